@@ -28,18 +28,67 @@ func Root() *cobra.Command {
 		Short:                 "Linter for Arduino projects.",
 		Long:                  "Arduino Lint checks for specification compliance and other common problems with Arduino projects",
 		DisableFlagsInUseLine: true,
-		Use:                   "arduino-lint [FLAG]... [PROJECT_PATH]...\n\nLint project in PROJECT_PATH or current path if no PROJECT_PATH argument provided.",
+		Use:                   "arduino-lint [FLAG]... [PROJECT_PATH]...\n\nLint project in PROJECT_PATH or current path if no PROJECT_PATH argument provided.\nA PROJECT_PATH with the name of a command (e.g., rules) must be given as a path (e.g., ./rules), otherwise the command is run.",
+		Args:                  cobra.ArbitraryArgs, // Required to allow PROJECT_PATH arguments in addition to the subcommands.
 		Run:                   command.ArduinoLint,
 	}
 
+	rootCommand.PersistentFlags().String("baseline", "", "Only fail on rule violations not recorded in this baseline file. Recorded violations are reported at the notice level.")
 	rootCommand.PersistentFlags().String("compliance", "specification", "Configure how strict the tool is. Can be {strict|specification|permissive}")
-	rootCommand.PersistentFlags().String("format", "text", "The output format can be {text|json}.")
+	rootCommand.PersistentFlags().StringSlice("disable-rules", []string{}, "Don't run the rules matching these comma-separated rule selectors. A selector is a rule ID, which may contain glob wildcards (e.g., \"LP0*\"), or a rule category or subcategory name (e.g., \"boards.txt\").")
+	rootCommand.PersistentFlags().Bool("dry-run", false, "With --fix, print the changes as a unified diff instead of applying them.")
+	rootCommand.PersistentFlags().StringSlice("enable-rules", []string{}, "Run the rules matching these comma-separated rule selectors, even if they are disabled by the other settings. See --disable-rules for the selector syntax.")
+	rootCommand.PersistentFlags().String("fail-on", "error", "The lowest level of rule violation that causes a failure exit status. Can be {error|warning|info|never}.")
+	rootCommand.PersistentFlags().Bool("fix", false, "Automatically fix the violations of rules which have a deterministic fix, then lint the result.")
+	rootCommand.PersistentFlags().String("format", "text", "The output format can be {text|json|sarif|junit|github|codeclimate|checkstyle|html|markdown|jsonl}.")
+	rootCommand.PersistentFlags().Int("jobs", 1, "The number of rules to run at the same time. The output order doesn't depend on this setting.")
+	rootCommand.PersistentFlags().String("library-index", "", "Use this local copy of the Library Manager index instead of downloading it. Can also be set via the ARDUINO_LINT_LIBRARY_INDEX environment variable.")
 	rootCommand.PersistentFlags().String("library-manager", "", "Configure the rules for libraries in the Arduino Library Manager index. Can be {submit|update|false}.\nsubmit: Also run additional rules required to pass before a library is accepted for inclusion in the index.\nupdate: Also run additional rules required to pass before new releases of a library already in the index are accepted.\nfalse: Don't run any Library Manager-specific rules.")
+	rootCommand.PersistentFlags().Bool("offline", false, "Don't access the network. Rules which require network access are not run.")
+	rootCommand.PersistentFlags().StringSlice("only", []string{}, "Only run the rules matching these comma-separated rule selectors. See --disable-rules for the selector syntax.")
+	rootCommand.PersistentFlags().String("plugins-config", "", "Run the plugins defined in this configuration file. The plugins of the configuration file found in the project path are not run, since the linted project could use them to run arbitrary commands.")
 	rootCommand.PersistentFlags().String("project-type", "all", "Only lint projects of the specified type and their subprojects. Can be {sketch|library|platform|all}.")
 	rootCommand.PersistentFlags().Bool("recursive", false, "Search path recursively for Arduino projects to lint. Can be {true|false}.")
-	rootCommand.PersistentFlags().String("report-file", "", "Save a report on the rules to this file.")
+	rootCommand.PersistentFlags().String("report-file", "", "Save a report on the rules to this file. The report uses the --report-format setting, which is independent of --format.")
+	rootCommand.PersistentFlags().String("report-format", "", "The format of the --report-file report can be {json|sarif|junit|codeclimate|checkstyle|html|markdown}. Defaults to html for a file with the .html extension, markdown for .md, otherwise json.")
+	rootCommand.PersistentFlags().String("report-detail", "violations", "The rule results recorded in the report can be {violations|full}.\nviolations: Only record rule violations, unless the --verbose flag is set.\nfull: Record the result of every rule that was applied, with its explanation.")
+	rootCommand.PersistentFlags().String("report-version", "", "The version of the JSON report format. Defaults to the latest version. Use a previous version to keep the report compatible with existing consumers.")
+	rootCommand.PersistentFlags().Bool("timings", false, "Measure the execution time of each rule and of gathering the project data. The times are added to the report, and a table of the slowest rules is printed in the text output format.")
 	rootCommand.PersistentFlags().BoolP("verbose", "v", false, "Show more information while running rules.")
 	rootCommand.PersistentFlags().Bool("version", false, "Print version and timestamp of the build.")
+	rootCommand.PersistentFlags().String("write-baseline", "", "Record the current rule violations to this baseline file, for use with the --baseline flag.")
+
+	rulesCommand := &cobra.Command{
+		Short:                 "List the rules.",
+		Long:                  "List Arduino Lint's rules, with the level each rule has with the --compliance and --library-manager settings.",
+		DisableFlagsInUseLine: true,
+		Use:                   "rules [FLAG]...",
+		Args:                  cobra.NoArgs,
+		Run:                   command.Rules,
+	}
+	rulesCommand.Flags().String("category", "", "Only list the rules of this category (e.g., \"library.properties\").")
+	rulesCommand.Flags().String("mode", "", "Only list the rules affected by this mode. Can be {strict|specification|permissive|submit|update}.")
+	rootCommand.AddCommand(rulesCommand)
+
+	explainCommand := &cobra.Command{
+		Short:                 "Explain a rule.",
+		Long:                  "Print the complete description of the rule with the given ID, with its level for each configuration.",
+		DisableFlagsInUseLine: true,
+		Use:                   "explain [FLAG]... RULE_ID",
+		Args:                  cobra.ExactArgs(1),
+		Run:                   command.Explain,
+	}
+	rootCommand.AddCommand(explainCommand)
+
+	reportSchemaCommand := &cobra.Command{
+		Short:                 "Print the JSON report schema.",
+		Long:                  "Print the JSON schema of the report produced by the json output format, for use by the consumers of the report.",
+		DisableFlagsInUseLine: true,
+		Use:                   "report-schema",
+		Args:                  cobra.NoArgs,
+		Run:                   command.ReportSchema,
+	}
+	rootCommand.AddCommand(reportSchemaCommand)
 
 	return rootCommand
 }
@@ -24,6 +24,7 @@ import (
 
 	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/result"
 	"github.com/arduino/arduino-lint/internal/result/feedback"
 	"github.com/arduino/arduino-lint/internal/result/outputformat"
@@ -31,15 +32,29 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// The exit statuses of the commands, which allow automated systems to distinguish the cause of the failure.
+const (
+	ExitLintFailure        = 1 // There were rule violations of the levels set by --fail-on.
+	ExitConfigurationError = 2 // The flags, arguments, or configuration file are not valid.
+	ExitNoProjects         = 3 // No projects were found under the project paths.
+	ExitInternalError      = 4 // An unexpected error occurred (e.g., a failure to access the file system or the network).
+)
+
 // ArduinoLint is the root command function.
 func ArduinoLint(rootCommand *cobra.Command, cliArguments []string) {
-	if err := configuration.Initialize(rootCommand.Flags(), cliArguments); err != nil {
+	toolConfiguration, err := configuration.Initialize(rootCommand.Flags(), cliArguments)
+	if err != nil {
 		feedback.Errorf("Invalid configuration: %v", err)
-		os.Exit(1)
+		os.Exit(ExitConfigurationError)
 	}
 
-	if configuration.VersionMode() {
-		if configuration.OutputFormat() == outputformat.Text {
+	if err := rule.ValidateRuleSettings(toolConfiguration); err != nil {
+		feedback.Errorf("Invalid configuration: %v", err)
+		os.Exit(ExitConfigurationError)
+	}
+
+	if toolConfiguration.VersionMode() {
+		if toolConfiguration.OutputFormat() == outputformat.Text {
 			if configuration.BuildVersion() == "" {
 				fmt.Print("0.0.0+" + configuration.BuildCommit())
 			} else {
@@ -65,47 +80,121 @@ func ArduinoLint(rootCommand *cobra.Command, cliArguments []string) {
 		return
 	}
 
-	result.Results.Initialize()
+	var results result.Type
+	results.Initialize(toolConfiguration)
+	if err := results.LoadBaseline(); err != nil {
+		feedback.Errorf("Invalid configuration: %v", err)
+		os.Exit(ExitConfigurationError)
+	}
 
-	projects, err := project.FindProjects()
+	projects, err := project.FindProjects(toolConfiguration)
 	if err != nil {
 		feedback.Errorf("Error while finding projects: %v", err)
-		os.Exit(1)
+		os.Exit(ExitNoProjects)
 	}
 
-	for _, project := range projects {
-		rule.Runner(project)
+	// The Library Manager index is only loaded once, for the first project that has rules that use it.
+	var libraryManagerIndex projectdata.LibraryManagerIndexType
+
+	if toolConfiguration.FixMode() {
+		for _, project := range projects {
+			if err := rule.Fixer(project, toolConfiguration, &libraryManagerIndex); err != nil {
+				feedback.Errorf("Error while fixing %s: %v", project.Path, err)
+				os.Exit(ExitInternalError)
+			}
+		}
+
+		if !toolConfiguration.DryRun() {
+			// Fixes may have renamed project files, so the projects must be found again.
+			projects, err = project.FindProjects(toolConfiguration)
+			if err != nil {
+				feedback.Errorf("Error while finding projects: %v", err)
+				os.Exit(ExitNoProjects)
+			}
+		}
+	}
+
+	projectRuns := rule.Runner(rootCommand.Context(), projects, toolConfiguration, &libraryManagerIndex)
+	for index, project := range projects {
+		// The results are recorded in the order of the projects, regardless of which project's rules finish first.
+		// The results of each project are recorded as they become available.
+		rule.Record(&results, project, projectRuns[index])
 
 		// Rules are finished for this project, so summarize its rule results in the report.
-		result.Results.AddProjectSummary(project)
+		results.AddProjectSummary(project)
 
 		// Print the project rule results summary.
-		feedback.Printf("\n%s\n", result.Results.ProjectSummaryText(project))
-		feedback.Print("\n-------------------\n\n")
+		feedback.Printf(toolConfiguration, "\n%s\n", results.ProjectSummaryText(project))
+		feedback.Print(toolConfiguration, "\n-------------------\n\n")
+		feedback.Stream(toolConfiguration, results.JSONLProjectSummary(project))
 	}
 
 	// All projects have been linted, so summarize their rule results in the report.
-	result.Results.AddSummary()
+	results.AddSummary()
 
-	if configuration.OutputFormat() == outputformat.Text {
+	switch toolConfiguration.OutputFormat() {
+	case outputformat.Text:
 		if len(projects) > 1 {
 			// There are multiple projects, print the summary of rule results for all projects.
-			fmt.Println(result.Results.SummaryText())
+			fmt.Println(results.SummaryText())
 		}
-	} else {
+	case outputformat.SARIF:
+		// Print the complete SARIF formatted report.
+		fmt.Println(results.SARIFReport())
+	case outputformat.JUnit:
+		// Print the complete JUnit XML formatted report.
+		fmt.Println(results.JUnitReport())
+	case outputformat.CodeClimate:
+		// Print the complete Code Climate formatted report.
+		fmt.Print(results.CodeClimateReport())
+	case outputformat.Checkstyle:
+		// Print the complete Checkstyle XML formatted report.
+		fmt.Print(results.CheckstyleReport())
+	case outputformat.HTML:
+		// Print the complete HTML formatted report.
+		fmt.Print(results.HTMLReport())
+	case outputformat.Markdown:
+		// Print the complete Markdown formatted report.
+		fmt.Print(results.MarkdownReport())
+	case outputformat.JSONL:
+		// The rule results were already streamed, so only the final summary event remains.
+		fmt.Print(results.JSONLSummary())
+	case outputformat.GitHub:
+		// Print the GitHub Actions workflow commands for the rule violations.
+		fmt.Print(results.GitHubReport())
+	default:
 		// Print the complete JSON formatted report.
-		fmt.Println(result.Results.JSONReport())
+		fmt.Println(results.JSONReport())
+	}
+
+	if toolConfiguration.Timings() {
+		// Print the execution time profile.
+		feedback.Print(toolConfiguration, "\n"+results.TimingsText())
 	}
 
-	if configuration.ReportFilePath() != nil {
+	if toolConfiguration.ReportFilePath() != nil {
 		// Write report file.
-		if err := result.Results.WriteReport(); err != nil {
+		if err := results.WriteReport(); err != nil {
 			feedback.Error(err.Error())
-			os.Exit(1)
+			os.Exit(ExitInternalError)
 		}
 	}
 
-	if !result.Results.Passed() {
-		os.Exit(1)
+	if toolConfiguration.WriteBaselineFilePath() != nil {
+		// Write baseline file.
+		if err := results.WriteBaseline(); err != nil {
+			feedback.Error(err.Error())
+			os.Exit(ExitInternalError)
+		}
+	}
+
+	if err := libraryManagerIndex.Err(); err != nil {
+		// The rules that use the index were not run, so the lint result can't be relied on.
+		feedback.Errorf("%s. The rules that use the index were not run.", err)
+		os.Exit(ExitInternalError)
+	}
+
+	if !results.Passed() {
+		os.Exit(ExitLintFailure)
 	}
 }
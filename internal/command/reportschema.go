@@ -0,0 +1,32 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-lint/internal/rule/schema/schemadata"
+	"github.com/spf13/cobra"
+)
+
+// reportSchemaFilename is the filename of the JSON schema of the report in the schemadata assets.
+const reportSchemaFilename = "arduino-lint-report-schema.json"
+
+// ReportSchema is the report-schema command function. It prints the JSON schema of the latest version of the JSON report.
+func ReportSchema(reportSchemaCommand *cobra.Command, cliArguments []string) {
+	fmt.Print(string(schemadata.MustAsset(reportSchemaFilename)))
+}
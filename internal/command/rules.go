@@ -0,0 +1,308 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/result/feedback"
+	"github.com/arduino/arduino-lint/internal/result/outputformat"
+	"github.com/arduino/arduino-lint/internal/rule"
+	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// ruleCatalogEntryType is the type for the rule catalog entries of the rules command's JSON output.
+type ruleCatalogEntryType struct {
+	ID               string `json:"ID"`
+	ProjectType      string `json:"projectType"`
+	SuperprojectType string `json:"superprojectType"`
+	Category         string `json:"category"`
+	Subcategory      string `json:"subcategory"`
+	Brief            string `json:"brief"`
+	Level            string `json:"level"`
+}
+
+// ruleExplanationType is the type for the explain command's JSON output.
+type ruleExplanationType struct {
+	ruleCatalogEntryType
+	Description string                 `json:"description"`
+	Reference   string                 `json:"reference"`
+	Levels      []ruleLevelsReportType `json:"levels"`
+}
+
+// ruleLevelsReportType is the type for the rule level of each configuration in the explain command's JSON output.
+type ruleLevelsReportType struct {
+	Compliance     string `json:"compliance"`
+	LibraryManager string `json:"libraryManager,omitempty"` // Omitted when the setting doesn't affect the rule.
+	Level          string `json:"level"`
+}
+
+// Rules is the rules command function. It lists the rules, with their level under the current configuration.
+func Rules(rulesCommand *cobra.Command, cliArguments []string) {
+	toolConfiguration, err := configuration.Initialize(rulesCommand.Flags(), []string{})
+	if err != nil {
+		feedback.Errorf("Invalid configuration: %v", err)
+		os.Exit(ExitConfigurationError)
+	}
+
+	ruleConfigurations, err := filterRuleConfigurations(rulesCommand.Flags(), toolConfiguration)
+	if err != nil {
+		feedback.Errorf("Invalid configuration: %v", err)
+		os.Exit(ExitConfigurationError)
+	}
+
+	catalog := []ruleCatalogEntryType{}
+	for _, ruleConfiguration := range ruleConfigurations {
+		catalog = append(catalog, ruleCatalogEntry(ruleConfiguration, toolConfiguration))
+	}
+
+	if toolConfiguration.OutputFormat() == outputformat.Text {
+		tableWriter := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tableWriter, "ID\tLevel\tProject type\tCategory\tBrief")
+		for _, entry := range catalog {
+			fmt.Fprintf(tableWriter, "%s\t%s\t%s\t%s\t%s\n", entry.ID, entry.Level, entry.ProjectType, entry.Category, entry.Brief)
+		}
+		tableWriter.Flush()
+		return
+	}
+
+	printJSON(catalog)
+}
+
+// Explain is the explain command function. It prints the complete information about a rule.
+func Explain(explainCommand *cobra.Command, cliArguments []string) {
+	toolConfiguration, err := configuration.Initialize(explainCommand.Flags(), []string{})
+	if err != nil {
+		feedback.Errorf("Invalid configuration: %v", err)
+		os.Exit(ExitConfigurationError)
+	}
+
+	ruleID := strings.ToUpper(cliArguments[0])
+	var explanation ruleExplanationType
+	found := false
+	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
+		if ruleConfiguration.ID == ruleID {
+			explanation = ruleExplanationType{
+				ruleCatalogEntryType: ruleCatalogEntry(ruleConfiguration, toolConfiguration),
+				Description:          ruleConfiguration.Description,
+				Reference:            ruleConfiguration.Reference,
+				Levels:               ruleLevelsReport(ruleConfiguration, toolConfiguration),
+			}
+			found = true
+			break
+		}
+	}
+	if !found {
+		feedback.Errorf("No rule with ID %s", cliArguments[0])
+		os.Exit(ExitConfigurationError)
+	}
+
+	if toolConfiguration.OutputFormat() != outputformat.Text {
+		printJSON(explanation)
+		return
+	}
+
+	fmt.Printf("%s: %s\n\n", explanation.ID, explanation.Brief)
+	fmt.Printf("%s\n\n", explanation.Description)
+	if explanation.Reference != "" {
+		fmt.Printf("More information: %s\n", explanation.Reference)
+	}
+	fmt.Printf("Project type: %s\n", explanation.ProjectType)
+	fmt.Printf("Enabled for superproject type: %s\n", explanation.SuperprojectType)
+	fmt.Printf("Category: %s\n", explanation.Category)
+	fmt.Printf("Subcategory: %s\n", explanation.Subcategory)
+	fmt.Printf("Level with current configuration: %s\n\n", explanation.Level)
+
+	tableWriter := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if explanation.Levels[0].LibraryManager == "" {
+		fmt.Fprintln(tableWriter, "compliance\tLevel")
+		for _, levels := range explanation.Levels {
+			fmt.Fprintf(tableWriter, "%s\t%s\n", levels.Compliance, levels.Level)
+		}
+	} else {
+		fmt.Fprintln(tableWriter, "compliance\tlibrary-manager\tLevel")
+		for _, levels := range explanation.Levels {
+			fmt.Fprintf(tableWriter, "%s\t%s\t%s\n", levels.Compliance, levels.LibraryManager, levels.Level)
+		}
+	}
+	tableWriter.Flush()
+}
+
+// filterRuleConfigurations returns the configurations of the rules that match the rules command's filter flags.
+func filterRuleConfigurations(flags *pflag.FlagSet, toolConfiguration *configuration.Type) ([]ruleconfiguration.Type, error) {
+	categoryFilter, _ := flags.GetString("category")
+
+	modeFilterString, _ := flags.GetString("mode")
+	modeFilter := rulemode.Default
+	if modeFilterString != "" {
+		found := false
+		for mode := range rulemode.Types {
+			if mode != rulemode.Default && strings.EqualFold(mode.String(), modeFilterString) {
+				modeFilter = mode
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("--mode flag value %s not valid", modeFilterString)
+		}
+	}
+
+	ruleConfigurations := []ruleconfiguration.Type{}
+	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
+		if !ruleConfiguration.ProjectType.Matches(toolConfiguration.SuperprojectTypeFilter()) {
+			continue
+		}
+
+		if categoryFilter != "" && !strings.EqualFold(ruleConfiguration.Category, categoryFilter) {
+			continue
+		}
+
+		if modeFilter != rulemode.Default && !usesMode(ruleConfiguration, modeFilter) {
+			continue
+		}
+
+		ruleConfigurations = append(ruleConfigurations, ruleConfiguration)
+	}
+
+	return ruleConfigurations, nil
+}
+
+// usesMode returns whether the given rule mode affects whether the rule is enabled or its level.
+func usesMode(ruleConfiguration ruleconfiguration.Type, mode rulemode.Type) bool {
+	for _, modes := range [][]rulemode.Type{
+		ruleConfiguration.DisableModes,
+		ruleConfiguration.EnableModes,
+		ruleConfiguration.InfoModes,
+		ruleConfiguration.WarningModes,
+		ruleConfiguration.ErrorModes,
+	} {
+		for _, ruleMode := range modes {
+			if ruleMode == mode {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ruleCatalogEntry returns the catalog entry for the given rule, with its level under the given tool configuration.
+func ruleCatalogEntry(ruleConfiguration ruleconfiguration.Type, toolConfiguration *configuration.Type) ruleCatalogEntryType {
+	return ruleCatalogEntryType{
+		ID:               ruleConfiguration.ID,
+		ProjectType:      ruleConfiguration.ProjectType.String(),
+		SuperprojectType: ruleConfiguration.SuperprojectType.String(),
+		Category:         ruleConfiguration.Category,
+		Subcategory:      ruleConfiguration.Subcategory,
+		Brief:            ruleConfiguration.Brief,
+		Level:            ruleLevel(ruleConfiguration, toolConfiguration.RuleModes(ruleSuperprojectType(ruleConfiguration)), toolConfiguration),
+	}
+}
+
+// ruleLevelsReport returns the level of the given rule for each of the compliance and Library Manager settings, with the
+// other settings of the given tool configuration.
+func ruleLevelsReport(ruleConfiguration ruleconfiguration.Type, toolConfiguration *configuration.Type) []ruleLevelsReportType {
+	complianceSettings := []string{
+		rulemode.Permissive.String(),
+		rulemode.Specification.String(),
+		rulemode.Strict.String(),
+	}
+
+	// The Library Manager setting is only reported for the rules it affects.
+	libraryManagerSettings := []string{""}
+	if usesMode(ruleConfiguration, rulemode.LibraryManagerSubmission) || usesMode(ruleConfiguration, rulemode.LibraryManagerIndexed) {
+		libraryManagerSettings = []string{
+			rulemode.LibraryManagerSubmission.String(),
+			rulemode.LibraryManagerIndexed.String(),
+			"false",
+		}
+	}
+
+	levelsReport := []ruleLevelsReportType{}
+	for _, complianceSetting := range complianceSettings {
+		for _, libraryManagerSetting := range libraryManagerSettings {
+			ruleModes := toolConfiguration.RuleModes(ruleSuperprojectType(ruleConfiguration))
+
+			var err error
+			ruleModes[rulemode.Strict], ruleModes[rulemode.Specification], ruleModes[rulemode.Permissive], err = rulemode.ComplianceModeFromString(complianceSetting)
+			if err != nil {
+				panic(err)
+			}
+			if libraryManagerSetting != "" {
+				ruleModes[rulemode.LibraryManagerSubmission], ruleModes[rulemode.LibraryManagerIndexed], ruleModes[rulemode.LibraryManagerIndexing], err = rulemode.LibraryManagerModeFromString(libraryManagerSetting)
+				if err != nil {
+					panic(err)
+				}
+			}
+
+			levelsReport = append(levelsReport, ruleLevelsReportType{
+				Compliance:     complianceSetting,
+				LibraryManager: libraryManagerSetting,
+				Level:          ruleLevel(ruleConfiguration, ruleModes, toolConfiguration),
+			})
+		}
+	}
+
+	return levelsReport
+}
+
+// ruleSuperprojectType returns the type of the superproject the rule modes of the given rule are determined by.
+// Rules that apply under any superproject are reported for a project of their own type.
+func ruleSuperprojectType(ruleConfiguration ruleconfiguration.Type) projecttype.Type {
+	if ruleConfiguration.SuperprojectType == projecttype.All {
+		return ruleConfiguration.ProjectType
+	}
+
+	return ruleConfiguration.SuperprojectType
+}
+
+// ruleLevel returns the string representation of the violation level of the given rule in the given mode, under the given
+// tool configuration.
+func ruleLevel(ruleConfiguration ruleconfiguration.Type, ruleModes map[rulemode.Type]bool, toolConfiguration *configuration.Type) string {
+	enabled, err := rule.IsEnabled(ruleConfiguration, ruleModes, toolConfiguration)
+	if err != nil {
+		panic(err)
+	}
+	if !enabled {
+		return "disabled"
+	}
+
+	level, err := rulelevel.FailRuleLevel(ruleConfiguration, ruleModes, toolConfiguration)
+	if err != nil {
+		panic(err)
+	}
+	return level.String()
+}
+
+// printJSON prints the given data as indented JSON.
+func printJSON(data interface{}) {
+	dataJSON, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(dataJSON))
+}
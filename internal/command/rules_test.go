@@ -0,0 +1,131 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package command
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/util/test"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rulesFlags returns the configuration flags with the addition of the rules command's flags.
+func rulesFlags() *pflag.FlagSet {
+	flags := test.ConfigurationFlags()
+	flags.String("category", "", "")
+	flags.String("mode", "", "")
+	return flags
+}
+
+func TestFilterRuleConfigurations(t *testing.T) {
+	testTables := []struct {
+		testName         string
+		projectType      string
+		category         string
+		mode             string
+		errorAssertion   assert.ValueAssertionFunc
+		filterAssertion  func(ruleconfiguration.Type) bool
+		expectedNotEmpty bool
+	}{
+		{"No filter", "all", "", "", assert.Nil, func(ruleconfiguration.Type) bool { return true }, true},
+		{"Project type", "platform", "", "", assert.Nil, func(ruleConfiguration ruleconfiguration.Type) bool {
+			return ruleConfiguration.ProjectType == projecttype.Platform
+		}, true},
+		{"Category", "all", "Structure", "", assert.Nil, func(ruleConfiguration ruleconfiguration.Type) bool {
+			return ruleConfiguration.Category == "structure"
+		}, true},
+		{"Mode", "library", "", "submit", assert.Nil, func(ruleConfiguration ruleconfiguration.Type) bool {
+			return usesMode(ruleConfiguration, rulemode.LibraryManagerSubmission)
+		}, true},
+		{"Invalid mode", "all", "", "foo", assert.NotNil, nil, false},
+		{"No match", "all", "foo", "", assert.Nil, nil, false},
+	}
+
+	for _, testTable := range testTables {
+		flags := rulesFlags()
+		flags.Set("project-type", testTable.projectType)
+		flags.Set("category", testTable.category)
+		flags.Set("mode", testTable.mode)
+		toolConfiguration, err := configuration.Initialize(flags, []string{})
+		require.Nil(t, err)
+
+		ruleConfigurations, err := filterRuleConfigurations(flags, toolConfiguration)
+		testTable.errorAssertion(t, err, testTable.testName)
+		assert.Equal(t, testTable.expectedNotEmpty, len(ruleConfigurations) > 0, testTable.testName)
+		for _, ruleConfiguration := range ruleConfigurations {
+			assert.True(t, testTable.filterAssertion(ruleConfiguration), testTable.testName)
+		}
+	}
+}
+
+func TestRuleSuperprojectType(t *testing.T) {
+	assert.Equal(t, projecttype.Library, ruleSuperprojectType(ruleconfiguration.Type{ProjectType: projecttype.Library, SuperprojectType: projecttype.All}))
+	assert.Equal(t, projecttype.Sketch, ruleSuperprojectType(ruleconfiguration.Type{ProjectType: projecttype.Library, SuperprojectType: projecttype.Sketch}))
+}
+
+func TestRuleLevelsReport(t *testing.T) {
+	toolConfiguration, err := configuration.Initialize(test.ConfigurationFlags(), []string{})
+	require.Nil(t, err)
+
+	ruleConfiguration := ruleconfiguration.Type{
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.All,
+		DisableModes:     []rulemode.Type{rulemode.Default},
+		EnableModes:      []rulemode.Type{rulemode.LibraryManagerSubmission},
+		WarningModes:     []rulemode.Type{rulemode.Permissive},
+		ErrorModes:       []rulemode.Type{rulemode.Default},
+	}
+	assert.Equal(
+		t,
+		[]ruleLevelsReportType{
+			{Compliance: "permissive", LibraryManager: "submit", Level: "WARNING"},
+			{Compliance: "permissive", LibraryManager: "update", Level: "disabled"},
+			{Compliance: "permissive", LibraryManager: "false", Level: "disabled"},
+			{Compliance: "specification", LibraryManager: "submit", Level: "ERROR"},
+			{Compliance: "specification", LibraryManager: "update", Level: "disabled"},
+			{Compliance: "specification", LibraryManager: "false", Level: "disabled"},
+			{Compliance: "strict", LibraryManager: "submit", Level: "ERROR"},
+			{Compliance: "strict", LibraryManager: "update", Level: "disabled"},
+			{Compliance: "strict", LibraryManager: "false", Level: "disabled"},
+		},
+		ruleLevelsReport(ruleConfiguration, toolConfiguration),
+	)
+
+	ruleConfiguration = ruleconfiguration.Type{
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+		DisableModes:     []rulemode.Type{rulemode.Permissive},
+		EnableModes:      []rulemode.Type{rulemode.Default},
+		InfoModes:        []rulemode.Type{rulemode.Specification},
+		ErrorModes:       []rulemode.Type{rulemode.Strict},
+	}
+	assert.Equal(
+		t,
+		[]ruleLevelsReportType{
+			{Compliance: "permissive", Level: "disabled"},
+			{Compliance: "specification", Level: "INFO"},
+			{Compliance: "strict", Level: "ERROR"},
+		},
+		ruleLevelsReport(ruleConfiguration, toolConfiguration),
+	)
+}
@@ -21,132 +21,310 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/result/failon"
 	"github.com/arduino/arduino-lint/internal/result/outputformat"
+	"github.com/arduino/arduino-lint/internal/result/reportversion"
 	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
 )
 
-// Initialize sets up the tool configuration according to defaults and user-specified options.
-func Initialize(flags *pflag.FlagSet, projectPaths []string) error {
+// Type is the type for the tool configuration.
+// Each run of the linter has its own configuration, so that the runs are independent of each other.
+type Type struct {
+	configurationFilePath        *paths.Path
+	ruleSettings                 map[string]string
+	pluginsConfigurationFilePath *paths.Path
+	plugins                      []Plugin
+	customRules                  []CustomRule
+	customRuleModes              map[rulemode.Type]bool
+	libraryIndexFilePath         *paths.Path
+	offline                      bool
+	superprojectTypeFilter       projecttype.Type
+	enableRules                  []string
+	disableRules                 []string
+	onlyRules                    []string
+	recursive                    bool
+	outputFormat                 outputformat.Type
+	failOn                       failon.Type
+	reportFormat                 outputformat.Type
+	reportFilePath               *paths.Path
+	fullReportDetail             bool
+	reportVersion                reportversion.Type
+	baselineFilePath             *paths.Path
+	writeBaselineFilePath        *paths.Path
+	fixMode                      bool
+	dryRun                       bool
+	jobs                         int
+	timings                      bool
+	verbose                      bool
+	versionMode                  bool
+	targetPaths                  paths.PathList
+}
+
+// Initialize returns the tool configuration according to defaults and user-specified options.
+func Initialize(flags *pflag.FlagSet, projectPaths []string) (*Type, error) {
 	var err error
+	configuration := Type{customRuleModes: make(map[rulemode.Type]bool)}
 
-	complianceString, _ := flags.GetString("compliance")
+	// Settings from the configuration file are used when the corresponding flag was not set by the user.
+	var configurationFile configurationFileType
+	configuration.configurationFilePath, err = findConfigurationFile(projectPaths)
+	if err != nil {
+		return nil, err
+	}
+	if configuration.configurationFilePath != nil {
+		configurationFile, err = loadConfigurationFile(configuration.configurationFilePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	configuration.ruleSettings = configurationFile.Rules
+	configuration.customRules = configurationFile.CustomRules
+
+	// The configuration file found in the project path is provided by the linted project, which must not be able to run
+	// arbitrary commands. So plugins are only run from a configuration file specified by the user.
+	if len(configurationFile.Plugins) > 0 {
+		logrus.Warnf("Ignoring plugins of configuration file %s. Plugins are only run from the --plugins-config file.", configuration.configurationFilePath)
+	}
+	pluginsConfigurationFilePathString, _ := flags.GetString("plugins-config")
+	if pluginsConfigurationFilePathString != "" {
+		configuration.pluginsConfigurationFilePath, err = paths.New(pluginsConfigurationFilePathString).Abs()
+		if err != nil || !configuration.pluginsConfigurationFilePath.IsNotDir() {
+			return nil, fmt.Errorf("--plugins-config flag value %s not valid: file does not exist", pluginsConfigurationFilePathString)
+		}
+		pluginsConfigurationFile, err := loadConfigurationFile(configuration.pluginsConfigurationFilePath)
+		if err != nil {
+			return nil, err
+		}
+		configuration.plugins = pluginsConfigurationFile.Plugins
+	}
+
+	complianceString, complianceSource := stringSetting(flags, "compliance", configurationFile.Compliance, configuration.configurationFilePath)
 	if complianceString != "" {
-		customRuleModes[rulemode.Strict], customRuleModes[rulemode.Specification], customRuleModes[rulemode.Permissive], err = rulemode.ComplianceModeFromString(complianceString)
+		configuration.customRuleModes[rulemode.Strict], configuration.customRuleModes[rulemode.Specification], configuration.customRuleModes[rulemode.Permissive], err = rulemode.ComplianceModeFromString(complianceString)
 		if err != nil {
-			return fmt.Errorf("--compliance flag value %s not valid", complianceString)
+			return nil, fmt.Errorf("%s value %s not valid", complianceSource, complianceString)
 		}
 	}
 
-	outputFormatString, _ := flags.GetString("format")
-	outputFormat, err = outputformat.FromString(outputFormatString)
+	outputFormatString, outputFormatSource := stringSetting(flags, "format", configurationFile.Format, configuration.configurationFilePath)
+	configuration.outputFormat, err = outputformat.FromString(outputFormatString)
+	if err != nil {
+		return nil, fmt.Errorf("%s value %s not valid", outputFormatSource, outputFormatString)
+	}
+
+	failOnString, failOnSource := stringSetting(flags, "fail-on", configurationFile.FailOn, configuration.configurationFilePath)
+	configuration.failOn, err = failon.FromString(failOnString)
 	if err != nil {
-		return fmt.Errorf("--format flag value %s not valid", outputFormatString)
+		return nil, fmt.Errorf("%s value %s not valid", failOnSource, failOnString)
 	}
 
-	libraryManagerModeString, _ := flags.GetString("library-manager")
+	libraryManagerModeString, libraryManagerModeSource := stringSetting(flags, "library-manager", configurationFile.LibraryManager, configuration.configurationFilePath)
 	if libraryManagerModeString != "" {
-		customRuleModes[rulemode.LibraryManagerSubmission], customRuleModes[rulemode.LibraryManagerIndexed], customRuleModes[rulemode.LibraryManagerIndexing], err = rulemode.LibraryManagerModeFromString(libraryManagerModeString)
+		configuration.customRuleModes[rulemode.LibraryManagerSubmission], configuration.customRuleModes[rulemode.LibraryManagerIndexed], configuration.customRuleModes[rulemode.LibraryManagerIndexing], err = rulemode.LibraryManagerModeFromString(libraryManagerModeString)
 		if err != nil {
-			return fmt.Errorf("--library-manager flag value %s not valid", libraryManagerModeString)
+			return nil, fmt.Errorf("%s value %s not valid", libraryManagerModeSource, libraryManagerModeString)
 		}
 	}
 
 	if libraryManagerModeString, ok := os.LookupEnv("ARDUINO_LINT_LIBRARY_MANAGER_INDEXING"); ok {
 		indexing, err := strconv.ParseBool(libraryManagerModeString)
 		if err != nil {
-			return fmt.Errorf("ARDUINO_LINT_LIBRARY_MANAGER_INDEXING environment variable value %s not valid", libraryManagerModeString)
+			return nil, fmt.Errorf("ARDUINO_LINT_LIBRARY_MANAGER_INDEXING environment variable value %s not valid", libraryManagerModeString)
 		}
 		if indexing {
-			customRuleModes[rulemode.LibraryManagerSubmission] = false
-			customRuleModes[rulemode.LibraryManagerIndexed] = false
-			customRuleModes[rulemode.LibraryManagerIndexing] = true
+			configuration.customRuleModes[rulemode.LibraryManagerSubmission] = false
+			configuration.customRuleModes[rulemode.LibraryManagerIndexed] = false
+			configuration.customRuleModes[rulemode.LibraryManagerIndexing] = true
 		}
 	}
 
-	if logFormatString, ok := os.LookupEnv("ARDUINO_LINT_LOG_FORMAT"); ok {
-		logFormat, err := logFormatFromString(logFormatString)
-		if err != nil {
-			return fmt.Errorf("--log-format flag value %s not valid", logFormatString)
+	libraryIndexFilePathString, _ := flags.GetString("library-index")
+	libraryIndexSource := "--library-index flag"
+	if !flags.Changed("library-index") {
+		if environmentLibraryIndexFilePathString, ok := os.LookupEnv("ARDUINO_LINT_LIBRARY_INDEX"); ok {
+			libraryIndexFilePathString = environmentLibraryIndexFilePathString
+			libraryIndexSource = "ARDUINO_LINT_LIBRARY_INDEX environment variable"
 		}
-		logrus.SetFormatter(logFormat)
-		EnableLogging(true)
+	}
+	configuration.libraryIndexFilePath = paths.New(libraryIndexFilePathString)
+	if configuration.libraryIndexFilePath != nil && !configuration.libraryIndexFilePath.IsNotDir() {
+		return nil, fmt.Errorf("%s value %s not valid: file does not exist", libraryIndexSource, libraryIndexFilePathString)
 	}
 
-	if logLevelString, ok := os.LookupEnv("ARDUINO_LINT_LOG_LEVEL"); ok {
-		logLevel, err := logrus.ParseLevel(logLevelString)
-		if err != nil {
-			return fmt.Errorf("--log-level flag value %s not valid", logLevelString)
-		}
-		logrus.SetLevel(logLevel)
-		EnableLogging(true)
+	configuration.offline, _ = flags.GetBool("offline")
+
+	superprojectTypeFilterString, superprojectTypeFilterSource := stringSetting(flags, "project-type", configurationFile.ProjectType, configuration.configurationFilePath)
+	configuration.superprojectTypeFilter, err = projecttype.FromString(superprojectTypeFilterString)
+	if err != nil {
+		return nil, fmt.Errorf("%s value %s not valid", superprojectTypeFilterSource, superprojectTypeFilterString)
 	}
 
-	superprojectTypeFilterString, _ := flags.GetString("project-type")
-	superprojectTypeFilter, err = projecttype.FromString(superprojectTypeFilterString)
+	configuration.enableRules, err = ruleSelectorsSetting(flags, "enable-rules")
 	if err != nil {
-		return fmt.Errorf("--project-type flag value %s not valid", superprojectTypeFilterString)
+		return nil, err
+	}
+	configuration.disableRules, err = ruleSelectorsSetting(flags, "disable-rules")
+	if err != nil {
+		return nil, err
+	}
+	configuration.onlyRules, err = ruleSelectorsSetting(flags, "only")
+	if err != nil {
+		return nil, err
 	}
 
-	recursive, _ = flags.GetBool("recursive")
+	baselineFilePathString, _ := flags.GetString("baseline")
+	configuration.baselineFilePath = paths.New(baselineFilePathString)
+	if configuration.baselineFilePath != nil && !configuration.baselineFilePath.IsNotDir() {
+		return nil, fmt.Errorf("--baseline flag value %s not valid: file does not exist", baselineFilePathString)
+	}
+
+	writeBaselineFilePathString, _ := flags.GetString("write-baseline")
+	configuration.writeBaselineFilePath = paths.New(writeBaselineFilePathString)
+
+	configuration.fixMode, _ = flags.GetBool("fix")
+	configuration.dryRun, _ = flags.GetBool("dry-run")
+	if configuration.dryRun {
+		if !configuration.fixMode {
+			return nil, fmt.Errorf("--dry-run flag requires the --fix flag")
+		}
+		if configuration.outputFormat != outputformat.Text {
+			// The diff is printed to stdout, so it can't be combined with the machine readable output formats.
+			return nil, fmt.Errorf("--dry-run flag requires text output format")
+		}
+	}
+
+	configuration.recursive, _ = flags.GetBool("recursive")
+	if !flags.Changed("recursive") && configurationFile.Recursive != nil {
+		configuration.recursive = *configurationFile.Recursive
+	}
 
 	reportFilePathString, _ := flags.GetString("report-file")
-	reportFilePath = paths.New(reportFilePathString)
+	configuration.reportFilePath = paths.New(reportFilePathString)
 
-	verbose, _ = flags.GetBool("verbose")
+	reportFormatString, _ := flags.GetString("report-format")
+	if reportFormatString == "" {
+		configuration.reportFormat = defaultReportFormat(configuration.reportFilePath)
+	} else {
+		configuration.reportFormat, err = outputformat.FromString(reportFormatString)
+		if err != nil || configuration.reportFormat == outputformat.Text || configuration.reportFormat == outputformat.GitHub || configuration.reportFormat == outputformat.JSONL {
+			return nil, fmt.Errorf("--report-format flag value %s not valid", reportFormatString)
+		}
+	}
 
-	versionMode, _ = flags.GetBool("version")
+	reportDetailString, _ := flags.GetString("report-detail")
+	switch strings.ToLower(reportDetailString) {
+	case "violations":
+		configuration.fullReportDetail = false
+	case "full":
+		configuration.fullReportDetail = true
+	default:
+		return nil, fmt.Errorf("--report-detail flag value %s not valid", reportDetailString)
+	}
+
+	reportVersionString, _ := flags.GetString("report-version")
+	configuration.reportVersion = reportversion.Latest
+	if reportVersionString != "" {
+		configuration.reportVersion, err = reportversion.FromString(reportVersionString)
+		if err != nil {
+			return nil, fmt.Errorf("--report-version flag value %s not valid", reportVersionString)
+		}
+	}
+
+	configuration.jobs, _ = flags.GetInt("jobs")
+	if configuration.jobs < 1 {
+		return nil, fmt.Errorf("--jobs flag value %v not valid", configuration.jobs)
+	}
+
+	configuration.timings, _ = flags.GetBool("timings")
+
+	configuration.verbose, _ = flags.GetBool("verbose")
+
+	configuration.versionMode, _ = flags.GetBool("version")
 
-	targetPaths = nil
 	if len(projectPaths) == 0 {
 		// Default to using current working directory.
 		workingDirectoryPath, err := os.Getwd()
 		if err != nil {
 			panic(err)
 		}
-		targetPaths.Add(paths.New(workingDirectoryPath))
+		configuration.targetPaths.Add(paths.New(workingDirectoryPath))
 	} else {
 		for _, projectPath := range projectPaths {
 			targetPath := paths.New(projectPath)
 			targetPathExists, err := targetPath.ExistCheck()
 			if err != nil {
-				return fmt.Errorf("Unable to process PROJECT_PATH argument value %v: %v", targetPath, err)
+				return nil, fmt.Errorf("Unable to process PROJECT_PATH argument value %v: %v", targetPath, err)
 			}
 			if !targetPathExists {
-				return fmt.Errorf("PROJECT_PATH argument %v does not exist", targetPath)
+				return nil, fmt.Errorf("PROJECT_PATH argument %v does not exist", targetPath)
 			}
-			targetPaths.AddIfMissing(targetPath)
+			configuration.targetPaths.AddIfMissing(targetPath)
 		}
 	}
 
 	if officialModeString, ok := os.LookupEnv("ARDUINO_LINT_OFFICIAL"); ok {
-		customRuleModes[rulemode.Official], err = strconv.ParseBool(officialModeString)
+		configuration.customRuleModes[rulemode.Official], err = strconv.ParseBool(officialModeString)
 		if err != nil {
-			return fmt.Errorf("ARDUINO_LINT_OFFICIAL environment variable value %s not valid", officialModeString)
+			return nil, fmt.Errorf("ARDUINO_LINT_OFFICIAL environment variable value %s not valid", officialModeString)
 		}
 	}
 
 	logrus.WithFields(logrus.Fields{
-		"compliance":                      rulemode.Compliance(customRuleModes),
-		"output format":                   OutputFormat(),
-		"Library Manager submission mode": customRuleModes[rulemode.LibraryManagerSubmission],
-		"Library Manager update mode":     customRuleModes[rulemode.LibraryManagerIndexed],
-		"Library Manager indexing mode":   customRuleModes[rulemode.LibraryManagerIndexing],
+		"configuration file":              configuration.configurationFilePath,
+		"rule settings":                   configuration.ruleSettings,
+		"plugins configuration file":      configuration.pluginsConfigurationFilePath,
+		"plugins":                         configuration.Plugins(),
+		"custom rules":                    configuration.CustomRules(),
+		"compliance":                      rulemode.Compliance(configuration.customRuleModes),
+		"output format":                   configuration.OutputFormat(),
+		"fail on":                         configuration.FailOn(),
+		"Library Manager submission mode": configuration.customRuleModes[rulemode.LibraryManagerSubmission],
+		"Library Manager update mode":     configuration.customRuleModes[rulemode.LibraryManagerIndexed],
+		"Library Manager indexing mode":   configuration.customRuleModes[rulemode.LibraryManagerIndexing],
+		"Library Manager index file":      libraryIndexFilePathString,
+		"offline":                         configuration.Offline(),
 		"log level":                       logrus.GetLevel().String(),
-		"superproject type filter":        SuperprojectTypeFilter(),
-		"recursive":                       Recursive(),
+		"superproject type filter":        configuration.SuperprojectTypeFilter(),
+		"enable rules":                    configuration.EnableRules(),
+		"disable rules":                   configuration.DisableRules(),
+		"only rules":                      configuration.OnlyRules(),
+		"recursive":                       configuration.Recursive(),
 		"report file":                     reportFilePathString,
-		"verbose":                         Verbose(),
-		"projects path":                   TargetPaths(),
+		"report format":                   configuration.ReportFormat(),
+		"report detail full":              configuration.FullReportDetail(),
+		"report version":                  configuration.ReportVersion(),
+		"baseline file":                   baselineFilePathString,
+		"write baseline file":             writeBaselineFilePathString,
+		"fix":                             configuration.FixMode(),
+		"dry run":                         configuration.DryRun(),
+		"jobs":                            configuration.Jobs(),
+		"timings":                         configuration.Timings(),
+		"verbose":                         configuration.Verbose(),
+		"projects path":                   configuration.TargetPaths(),
 	}).Debug("Configuration initialized")
 
-	return nil
+	return &configuration, nil
+}
+
+// ruleSelectorsSetting returns the rule selectors of the given flag.
+// A rule selector is a rule ID glob pattern, or the name of a rule category or subcategory.
+func ruleSelectorsSetting(flags *pflag.FlagSet, flagName string) ([]string, error) {
+	ruleSelectors, _ := flags.GetStringSlice(flagName)
+	for _, ruleSelector := range ruleSelectors {
+		if _, err := path.Match(ruleSelector, ""); err != nil || strings.TrimSpace(ruleSelector) == "" {
+			return nil, fmt.Errorf("--%s flag value %s not valid", flagName, ruleSelector)
+		}
+	}
+
+	return ruleSelectors, nil
 }
 
 // logFormatFromString parses the --log-format flag value and returns the corresponding log formatter.
@@ -161,53 +339,163 @@ func logFormatFromString(logFormatString string) (logrus.Formatter, error) {
 	}
 }
 
-var customRuleModes = make(map[rulemode.Type]bool)
+// ConfigurationFilePath returns the path of the configuration file in use, or nil if there is none.
+func (configuration *Type) ConfigurationFilePath() *paths.Path {
+	return configuration.configurationFilePath
+}
+
+// RuleSetting returns the configuration file setting for the rule with the given ID, and whether there is one.
+func (configuration *Type) RuleSetting(ruleID string) (string, bool) {
+	ruleSetting, ok := configuration.ruleSettings[ruleID]
+	return ruleSetting, ok
+}
+
+// RuleSettings returns the configuration file settings for all rules, mapped by rule ID.
+func (configuration *Type) RuleSettings() map[string]string {
+	return configuration.ruleSettings
+}
+
+// PluginsConfigurationFilePath returns the path of the configuration file that defines the plugins, or nil if there is
+// none.
+func (configuration *Type) PluginsConfigurationFilePath() *paths.Path {
+	return configuration.pluginsConfigurationFilePath
+}
+
+// Plugins returns the configurations of the external rule executables, from the --plugins-config file.
+func (configuration *Type) Plugins() []Plugin {
+	return configuration.plugins
+}
+
+// CustomRules returns the configurations of the rules defined in the configuration file.
+func (configuration *Type) CustomRules() []CustomRule {
+	return configuration.customRules
+}
 
 // RuleModes returns the rule modes configuration for the given project type.
-func RuleModes(superprojectType projecttype.Type) map[rulemode.Type]bool {
-	return rulemode.Modes(defaultRuleModes, customRuleModes, superprojectType)
+func (configuration *Type) RuleModes(superprojectType projecttype.Type) map[rulemode.Type]bool {
+	return rulemode.Modes(defaultRuleModes, configuration.customRuleModes, superprojectType)
+}
+
+// LibraryIndexFilePath returns the path of the local Library Manager index file to use, or nil if the index is to be
+// downloaded.
+func (configuration *Type) LibraryIndexFilePath() *paths.Path {
+	return configuration.libraryIndexFilePath
 }
 
-var superprojectTypeFilter projecttype.Type
+// Offline returns the --offline setting.
+func (configuration *Type) Offline() bool {
+	return configuration.offline
+}
 
 // SuperprojectTypeFilter returns the superproject type filter configuration.
-func SuperprojectTypeFilter() projecttype.Type {
-	return superprojectTypeFilter
+func (configuration *Type) SuperprojectTypeFilter() projecttype.Type {
+	return configuration.superprojectTypeFilter
 }
 
-var recursive bool
+// EnableRules returns the selectors of the rules to run regardless of the rule modes and configuration file settings.
+func (configuration *Type) EnableRules() []string {
+	return configuration.enableRules
+}
 
-// Recursive returns the recursive project search configuration value.
-func Recursive() bool {
-	return recursive
+// DisableRules returns the selectors of the rules not to run.
+func (configuration *Type) DisableRules() []string {
+	return configuration.disableRules
 }
 
-var outputFormat outputformat.Type
+// OnlyRules returns the selectors of the rules to limit the run to. All rules are candidates if empty.
+func (configuration *Type) OnlyRules() []string {
+	return configuration.onlyRules
+}
+
+// Recursive returns the recursive project search configuration value.
+func (configuration *Type) Recursive() bool {
+	return configuration.recursive
+}
 
 // OutputFormat returns the tool output format configuration value.
-func OutputFormat() outputformat.Type {
-	return outputFormat
+func (configuration *Type) OutputFormat() outputformat.Type {
+	return configuration.outputFormat
+}
+
+// FailOn returns the lowest rule violation level that causes the linting to fail.
+func (configuration *Type) FailOn() failon.Type {
+	return configuration.failOn
 }
 
-var reportFilePath *paths.Path
+// ReportFormat returns the format of the report file.
+func (configuration *Type) ReportFormat() outputformat.Type {
+	return configuration.reportFormat
+}
+
+// defaultReportFormat returns the report file format to use when the --report-format flag is not set.
+// The report file was always JSON before the other report formats were added, so it stays JSON unless the file extension
+// is specific to another format.
+func defaultReportFormat(reportFilePath *paths.Path) outputformat.Type {
+	if reportFilePath != nil {
+		switch strings.ToLower(reportFilePath.Ext()) {
+		case ".html", ".htm":
+			return outputformat.HTML
+		case ".md":
+			return outputformat.Markdown
+		}
+	}
+
+	return outputformat.JSON
+}
 
 // ReportFilePath returns the path to save the report file at.
-func ReportFilePath() *paths.Path {
-	return reportFilePath
+func (configuration *Type) ReportFilePath() *paths.Path {
+	return configuration.reportFilePath
 }
 
-var verbose bool
+// FullReportDetail returns whether the report should record the result of every rule, regardless of verbosity.
+func (configuration *Type) FullReportDetail() bool {
+	return configuration.fullReportDetail
+}
 
-// Verbose returns the verbosity setting.
-func Verbose() bool {
-	return verbose
+// ReportVersion returns the version of the JSON report format.
+func (configuration *Type) ReportVersion() reportversion.Type {
+	return configuration.reportVersion
 }
 
-var versionMode bool
+// BaselineFilePath returns the path of the baseline file to compare the rule results against.
+func (configuration *Type) BaselineFilePath() *paths.Path {
+	return configuration.baselineFilePath
+}
+
+// WriteBaselineFilePath returns the path to save the baseline file of the rule results at.
+func (configuration *Type) WriteBaselineFilePath() *paths.Path {
+	return configuration.writeBaselineFilePath
+}
+
+// FixMode returns the --fix setting.
+func (configuration *Type) FixMode() bool {
+	return configuration.fixMode
+}
+
+// DryRun returns the --dry-run setting.
+func (configuration *Type) DryRun() bool {
+	return configuration.dryRun
+}
+
+// Jobs returns the maximum number of rules to run at the same time.
+func (configuration *Type) Jobs() int {
+	return configuration.jobs
+}
+
+// Timings returns whether the execution time of the rules should be measured.
+func (configuration *Type) Timings() bool {
+	return configuration.timings
+}
+
+// Verbose returns the verbosity setting.
+func (configuration *Type) Verbose() bool {
+	return configuration.verbose
+}
 
 // VersionMode returns the --version setting.
-func VersionMode() bool {
-	return versionMode
+func (configuration *Type) VersionMode() bool {
+	return configuration.versionMode
 }
 
 // Version is the build version.
@@ -234,11 +522,34 @@ func BuildTimestamp() string {
 	return Timestamp
 }
 
-var targetPaths paths.PathList
-
 // TargetPaths returns the projects search paths.
-func TargetPaths() paths.PathList {
-	return targetPaths
+func (configuration *Type) TargetPaths() paths.PathList {
+	return configuration.targetPaths
+}
+
+// InitializeLogging configures the standard logger according to the environment variables.
+// This is separate from Initialize, since the logger is shared by the whole process, so it is only configured by the
+// command line interface rather than by each linter run.
+func InitializeLogging() error {
+	if logFormatString, ok := os.LookupEnv("ARDUINO_LINT_LOG_FORMAT"); ok {
+		logFormat, err := logFormatFromString(logFormatString)
+		if err != nil {
+			return fmt.Errorf("--log-format flag value %s not valid", logFormatString)
+		}
+		logrus.SetFormatter(logFormat)
+		EnableLogging(true)
+	}
+
+	if logLevelString, ok := os.LookupEnv("ARDUINO_LINT_LOG_LEVEL"); ok {
+		logLevel, err := logrus.ParseLevel(logLevelString)
+		if err != nil {
+			return fmt.Errorf("--log-level flag value %s not valid", logLevelString)
+		}
+		logrus.SetLevel(logLevel)
+		EnableLogging(true)
+	}
+
+	return nil
 }
 
 // EnableLogging enables or disables logging debug output.
@@ -22,7 +22,9 @@ import (
 
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/result/failon"
 	"github.com/arduino/arduino-lint/internal/result/outputformat"
+	"github.com/arduino/arduino-lint/internal/result/reportversion"
 	"github.com/arduino/arduino-lint/internal/util/test"
 	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
@@ -44,99 +46,223 @@ func TestInitializeCompliance(t *testing.T) {
 	flags := test.ConfigurationFlags()
 
 	flags.Set("compliance", "foo")
-	assert.Error(t, Initialize(flags, projectPaths))
+	_, err := Initialize(flags, projectPaths)
+	assert.Error(t, err)
 
 	flags.Set("compliance", "strict")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.True(t, customRuleModes[rulemode.Strict])
-	assert.False(t, customRuleModes[rulemode.Specification])
-	assert.False(t, customRuleModes[rulemode.Permissive])
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.True(t, configuration.customRuleModes[rulemode.Strict])
+	assert.False(t, configuration.customRuleModes[rulemode.Specification])
+	assert.False(t, configuration.customRuleModes[rulemode.Permissive])
 
 	flags.Set("compliance", "specification")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.False(t, customRuleModes[rulemode.Strict])
-	assert.True(t, customRuleModes[rulemode.Specification])
-	assert.False(t, customRuleModes[rulemode.Permissive])
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.customRuleModes[rulemode.Strict])
+	assert.True(t, configuration.customRuleModes[rulemode.Specification])
+	assert.False(t, configuration.customRuleModes[rulemode.Permissive])
 
 	flags.Set("compliance", "permissive")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.False(t, customRuleModes[rulemode.Strict])
-	assert.False(t, customRuleModes[rulemode.Specification])
-	assert.True(t, customRuleModes[rulemode.Permissive])
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.customRuleModes[rulemode.Strict])
+	assert.False(t, configuration.customRuleModes[rulemode.Specification])
+	assert.True(t, configuration.customRuleModes[rulemode.Permissive])
 }
 
 func TestInitializeFormat(t *testing.T) {
 	flags := test.ConfigurationFlags()
 	flags.Set("format", "foo")
-	assert.Error(t, Initialize(flags, projectPaths))
+	_, err := Initialize(flags, projectPaths)
+	assert.Error(t, err)
 
 	flags.Set("format", "text")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.Equal(t, outputformat.Text, OutputFormat())
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, outputformat.Text, configuration.OutputFormat())
+
+	assert.Equal(t, outputformat.JSON, configuration.ReportFormat(), "Default report format")
 
 	flags.Set("format", "json")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.Equal(t, outputformat.JSON, OutputFormat())
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, outputformat.JSON, configuration.OutputFormat())
+	assert.Equal(t, outputformat.JSON, configuration.ReportFormat())
+
+	flags.Set("format", "sarif")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, outputformat.SARIF, configuration.OutputFormat())
+	assert.Equal(t, outputformat.JSON, configuration.ReportFormat(), "Report format is independent of output format")
+
+	flags.Set("format", "github")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, outputformat.GitHub, configuration.OutputFormat())
+	assert.Equal(t, outputformat.JSON, configuration.ReportFormat(), "Report format is independent of output format")
+
+	flags.Set("format", "jsonl")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, outputformat.JSONL, configuration.OutputFormat())
+	assert.Equal(t, outputformat.JSON, configuration.ReportFormat(), "Report format is independent of output format")
+
+	flags.Set("format", "codeclimate")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, outputformat.CodeClimate, configuration.OutputFormat())
+	assert.Equal(t, outputformat.JSON, configuration.ReportFormat(), "Report format is independent of output format")
+
+	flags.Set("format", "checkstyle")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, outputformat.Checkstyle, configuration.OutputFormat())
+	assert.Equal(t, outputformat.JSON, configuration.ReportFormat(), "Report format is independent of output format")
+}
+
+func TestInitializeFailOn(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, failon.Error, configuration.FailOn(), "Default")
+
+	flags.Set("fail-on", "foo")
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err)
+
+	flags.Set("fail-on", "warning")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, failon.Warning, configuration.FailOn())
+
+	flags.Set("fail-on", "info")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, failon.Info, configuration.FailOn())
+
+	flags.Set("fail-on", "never")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, failon.Never, configuration.FailOn())
 }
 
 func TestInitializeLibraryManager(t *testing.T) {
 	flags := test.ConfigurationFlags()
 	flags.Set("library-manager", "foo")
-	assert.Error(t, Initialize(flags, projectPaths))
+	_, err := Initialize(flags, projectPaths)
+	assert.Error(t, err)
 
-	customRuleModes = make(map[rulemode.Type]bool)
 	flags.Set("library-manager", "")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	_, ok := customRuleModes[rulemode.LibraryManagerSubmission]
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	_, ok := configuration.customRuleModes[rulemode.LibraryManagerSubmission]
 	assert.False(t, ok)
-	_, ok = customRuleModes[rulemode.LibraryManagerIndexed]
+	_, ok = configuration.customRuleModes[rulemode.LibraryManagerIndexed]
 	assert.False(t, ok)
 
 	flags.Set("library-manager", "submit")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.True(t, customRuleModes[rulemode.LibraryManagerSubmission])
-	assert.False(t, customRuleModes[rulemode.LibraryManagerIndexed])
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.True(t, configuration.customRuleModes[rulemode.LibraryManagerSubmission])
+	assert.False(t, configuration.customRuleModes[rulemode.LibraryManagerIndexed])
 
 	flags.Set("library-manager", "update")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.False(t, customRuleModes[rulemode.LibraryManagerSubmission])
-	assert.True(t, customRuleModes[rulemode.LibraryManagerIndexed])
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.customRuleModes[rulemode.LibraryManagerSubmission])
+	assert.True(t, configuration.customRuleModes[rulemode.LibraryManagerIndexed])
 
 	flags.Set("library-manager", "false")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.False(t, customRuleModes[rulemode.LibraryManagerSubmission])
-	assert.False(t, customRuleModes[rulemode.LibraryManagerIndexed])
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.customRuleModes[rulemode.LibraryManagerSubmission])
+	assert.False(t, configuration.customRuleModes[rulemode.LibraryManagerIndexed])
 
 	os.Setenv("ARDUINO_LINT_LIBRARY_MANAGER_INDEXING", "foo")
-	assert.Error(t, Initialize(flags, projectPaths))
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err)
 
 	flags.Set("library-manager", "")
 	os.Setenv("ARDUINO_LINT_LIBRARY_MANAGER_INDEXING", "true")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.False(t, customRuleModes[rulemode.LibraryManagerSubmission])
-	assert.False(t, customRuleModes[rulemode.LibraryManagerIndexed])
-	assert.True(t, customRuleModes[rulemode.LibraryManagerIndexing])
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.customRuleModes[rulemode.LibraryManagerSubmission])
+	assert.False(t, configuration.customRuleModes[rulemode.LibraryManagerIndexed])
+	assert.True(t, configuration.customRuleModes[rulemode.LibraryManagerIndexing])
+}
+
+func TestInitializeLibraryIndex(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Nil(t, configuration.LibraryIndexFilePath())
+
+	flags.Set("library-index", "/nonexistent")
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err)
+
+	libraryIndexFilePath, err := paths.New("configuration.go").Abs()
+	require.Nil(t, err)
+	flags.Set("library-index", libraryIndexFilePath.String())
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, libraryIndexFilePath, configuration.LibraryIndexFilePath())
+
+	os.Setenv("ARDUINO_LINT_LIBRARY_INDEX", "/nonexistent")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err, "Flag takes precedence over environment variable")
+	assert.Equal(t, libraryIndexFilePath, configuration.LibraryIndexFilePath())
+
+	flags = test.ConfigurationFlags()
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err)
+
+	os.Setenv("ARDUINO_LINT_LIBRARY_INDEX", libraryIndexFilePath.String())
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, libraryIndexFilePath, configuration.LibraryIndexFilePath())
+
+	os.Unsetenv("ARDUINO_LINT_LIBRARY_INDEX")
+}
+
+func TestInitializeOffline(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.Offline())
+
+	flags.Set("offline", "true")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.True(t, configuration.Offline())
 }
 
 func TestInitializeLogFormat(t *testing.T) {
-	os.Setenv("ARDUINO_LINT_LOG_FORMAT", "foo")
-	assert.Error(t, Initialize(test.ConfigurationFlags(), projectPaths), "Invalid format")
+	t.Setenv("ARDUINO_LINT_LOG_FORMAT", "foo")
+	assert.Error(t, InitializeLogging(), "Invalid format")
+	_, err := Initialize(test.ConfigurationFlags(), projectPaths)
+	assert.Nil(t, err, "Logging is not configured by Initialize")
 
-	os.Setenv("ARDUINO_LINT_LOG_FORMAT", "text")
-	assert.Nil(t, Initialize(test.ConfigurationFlags(), projectPaths), "text format")
+	t.Setenv("ARDUINO_LINT_LOG_FORMAT", "text")
+	assert.Nil(t, InitializeLogging(), "text format")
 
-	os.Setenv("ARDUINO_LINT_LOG_FORMAT", "json")
-	assert.Nil(t, Initialize(test.ConfigurationFlags(), projectPaths), "json format")
+	t.Setenv("ARDUINO_LINT_LOG_FORMAT", "json")
+	assert.Nil(t, InitializeLogging(), "json format")
 }
 
 func TestInitializeLogLevel(t *testing.T) {
-	require.Nil(t, Initialize(test.ConfigurationFlags(), projectPaths))
+	defer logrus.SetLevel(logrus.GetLevel())
 
-	os.Setenv("ARDUINO_LINT_LOG_LEVEL", "foo")
-	assert.Error(t, Initialize(test.ConfigurationFlags(), projectPaths), "Invalid level")
+	t.Setenv("ARDUINO_LINT_LOG_LEVEL", "foo")
+	assert.Error(t, InitializeLogging(), "Invalid level")
 
-	os.Setenv("ARDUINO_LINT_LOG_LEVEL", "info")
-	assert.Nil(t, Initialize(test.ConfigurationFlags(), projectPaths), "Valid level")
+	logrus.SetLevel(logrus.WarnLevel)
+	t.Setenv("ARDUINO_LINT_LOG_LEVEL", "info")
+	_, err := Initialize(test.ConfigurationFlags(), projectPaths)
+	require.Nil(t, err)
+	assert.Equal(t, logrus.WarnLevel, logrus.GetLevel(), "Logging is not configured by Initialize")
+	assert.Nil(t, InitializeLogging(), "Valid level")
 	assert.Equal(t, logrus.InfoLevel, logrus.GetLevel())
 }
 
@@ -144,104 +270,288 @@ func TestInitializeProjectType(t *testing.T) {
 	flags := test.ConfigurationFlags()
 
 	flags.Set("project-type", "foo")
-	assert.Error(t, Initialize(flags, projectPaths))
+	_, err := Initialize(flags, projectPaths)
+	assert.Error(t, err)
 
 	flags.Set("project-type", "sketch")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.Equal(t, projecttype.Sketch, SuperprojectTypeFilter())
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, projecttype.Sketch, configuration.SuperprojectTypeFilter())
 
 	flags.Set("project-type", "library")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.Equal(t, projecttype.Library, SuperprojectTypeFilter())
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, projecttype.Library, configuration.SuperprojectTypeFilter())
 
 	flags.Set("project-type", "platform")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.Equal(t, projecttype.Platform, SuperprojectTypeFilter())
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, projecttype.Platform, configuration.SuperprojectTypeFilter())
 
 	flags.Set("project-type", "package-index")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.Equal(t, projecttype.PackageIndex, SuperprojectTypeFilter())
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, projecttype.PackageIndex, configuration.SuperprojectTypeFilter())
 
 	flags.Set("project-type", "all")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.Equal(t, projecttype.All, SuperprojectTypeFilter())
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, projecttype.All, configuration.SuperprojectTypeFilter())
+}
+
+func TestInitializeRuleSelection(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Empty(t, configuration.EnableRules())
+	assert.Empty(t, configuration.DisableRules())
+	assert.Empty(t, configuration.OnlyRules())
+
+	flags.Set("enable-rules", "LP0*,boards.txt")
+	flags.Set("disable-rules", "PF01?")
+	flags.Set("only", "library.properties")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"LP0*", "boards.txt"}, configuration.EnableRules())
+	assert.Equal(t, []string{"PF01?"}, configuration.DisableRules())
+	assert.Equal(t, []string{"library.properties"}, configuration.OnlyRules())
+
+	for _, flagName := range []string{"enable-rules", "disable-rules", "only"} {
+		flags := test.ConfigurationFlags()
+		flags.Set(flagName, "LP[")
+		_, err = Initialize(flags, projectPaths)
+		assert.Error(t, err, "Malformed pattern")
+	}
 }
 
 func TestInitializeRecursive(t *testing.T) {
 	flags := test.ConfigurationFlags()
 
 	flags.Set("recursive", "true")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.True(t, Recursive())
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.True(t, configuration.Recursive())
 
 	flags.Set("recursive", "false")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.False(t, Recursive())
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.Recursive())
 }
 
 func TestInitializeReportFile(t *testing.T) {
 	flags := test.ConfigurationFlags()
 
 	flags.Set("report-file", "")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.Nil(t, ReportFilePath())
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Nil(t, configuration.ReportFilePath())
 
 	reportFilePath := paths.New("/bar")
 	flags.Set("report-file", reportFilePath.String())
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.Equal(t, reportFilePath, ReportFilePath())
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, reportFilePath, configuration.ReportFilePath())
+}
+
+func TestInitializeReportFormat(t *testing.T) {
+	flags := test.ConfigurationFlags()
+
+	flags.Set("report-file", "/bar/report.json")
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, outputformat.JSON, configuration.ReportFormat(), "Default for text output format")
+
+	flags.Set("format", "sarif")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, outputformat.JSON, configuration.ReportFormat(), "Output format doesn't affect report format")
+
+	flags.Set("report-file", "/bar/report.HTML")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, outputformat.HTML, configuration.ReportFormat(), "Format from report file extension")
+
+	flags.Set("report-file", "/bar/report.md")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, outputformat.Markdown, configuration.ReportFormat(), "Format from report file extension")
+
+	flags.Set("report-format", "junit")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, outputformat.JUnit, configuration.ReportFormat(), "Flag takes precedence over report file extension")
+
+	flags.Set("report-format", "text")
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err)
+
+	flags.Set("report-format", "github")
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err)
+
+	flags.Set("report-format", "jsonl")
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err)
+
+	flags.Set("report-format", "foo")
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err)
+}
+
+func TestInitializeTimings(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.Timings())
+
+	flags.Set("timings", "true")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.True(t, configuration.Timings())
+}
+
+func TestInitializeReportDetail(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.FullReportDetail())
+
+	flags.Set("report-detail", "full")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.True(t, configuration.FullReportDetail())
+
+	flags.Set("report-detail", "foo")
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err)
+}
+
+func TestInitializeReportVersion(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, reportversion.Latest, configuration.ReportVersion())
+
+	flags.Set("report-version", "1")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, reportversion.V1, configuration.ReportVersion())
+
+	flags.Set("report-version", "foo")
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err)
+}
+
+func TestInitializeBaseline(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Nil(t, configuration.BaselineFilePath())
+	assert.Nil(t, configuration.WriteBaselineFilePath())
+
+	flags.Set("baseline", "/nonexistent")
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err)
+
+	baselineFilePath, err := paths.New("configuration.go").Abs()
+	require.Nil(t, err)
+	flags.Set("baseline", baselineFilePath.String())
+	flags.Set("write-baseline", "/bar")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, baselineFilePath, configuration.BaselineFilePath())
+	assert.Equal(t, paths.New("/bar"), configuration.WriteBaselineFilePath())
+}
+
+func TestInitializeFix(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.FixMode())
+	assert.False(t, configuration.DryRun())
+
+	flags.Set("dry-run", "true")
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err, "--dry-run requires --fix")
+
+	flags.Set("fix", "true")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.True(t, configuration.FixMode())
+	assert.True(t, configuration.DryRun())
+
+	flags.Set("format", "json")
+	_, err = Initialize(flags, projectPaths)
+	assert.Error(t, err, "--dry-run requires text format")
+
+	flags.Set("dry-run", "false")
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.True(t, configuration.FixMode())
+	assert.False(t, configuration.DryRun())
 }
 
 func TestInitializeVersion(t *testing.T) {
 	flags := test.ConfigurationFlags()
 
 	flags.Set("version", "true")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.True(t, VersionMode())
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.True(t, configuration.VersionMode())
 
 	flags.Set("version", "false")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.False(t, VersionMode())
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.VersionMode())
 }
 
 func TestInitializeVerbose(t *testing.T) {
 	flags := test.ConfigurationFlags()
 
 	flags.Set("verbose", "true")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.True(t, Verbose())
+	configuration, err := Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.True(t, configuration.Verbose())
 
 	flags.Set("verbose", "false")
-	assert.Nil(t, Initialize(flags, projectPaths))
-	assert.False(t, Verbose())
+	configuration, err = Initialize(flags, projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.Verbose())
 }
 
 func TestInitializeProjectPath(t *testing.T) {
-	assert.Nil(t, Initialize(test.ConfigurationFlags(), []string{}))
+	configuration, err := Initialize(test.ConfigurationFlags(), []string{})
+	assert.Nil(t, err)
 	workingDirectoryPath, err := os.Getwd()
 	require.Nil(t, err)
-	assert.Equal(t, paths.NewPathList(workingDirectoryPath), TargetPaths(), "Default PROJECT_PATH to current working directory")
+	assert.Equal(t, paths.NewPathList(workingDirectoryPath), configuration.TargetPaths(), "Default PROJECT_PATH to current working directory")
 
-	assert.Nil(t, Initialize(test.ConfigurationFlags(), projectPaths))
-	assert.Equal(t, paths.NewPathList(projectPaths[0]), TargetPaths())
+	configuration, err = Initialize(test.ConfigurationFlags(), projectPaths)
+	assert.Nil(t, err)
+	assert.Equal(t, paths.NewPathList(projectPaths[0]), configuration.TargetPaths())
 
-	assert.Error(t, Initialize(test.ConfigurationFlags(), []string{"/nonexistent"}))
+	_, err = Initialize(test.ConfigurationFlags(), []string{"/nonexistent"})
+	assert.Error(t, err)
 }
 
 func TestInitializeOfficial(t *testing.T) {
-	assert.Nil(t, Initialize(test.ConfigurationFlags(), projectPaths))
-	assert.False(t, customRuleModes[rulemode.Official], "Default official rule mode")
+	configuration, err := Initialize(test.ConfigurationFlags(), projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.customRuleModes[rulemode.Official], "Default official rule mode")
 
 	os.Setenv("ARDUINO_LINT_OFFICIAL", "true")
-	assert.Nil(t, Initialize(test.ConfigurationFlags(), projectPaths))
-	assert.True(t, customRuleModes[rulemode.Official])
+	configuration, err = Initialize(test.ConfigurationFlags(), projectPaths)
+	assert.Nil(t, err)
+	assert.True(t, configuration.customRuleModes[rulemode.Official])
 
 	os.Setenv("ARDUINO_LINT_OFFICIAL", "false")
-	assert.Nil(t, Initialize(test.ConfigurationFlags(), projectPaths))
-	assert.False(t, customRuleModes[rulemode.Official])
+	configuration, err = Initialize(test.ConfigurationFlags(), projectPaths)
+	assert.Nil(t, err)
+	assert.False(t, configuration.customRuleModes[rulemode.Official])
 
 	os.Setenv("ARDUINO_LINT_OFFICIAL", "invalid value")
-	assert.Error(t, Initialize(test.ConfigurationFlags(), projectPaths))
+	_, err = Initialize(test.ConfigurationFlags(), projectPaths)
+	assert.Error(t, err)
 }
 
 func TestVersion(t *testing.T) {
@@ -258,3 +568,177 @@ func TestBuildTimestamp(t *testing.T) {
 	Timestamp = "2020-11-27T04:05:19+00:00"
 	assert.Equal(t, Timestamp, BuildTimestamp())
 }
+
+func TestInitializeConfigurationFile(t *testing.T) {
+	os.Unsetenv("ARDUINO_LINT_LIBRARY_MANAGER_INDEXING")
+	os.Unsetenv("ARDUINO_LINT_LIBRARY_INDEX")
+	os.Unsetenv("ARDUINO_LINT_OFFICIAL")
+
+	temporaryPath, err := paths.MkTempDir("", "arduino-lint-configuration-test")
+	require.Nil(t, err)
+	defer temporaryPath.RemoveAll()
+
+	projectPath := temporaryPath.Join("foo", "bar")
+	require.Nil(t, projectPath.MkdirAll())
+	configurationFilePath := temporaryPath.Join(".arduino-lint.yml")
+
+	configuration, err := Initialize(test.ConfigurationFlags(), []string{projectPath.String()})
+	assert.Nil(t, err)
+	assert.Nil(t, configuration.ConfigurationFilePath(), "No configuration file")
+	_, ok := configuration.RuleSetting("LP012")
+	assert.False(t, ok)
+
+	require.Nil(t, configurationFilePath.WriteFile([]byte(`
+compliance: strict
+library-manager: update
+recursive: false
+project-type: library
+format: json
+fail-on: warning
+rules:
+  LP012: off
+  lp013: Warning
+plugins:
+  - name: house-rules
+    command: [tools/house-rules, --strict]
+    rules: [hr001, HR002]
+  - name: vendor
+    command: [vendor-lint]
+    rules: [VR001]
+custom-rules:
+  - id: cr001
+    file: boards.txt
+    key: BOARD_ID.name
+    level: Warning
+    message: Board name must start with the vendor prefix.
+    regex: ^Example
+    max-length: 30
+`)))
+
+	flags := test.ConfigurationFlags()
+	configuration, err = Initialize(flags, []string{projectPath.String()})
+	assert.Nil(t, err)
+	assert.Equal(t, configurationFilePath, configuration.ConfigurationFilePath(), "Configuration file in parent folder")
+	assert.True(t, configuration.customRuleModes[rulemode.Strict])
+	assert.True(t, configuration.customRuleModes[rulemode.LibraryManagerIndexed])
+	assert.False(t, configuration.Recursive())
+	assert.Equal(t, projecttype.Library, configuration.SuperprojectTypeFilter())
+	assert.Equal(t, outputformat.JSON, configuration.OutputFormat())
+	assert.Equal(t, failon.Warning, configuration.FailOn())
+	ruleSetting, ok := configuration.RuleSetting("LP012")
+	assert.True(t, ok)
+	assert.Equal(t, RuleSettingOff, ruleSetting)
+	ruleSetting, ok = configuration.RuleSetting("LP013")
+	assert.True(t, ok, "Rule IDs are case insensitive")
+	assert.Equal(t, RuleSettingWarning, ruleSetting, "Rule settings are case insensitive")
+	assert.Empty(t, configuration.Plugins(), "Plugins of the configuration file found in the project path are not run")
+	assert.Nil(t, configuration.PluginsConfigurationFilePath())
+	maxLength := 30
+	assert.Equal(
+		t,
+		[]CustomRule{
+			{
+				ID:        "CR001",
+				File:      "boards.txt",
+				Key:       "BOARD_ID.name",
+				Level:     RuleSettingWarning,
+				Message:   "Board name must start with the vendor prefix.",
+				Regex:     "^Example",
+				MaxLength: &maxLength,
+			},
+		},
+		configuration.CustomRules(),
+	)
+
+	flags.Set("compliance", "permissive")
+	flags.Set("recursive", "true")
+	flags.Set("format", "text")
+	configuration, err = Initialize(flags, []string{projectPath.String()})
+	assert.Nil(t, err)
+	assert.True(t, configuration.customRuleModes[rulemode.Permissive], "Flag takes precedence over configuration file")
+	assert.True(t, configuration.Recursive(), "Flag takes precedence over configuration file")
+	assert.Equal(t, outputformat.Text, configuration.OutputFormat(), "Flag takes precedence over configuration file")
+
+	closerConfigurationFilePath := projectPath.Join(".arduino-lint.yaml")
+	require.Nil(t, closerConfigurationFilePath.WriteFile([]byte("compliance: specification\n")))
+	configuration, err = Initialize(test.ConfigurationFlags(), []string{projectPath.String()})
+	assert.Nil(t, err)
+	assert.Equal(t, closerConfigurationFilePath, configuration.ConfigurationFilePath(), "Closest configuration file is used")
+	_, err = Initialize(test.ConfigurationFlags(), []string{projectPath.String(), temporaryPath.Join("foo").String()})
+	assert.Error(t, err, "Conflicting configuration files")
+	require.Nil(t, closerConfigurationFilePath.Remove())
+
+	for _, configurationFileData := range []string{
+		"compliance: foo\n",
+		"format: foo\n",
+		"fail-on: foo\n",
+		"library-manager: foo\n",
+		"project-type: foo\n",
+		"recursive: foo\n",
+		"foo: bar\n",
+		"rules:\n  LP012: foo\n",
+		"plugins:\n  - command: [foo]\n    rules: [FR001]\n",
+		"plugins:\n  - name: foo\n    rules: [FR001]\n",
+		"plugins:\n  - name: foo\n    command: [foo]\n",
+		"plugins:\n  - name: foo\n    command: [foo]\n    rules: [FR001]\n  - name: foo\n    command: [bar]\n    rules: [FR002]\n",
+		"plugins:\n  - name: foo\n    command: [foo]\n    rules: [FR001]\n  - name: bar\n    command: [bar]\n    rules: [fr001]\n",
+		"custom-rules:\n  - file: boards.txt\n    key: name\n    level: error\n    message: foo\n    required: true\n",
+		"custom-rules:\n  - id: CR001\n    file: foo.txt\n    key: name\n    level: error\n    message: foo\n    required: true\n",
+		"custom-rules:\n  - id: CR001\n    file: boards.txt\n    key: name\n    level: foo\n    message: foo\n    required: true\n",
+		"custom-rules:\n  - id: CR001\n    file: boards.txt\n    key: name\n    level: error\n    message: foo\n",
+		"custom-rules:\n  - id: CR001\n    file: boards.txt\n    key: name\n    level: error\n    message: foo\n    foo: bar\n",
+		"plugins:\n  - name: foo\n    command: [foo]\n    rules: [CR001]\ncustom-rules:\n  - id: cr001\n    file: boards.txt\n    key: name\n    level: error\n    message: foo\n    required: true\n",
+	} {
+		require.Nil(t, configurationFilePath.WriteFile([]byte(configurationFileData)))
+		_, err = Initialize(test.ConfigurationFlags(), []string{projectPath.String()})
+		assert.Error(t, err, configurationFileData)
+	}
+}
+
+func TestInitializePluginsConfig(t *testing.T) {
+	temporaryPath, err := paths.MkTempDir("", "arduino-lint-configuration-test")
+	require.Nil(t, err)
+	defer temporaryPath.RemoveAll()
+
+	projectPath := temporaryPath.Join("project")
+	require.Nil(t, projectPath.MkdirAll())
+	require.Nil(t, projectPath.Join(".arduino-lint.yml").WriteFile([]byte("plugins:\n  - name: untrusted\n    command: [rm]\n    rules: [UR001]\n")))
+	pluginsConfigurationFilePath := temporaryPath.Join("plugins.yml")
+	require.Nil(t, pluginsConfigurationFilePath.WriteFile([]byte(`
+plugins:
+  - name: house-rules
+    command: [tools/house-rules, --strict]
+    rules: [hr001, HR002]
+  - name: vendor
+    command: [vendor-lint]
+    rules: [VR001]
+`)))
+
+	flags := test.ConfigurationFlags()
+	configuration, err := Initialize(flags, []string{projectPath.String()})
+	assert.Nil(t, err)
+	assert.Nil(t, configuration.PluginsConfigurationFilePath())
+	assert.Empty(t, configuration.Plugins(), "Plugins of the configuration file found in the project path are not run")
+
+	flags.Set("plugins-config", pluginsConfigurationFilePath.String())
+	configuration, err = Initialize(flags, []string{projectPath.String()})
+	assert.Nil(t, err)
+	assert.Equal(t, pluginsConfigurationFilePath, configuration.PluginsConfigurationFilePath())
+	assert.Equal(
+		t,
+		[]Plugin{
+			{
+				Name:    "house-rules",
+				Command: []string{temporaryPath.Join("tools", "house-rules").String(), "--strict"},
+				Rules:   []string{"HR001", "HR002"},
+			},
+			{Name: "vendor", Command: []string{"vendor-lint"}, Rules: []string{"VR001"}},
+		},
+		configuration.Plugins(),
+		"Relative plugin executable paths are relative to the plugins configuration file",
+	)
+
+	flags.Set("plugins-config", temporaryPath.Join("nonexistent.yml").String())
+	_, err = Initialize(flags, []string{projectPath.String()})
+	assert.Error(t, err)
+}
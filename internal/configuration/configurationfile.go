@@ -0,0 +1,230 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+// The configuration file, which allows the settings to be stored in the project repository.
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// configurationFileNames are the supported configuration file names, in order of precedence.
+var configurationFileNames = []string{".arduino-lint.yml", ".arduino-lint.yaml"}
+
+// The rule settings supported by the configuration file's `rules` key.
+const (
+	RuleSettingOff     = "off"     // Don't run the rule.
+	RuleSettingError   = "error"   // Use the error level for rule violations.
+	RuleSettingWarning = "warning" // Use the warning level for rule violations.
+	RuleSettingInfo    = "info"    // Use the info level for rule violations.
+)
+
+// configurationFileType is the type of the configuration file's data.
+type configurationFileType struct {
+	Compliance     string            `yaml:"compliance"`
+	LibraryManager string            `yaml:"library-manager"`
+	Recursive      *bool             `yaml:"recursive"`
+	ProjectType    string            `yaml:"project-type"`
+	Format         string            `yaml:"format"`
+	FailOn         string            `yaml:"fail-on"`
+	Rules          map[string]string `yaml:"rules"`
+	Plugins        []Plugin          `yaml:"plugins"`
+	CustomRules    []CustomRule      `yaml:"custom-rules"`
+}
+
+// customRuleFiles are the files that can be the target of a custom rule.
+var customRuleFiles = []string{"library.properties", "boards.txt", "platform.txt", "programmers.txt"}
+
+// CustomRule is the configuration of a rule defined in the configuration file, which checks the values of the matching
+// keys of a properties file.
+type CustomRule struct {
+	ID      string `yaml:"id"`
+	Brief   string `yaml:"brief"`   // Short description of the rule. Optional.
+	File    string `yaml:"file"`    // The properties file the rule applies to.
+	Key     string `yaml:"key"`     // Key pattern. Key components of the form BOARD_ID, MENU_ID, etc. match any component.
+	Level   string `yaml:"level"`   // The level of a violation of the rule: error, warning, or info.
+	Message string `yaml:"message"` // Message template, filled with the keys that violate the rule.
+	// The following fields define the conditions on the value of the keys. Each is optional, but at least one is required:
+	Required  bool                   `yaml:"required"`
+	Regex     string                 `yaml:"regex"`
+	Enum      []string               `yaml:"enum"`
+	MinLength *int                   `yaml:"min-length"`
+	MaxLength *int                   `yaml:"max-length"`
+	Schema    map[string]interface{} `yaml:"schema"` // JSON Schema the value must be valid against.
+}
+
+// Plugin is the configuration of an external rule executable, which provides organization-specific rules.
+type Plugin struct {
+	Name    string   `yaml:"name"`    // Identifies the plugin in the output. Also used as the subcategory of its rules.
+	Command []string `yaml:"command"` // The executable and its arguments. Run in the folder of the configuration file.
+	Rules   []string `yaml:"rules"`   // The IDs of the rules the plugin provides.
+}
+
+// findConfigurationFile searches the project paths and their parent folders for a configuration file.
+// nil is returned if no configuration file is found.
+func findConfigurationFile(projectPaths []string) (*paths.Path, error) {
+	if len(projectPaths) == 0 {
+		// Default to using current working directory.
+		workingDirectoryPath, err := os.Getwd()
+		if err != nil {
+			panic(err)
+		}
+		projectPaths = []string{workingDirectoryPath}
+	}
+
+	var foundPath *paths.Path
+	for _, projectPath := range projectPaths {
+		searchPath, err := paths.New(projectPath).Abs()
+		if err != nil {
+			return nil, err
+		}
+		if !searchPath.IsDir() {
+			// The path is a file (e.g., sketch primary file), or does not exist.
+			searchPath = searchPath.Parent()
+		}
+
+		configurationFilePath := searchConfigurationFile(searchPath)
+		if configurationFilePath == nil {
+			continue
+		}
+
+		if foundPath != nil && !foundPath.EqualsTo(configurationFilePath) {
+			return nil, fmt.Errorf("PROJECT_PATH arguments use different configuration files %s and %s", foundPath, configurationFilePath)
+		}
+		foundPath = configurationFilePath
+	}
+
+	return foundPath, nil
+}
+
+// searchConfigurationFile returns the path of the configuration file in the given folder or the closest of its parents.
+// nil is returned if no configuration file is found.
+func searchConfigurationFile(folderPath *paths.Path) *paths.Path {
+	for _, searchPath := range folderPath.Parents() {
+		for _, configurationFileName := range configurationFileNames {
+			configurationFilePath := searchPath.Join(configurationFileName)
+			if configurationFilePath.IsNotDir() {
+				return configurationFilePath
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadConfigurationFile parses the configuration file at the given path.
+func loadConfigurationFile(configurationFilePath *paths.Path) (configurationFileType, error) {
+	var configurationFile configurationFileType
+
+	configurationFileData, err := configurationFilePath.ReadFile()
+	if err != nil {
+		return configurationFile, fmt.Errorf("Unable to read configuration file %s: %v", configurationFilePath, err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(configurationFileData))
+	decoder.KnownFields(true) // Catch misspelled keys.
+	if err := decoder.Decode(&configurationFile); err != nil && !errors.Is(err, io.EOF) {
+		return configurationFile, fmt.Errorf("Unable to parse configuration file %s: %v", configurationFilePath, err)
+	}
+
+	rules := make(map[string]string)
+	for ruleID, ruleSetting := range configurationFile.Rules {
+		ruleSetting = strings.ToLower(ruleSetting)
+		switch ruleSetting {
+		case RuleSettingOff, RuleSettingError, RuleSettingWarning, RuleSettingInfo:
+			rules[strings.ToUpper(ruleID)] = ruleSetting
+		default:
+			return configurationFile, fmt.Errorf("Configuration file %s rule %s setting %s not valid", configurationFilePath, ruleID, ruleSetting)
+		}
+	}
+	configurationFile.Rules = rules
+
+	pluginNames := make(map[string]bool)
+	ruleIDs := make(map[string]bool) // The IDs of the rules defined in the configuration file.
+	for pluginIndex, plugin := range configurationFile.Plugins {
+		if plugin.Name == "" || len(plugin.Command) == 0 || plugin.Command[0] == "" || len(plugin.Rules) == 0 {
+			return configurationFile, fmt.Errorf("Configuration file %s plugin %s requires name, command, and rules", configurationFilePath, plugin.Name)
+		}
+		if pluginNames[plugin.Name] {
+			return configurationFile, fmt.Errorf("Configuration file %s plugin name %s is not unique", configurationFilePath, plugin.Name)
+		}
+		pluginNames[plugin.Name] = true
+
+		for ruleIndex, ruleID := range plugin.Rules {
+			ruleID = strings.ToUpper(ruleID)
+			if ruleIDs[ruleID] {
+				return configurationFile, fmt.Errorf("Configuration file %s plugin %s rule ID %s is not unique", configurationFilePath, plugin.Name, ruleID)
+			}
+			ruleIDs[ruleID] = true
+			configurationFile.Plugins[pluginIndex].Rules[ruleIndex] = ruleID
+		}
+
+		executablePath := paths.New(plugin.Command[0])
+		if !executablePath.IsAbs() && strings.ContainsAny(plugin.Command[0], `/\`) {
+			// Executables without a path are looked up in PATH. The path of other executables is relative to the
+			// configuration file, regardless of the working directory.
+			configurationFile.Plugins[pluginIndex].Command[0] = configurationFilePath.Parent().JoinPath(executablePath).String()
+		}
+	}
+
+	for customRuleIndex, customRule := range configurationFile.CustomRules {
+		customRule.ID = strings.ToUpper(customRule.ID)
+		customRule.Level = strings.ToLower(customRule.Level)
+		if customRule.ID == "" || customRule.File == "" || customRule.Key == "" || customRule.Level == "" || customRule.Message == "" {
+			return configurationFile, fmt.Errorf("Configuration file %s custom rule %s requires id, file, key, level, and message", configurationFilePath, customRule.ID)
+		}
+		if ruleIDs[customRule.ID] {
+			return configurationFile, fmt.Errorf("Configuration file %s custom rule ID %s is not unique", configurationFilePath, customRule.ID)
+		}
+		ruleIDs[customRule.ID] = true
+		if !slices.Contains(customRuleFiles, customRule.File) {
+			return configurationFile, fmt.Errorf("Configuration file %s custom rule %s file %s not valid", configurationFilePath, customRule.ID, customRule.File)
+		}
+		switch customRule.Level {
+		case RuleSettingError, RuleSettingWarning, RuleSettingInfo:
+		default:
+			return configurationFile, fmt.Errorf("Configuration file %s custom rule %s level %s not valid", configurationFilePath, customRule.ID, customRule.Level)
+		}
+		if !customRule.Required && customRule.Regex == "" && customRule.Enum == nil && customRule.MinLength == nil && customRule.MaxLength == nil && customRule.Schema == nil {
+			return configurationFile, fmt.Errorf("Configuration file %s custom rule %s has no condition", configurationFilePath, customRule.ID)
+		}
+		configurationFile.CustomRules[customRuleIndex] = customRule
+	}
+
+	return configurationFile, nil
+}
+
+// stringSetting returns the value of the given string flag, falling back to the configuration file value if the flag was not set by the user.
+// The source of the value is returned for use in error messages.
+func stringSetting(flags *pflag.FlagSet, flagName string, configurationFileValue string, configurationFilePath *paths.Path) (value string, source string) {
+	value, _ = flags.GetString(flagName)
+	if flags.Changed(flagName) || configurationFileValue == "" {
+		return value, fmt.Sprintf("--%s flag", flagName)
+	}
+
+	return configurationFileValue, fmt.Sprintf("Configuration file %s %s setting", configurationFilePath, flagName)
+}
@@ -18,6 +18,10 @@
 package general
 
 import (
+	"strings"
+	"unicode"
+
+	"github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
 )
 
@@ -66,3 +70,107 @@ func PropertiesToList(flatProperties *properties.Map, key string) map[string]int
 	mapInterface[key] = listInterface
 	return mapInterface
 }
+
+// PropertiesKeyLines returns the line number of the definition of each key in the properties file at the given path.
+// When a key is defined multiple times, the line of the last definition is used, since that is the one that takes effect.
+func PropertiesKeyLines(propertiesPath *paths.Path) (map[string]int, error) {
+	lines, err := propertiesPath.ReadFileAsLines()
+	if err != nil {
+		return nil, err
+	}
+
+	keyLines := make(map[string]int)
+	for lineIndex, line := range lines {
+		line = strings.TrimSpace(line)
+		if lineIndex == 0 {
+			line = strings.TrimPrefix(line, "\ufeff") // Strip UTF-8 BOM.
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, _, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		keyLines[strings.TrimSpace(key)] = lineIndex + 1
+	}
+
+	return keyLines, nil
+}
+
+// The prefixes of the comments that suppress rules in properties files.
+const (
+	suppressNextLineDirective = "arduino-lint-disable-next-line"
+	suppressFileDirective     = "arduino-lint-disable"
+)
+
+// PropertiesSuppressions is the rule suppression data from the comments of a properties file.
+type PropertiesSuppressions struct {
+	FileRuleIDs map[string]bool         // IDs of the rules suppressed in the entire file.
+	LineRuleIDs map[int]map[string]bool // IDs of the rules suppressed on each line, by line number.
+}
+
+// Suppressed returns whether the rule with the given ID is suppressed on the given line of the file.
+// A line number of 0 means the location in the file is not known, so only suppression for the entire file applies.
+func (suppressions PropertiesSuppressions) Suppressed(ruleID string, line int) bool {
+	return suppressions.FileRuleIDs[ruleID] || suppressions.LineRuleIDs[line][ruleID]
+}
+
+// PropertiesSuppressionsFromPath parses the rule suppression comments of the properties file at the given path.
+// `# arduino-lint-disable-next-line RULE_ID...` suppresses the rules on the next key definition line.
+// `# arduino-lint-disable RULE_ID...` suppresses the rules in the entire file.
+func PropertiesSuppressionsFromPath(propertiesPath *paths.Path) (PropertiesSuppressions, error) {
+	suppressions := PropertiesSuppressions{
+		FileRuleIDs: make(map[string]bool),
+		LineRuleIDs: make(map[int]map[string]bool),
+	}
+
+	lines, err := propertiesPath.ReadFileAsLines()
+	if err != nil {
+		return suppressions, err
+	}
+
+	pendingRuleIDs := make(map[string]bool) // Rules to suppress on the next key definition line.
+	for lineIndex, line := range lines {
+		line = strings.TrimSpace(line)
+		if lineIndex == 0 {
+			line = strings.TrimPrefix(line, "\ufeff") // Strip UTF-8 BOM.
+		}
+		if line == "" {
+			continue
+		}
+
+		if comment, isComment := strings.CutPrefix(line, "#"); isComment {
+			directive, ruleIDs := suppressionDirective(comment)
+			for _, ruleID := range ruleIDs {
+				switch directive {
+				case suppressNextLineDirective:
+					pendingRuleIDs[ruleID] = true
+				case suppressFileDirective:
+					suppressions.FileRuleIDs[ruleID] = true
+				}
+			}
+			continue
+		}
+
+		if len(pendingRuleIDs) > 0 {
+			suppressions.LineRuleIDs[lineIndex+1] = pendingRuleIDs
+			pendingRuleIDs = make(map[string]bool)
+		}
+	}
+
+	return suppressions, nil
+}
+
+// suppressionDirective returns the suppression directive and rule IDs of the given comment text.
+func suppressionDirective(comment string) (string, []string) {
+	fields := strings.FieldsFunc(comment, func(character rune) bool {
+		return character == ',' || unicode.IsSpace(character)
+	})
+	if len(fields) == 0 || (fields[0] != suppressNextLineDirective && fields[0] != suppressFileDirective) {
+		return "", nil
+	}
+
+	return fields[0], fields[1:]
+}
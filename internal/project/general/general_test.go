@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -123,3 +124,52 @@ func TestPropertiesToList(t *testing.T) {
 	}
 	assert.True(t, reflect.DeepEqual(expectedMapOutput, PropertiesToList(propertiesInput, "foo")))
 }
+
+func TestPropertiesKeyLines(t *testing.T) {
+	propertiesFolder, err := paths.MkTempDir("", "arduino-lint-general-TestPropertiesKeyLines")
+	require.Nil(t, err)
+	defer propertiesFolder.RemoveAll() // clean up
+	propertiesPath := propertiesFolder.Join("foo.properties")
+
+	_, err = PropertiesKeyLines(propertiesPath)
+	assert.Error(t, err, "Nonexistent file")
+
+	require.Nil(t, propertiesPath.WriteFile([]byte("\ufeffhello=world\n# foo=bar\n\n  foo.bar = asdf\nbaz\nhello=again\n")))
+	keyLines, err := PropertiesKeyLines(propertiesPath)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]int{"hello": 6, "foo.bar": 4}, keyLines)
+}
+
+func TestPropertiesSuppressionsFromPath(t *testing.T) {
+	propertiesFolder, err := paths.MkTempDir("", "arduino-lint-general-TestPropertiesSuppressionsFromPath")
+	require.Nil(t, err)
+	defer propertiesFolder.RemoveAll() // clean up
+	propertiesPath := propertiesFolder.Join("foo.properties")
+
+	_, err = PropertiesSuppressionsFromPath(propertiesPath)
+	assert.Error(t, err, "Nonexistent file")
+
+	propertiesData := `# arduino-lint-disable XX001
+hello=world
+# arduino-lint-disable-next-line XX002, XX003
+# arduino-lint-disable-next-line XX004
+
+foo=bar
+# arduino-lint-disable-next-line
+# arduino-lint-disable-next-lines XX005
+baz=qux
+`
+	require.Nil(t, propertiesPath.WriteFile([]byte(propertiesData)))
+	suppressions, err := PropertiesSuppressionsFromPath(propertiesPath)
+	require.Nil(t, err)
+
+	assert.True(t, suppressions.Suppressed("XX001", 0), "File suppression applies when line is unknown")
+	assert.True(t, suppressions.Suppressed("XX001", 9), "File suppression applies to all lines")
+	assert.False(t, suppressions.Suppressed("XX002", 2))
+	assert.True(t, suppressions.Suppressed("XX002", 6), "Next line suppression applies to next key definition")
+	assert.True(t, suppressions.Suppressed("XX003", 6), "Multiple rules per directive")
+	assert.True(t, suppressions.Suppressed("XX004", 6), "Consecutive directives are combined")
+	assert.False(t, suppressions.Suppressed("XX002", 0), "Next line suppression doesn't apply when line is unknown")
+	assert.False(t, suppressions.Suppressed("XX005", 9), "Unknown directive is ignored")
+	assert.Equal(t, map[int]map[string]bool{6: {"XX002": true, "XX003": true, "XX004": true}}, suppressions.LineRuleIDs)
+}
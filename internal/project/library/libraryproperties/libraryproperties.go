@@ -18,6 +18,8 @@
 package libraryproperties
 
 import (
+	"sync"
+
 	"github.com/arduino/arduino-lint/internal/project/general"
 	"github.com/arduino/arduino-lint/internal/rule/schema"
 	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
@@ -31,7 +33,18 @@ func Properties(libraryPath *paths.Path) (*properties.Map, error) {
 	return properties.SafeLoadFromPath(libraryPath.Join("library.properties"))
 }
 
+// Suppressions parses the rule suppression comments of the library.properties from the given path.
+// As with Properties, a missing library.properties is not an error.
+func Suppressions(libraryPath *paths.Path) (general.PropertiesSuppressions, error) {
+	libraryPropertiesPath := libraryPath.Join("library.properties")
+	if !libraryPropertiesPath.Exist() {
+		return general.PropertiesSuppressions{}, nil
+	}
+	return general.PropertiesSuppressionsFromPath(libraryPropertiesPath)
+}
+
 var schemaObject = make(map[compliancelevel.Type]schema.Schema)
+var compileSchemaObjectOnce sync.Once
 
 // Validate validates library.properties data against the JSON schema and returns a map of the result for each compliance level.
 func Validate(libraryProperties *properties.Map) map[compliancelevel.Type]schema.ValidationResult {
@@ -42,11 +55,11 @@ func Validate(libraryProperties *properties.Map) map[compliancelevel.Type]schema
 
 	var validationResults = make(map[compliancelevel.Type]schema.ValidationResult)
 
-	if schemaObject[compliancelevel.Permissive].Compiled == nil { // Only compile the schemas once.
+	compileSchemaObjectOnce.Do(func() { // Only compile the schemas once.
 		schemaObject[compliancelevel.Permissive] = schema.Compile("arduino-library-properties-permissive-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Specification] = schema.Compile("arduino-library-properties-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Strict] = schema.Compile("arduino-library-properties-strict-schema.json", referencedSchemaFilenames, schemadata.Asset)
-	}
+	})
 
 	// Convert the library.properties data from the native properties.Map type to the interface type required by the schema
 	// validation package.
@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sync"
 
 	"github.com/arduino/arduino-lint/internal/rule/schema"
 	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
@@ -50,6 +51,7 @@ func Properties(packageIndexPath *paths.Path) (map[string]interface{}, error) {
 }
 
 var schemaObject = make(map[compliancelevel.Type]schema.Schema)
+var compileSchemaObjectOnce sync.Once
 
 // Validate validates boards.txt data against the JSON schema and returns a map of the result for each compliance level.
 func Validate(packageIndex map[string]interface{}) map[compliancelevel.Type]schema.ValidationResult {
@@ -60,11 +62,11 @@ func Validate(packageIndex map[string]interface{}) map[compliancelevel.Type]sche
 
 	var validationResults = make(map[compliancelevel.Type]schema.ValidationResult)
 
-	if schemaObject[compliancelevel.Permissive].Compiled == nil { // Only compile the schemas once.
+	compileSchemaObjectOnce.Do(func() { // Only compile the schemas once.
 		schemaObject[compliancelevel.Permissive] = schema.Compile("arduino-package-index-permissive-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Specification] = schema.Compile("arduino-package-index-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Strict] = schema.Compile("arduino-package-index-strict-schema.json", referencedSchemaFilenames, schemadata.Asset)
-	}
+	})
 
 	validationResults[compliancelevel.Permissive] = schema.Validate(packageIndex, schemaObject[compliancelevel.Permissive])
 	validationResults[compliancelevel.Specification] = schema.Validate(packageIndex, schemaObject[compliancelevel.Specification])
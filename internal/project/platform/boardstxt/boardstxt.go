@@ -22,6 +22,7 @@ package boardstxt
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/arduino/arduino-lint/internal/project/general"
 	"github.com/arduino/arduino-lint/internal/rule/schema"
@@ -36,7 +37,13 @@ func Properties(platformPath *paths.Path) (*properties.Map, error) {
 	return properties.LoadFromPath(platformPath.Join("boards.txt"))
 }
 
+// Suppressions parses the rule suppression comments of the boards.txt from the given path.
+func Suppressions(platformPath *paths.Path) (general.PropertiesSuppressions, error) {
+	return general.PropertiesSuppressionsFromPath(platformPath.Join("boards.txt"))
+}
+
 var schemaObject = make(map[compliancelevel.Type]schema.Schema)
+var compileSchemaObjectOnce sync.Once
 
 // Validate validates boards.txt data against the JSON schema and returns a map of the result for each compliance level.
 func Validate(boardsTxt *properties.Map) map[compliancelevel.Type]schema.ValidationResult {
@@ -47,11 +54,11 @@ func Validate(boardsTxt *properties.Map) map[compliancelevel.Type]schema.Validat
 
 	var validationResults = make(map[compliancelevel.Type]schema.ValidationResult)
 
-	if schemaObject[compliancelevel.Permissive].Compiled == nil { // Only compile the schemas once.
+	compileSchemaObjectOnce.Do(func() { // Only compile the schemas once.
 		schemaObject[compliancelevel.Permissive] = schema.Compile("arduino-boards-txt-permissive-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Specification] = schema.Compile("arduino-boards-txt-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Strict] = schema.Compile("arduino-boards-txt-strict-schema.json", referencedSchemaFilenames, schemadata.Asset)
-	}
+	})
 
 	//Convert the boards.txt data from the native properties.Map type to the interface type required by the schema validation package.
 	boardsTxtInterface := make(map[string]interface{})
@@ -23,6 +23,7 @@ package platformtxt
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/arduino/arduino-lint/internal/project/general"
 	"github.com/arduino/arduino-lint/internal/rule/schema"
@@ -37,7 +38,13 @@ func Properties(platformPath *paths.Path) (*properties.Map, error) {
 	return properties.LoadFromPath(platformPath.Join("platform.txt"))
 }
 
+// Suppressions parses the rule suppression comments of the platform.txt from the given path.
+func Suppressions(platformPath *paths.Path) (general.PropertiesSuppressions, error) {
+	return general.PropertiesSuppressionsFromPath(platformPath.Join("platform.txt"))
+}
+
 var schemaObject = make(map[compliancelevel.Type]schema.Schema)
+var compileSchemaObjectOnce sync.Once
 
 // Validate validates platform.txt data against the JSON schema and returns a map of the result for each compliance level.
 func Validate(platformTxt *properties.Map) map[compliancelevel.Type]schema.ValidationResult {
@@ -48,11 +55,11 @@ func Validate(platformTxt *properties.Map) map[compliancelevel.Type]schema.Valid
 
 	var validationResults = make(map[compliancelevel.Type]schema.ValidationResult)
 
-	if schemaObject[compliancelevel.Permissive].Compiled == nil { // Only compile the schemas once.
+	compileSchemaObjectOnce.Do(func() { // Only compile the schemas once.
 		schemaObject[compliancelevel.Permissive] = schema.Compile("arduino-platform-txt-permissive-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Specification] = schema.Compile("arduino-platform-txt-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Strict] = schema.Compile("arduino-platform-txt-strict-schema.json", referencedSchemaFilenames, schemadata.Asset)
-	}
+	})
 
 	/*
 		Convert the platform.txt data from the native properties.Map type to the interface type required by the schema
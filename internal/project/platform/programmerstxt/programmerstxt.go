@@ -21,6 +21,8 @@ See: https://arduino.github.io/arduino-cli/latest/platform-specification/#progra
 package programmerstxt
 
 import (
+	"sync"
+
 	"github.com/arduino/arduino-lint/internal/project/general"
 	"github.com/arduino/arduino-lint/internal/rule/schema"
 	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
@@ -35,6 +37,7 @@ func Properties(platformPath *paths.Path) (*properties.Map, error) {
 }
 
 var schemaObject = make(map[compliancelevel.Type]schema.Schema)
+var compileSchemaObjectOnce sync.Once
 
 // Validate validates programmers.txt data against the JSON schema and returns the result.
 func Validate(programmersTxt *properties.Map) map[compliancelevel.Type]schema.ValidationResult {
@@ -44,11 +47,11 @@ func Validate(programmersTxt *properties.Map) map[compliancelevel.Type]schema.Va
 
 	var validationResults = make(map[compliancelevel.Type]schema.ValidationResult)
 
-	if schemaObject[compliancelevel.Permissive].Compiled == nil { // Only compile the schemas once.
+	compileSchemaObjectOnce.Do(func() { // Only compile the schemas once.
 		schemaObject[compliancelevel.Permissive] = schema.Compile("arduino-programmers-txt-permissive-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Specification] = schema.Compile("arduino-programmers-txt-schema.json", referencedSchemaFilenames, schemadata.Asset)
 		schemaObject[compliancelevel.Strict] = schema.Compile("arduino-programmers-txt-strict-schema.json", referencedSchemaFilenames, schemadata.Asset)
-	}
+	})
 
 	//Convert the programmers.txt data from the native properties.Map type to the interface type required by the schema validation package.
 	programmersTxtInterface := general.PropertiesToMap(programmersTxt, 2)
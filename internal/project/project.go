@@ -40,11 +40,11 @@ type Type struct {
 
 // FindProjects searches the target path configured by the user for projects of the type configured by the user as well as the subprojects of those project.
 // It returns a slice containing the definitions of each found project.
-func FindProjects() ([]Type, error) {
+func FindProjects(toolConfiguration *configuration.Type) ([]Type, error) {
 	var foundProjects []Type
 
-	for _, targetPath := range configuration.TargetPaths() {
-		foundProjectsForTargetPath, err := findProjects(targetPath)
+	for _, targetPath := range toolConfiguration.TargetPaths() {
+		foundProjectsForTargetPath, err := findProjects(targetPath, toolConfiguration)
 		if err != nil {
 			return nil, err
 		}
@@ -54,7 +54,7 @@ func FindProjects() ([]Type, error) {
 }
 
 // findProjects handles the recursion for FindProjects().
-func findProjects(targetPath *paths.Path) ([]Type, error) {
+func findProjects(targetPath *paths.Path, toolConfiguration *configuration.Type) ([]Type, error) {
 	var foundParentProjects []Type
 
 	// If targetPath is a file, targetPath itself is the project, so it's only necessary to determine/verify the type.
@@ -62,14 +62,14 @@ func findProjects(targetPath *paths.Path) ([]Type, error) {
 		logrus.Debug("Projects path is file")
 		var isProject bool
 		var projectType projecttype.Type
-		if configuration.SuperprojectTypeFilter() == projecttype.All {
+		if toolConfiguration.SuperprojectTypeFilter() == projecttype.All {
 			// Project type detection is required.
 			// The filename provides additional information about the project type. So rather than using isProject(), which doesn't make use this information, use a specialized function that does.
-			isProject, projectType = isProjectIndicatorFile(targetPath, configuration.SuperprojectTypeFilter())
+			isProject, projectType = isProjectIndicatorFile(targetPath, toolConfiguration.SuperprojectTypeFilter())
 		} else {
 			// Project was explicitly defined by user.
 			isProject = true
-			projectType = configuration.SuperprojectTypeFilter()
+			projectType = toolConfiguration.SuperprojectTypeFilter()
 		}
 
 		if isProject {
@@ -87,16 +87,16 @@ func findProjects(targetPath *paths.Path) ([]Type, error) {
 			foundParentProjects = append(foundParentProjects, foundProject)
 		}
 	} else {
-		if configuration.SuperprojectTypeFilter() == projecttype.All || configuration.Recursive() {
+		if toolConfiguration.SuperprojectTypeFilter() == projecttype.All || toolConfiguration.Recursive() {
 			// Project discovery and/or type detection is required.
-			foundParentProjects = findProjectsUnderPath(targetPath, configuration.SuperprojectTypeFilter(), configuration.Recursive(), 0)
+			foundParentProjects = findProjectsUnderPath(targetPath, toolConfiguration.SuperprojectTypeFilter(), toolConfiguration.Recursive(), 0)
 		} else {
 			// Project was explicitly defined by user.
 			foundParentProjects = append(foundParentProjects,
 				Type{
 					Path:             targetPath,
-					ProjectType:      configuration.SuperprojectTypeFilter(),
-					SuperprojectType: configuration.SuperprojectTypeFilter(),
+					ProjectType:      toolConfiguration.SuperprojectTypeFilter(),
+					SuperprojectType: toolConfiguration.SuperprojectTypeFilter(),
 				},
 			)
 		}
@@ -58,9 +58,10 @@ func TestSymlinkLoop(t *testing.T) {
 	err = os.Symlink(examplesPath.Join("..").String(), examplesPath.Join("UpGoer2").String())
 	require.Nil(t, err)
 
-	configuration.Initialize(test.ConfigurationFlags(), []string{libraryPath.String()})
+	toolConfiguration, err := configuration.Initialize(test.ConfigurationFlags(), []string{libraryPath.String()})
+	require.Nil(t, err)
 
-	assert.Panics(t, func() { FindProjects() }, "Infinite symlink loop encountered during project discovery")
+	assert.Panics(t, func() { FindProjects(toolConfiguration) }, "Infinite symlink loop encountered during project discovery")
 }
 
 func TestBrokenSymlink(t *testing.T) {
@@ -84,9 +85,10 @@ func TestBrokenSymlink(t *testing.T) {
 	flags := test.ConfigurationFlags()
 	flags.Set("project-type", "all")
 	flags.Set("recursive", "true")
-	configuration.Initialize(flags, []string{projectsPath.String()})
+	toolConfiguration, err := configuration.Initialize(flags, []string{projectsPath.String()})
+	require.Nil(t, err)
 
-	foundProjects, err := FindProjects()
+	foundProjects, err := FindProjects(toolConfiguration)
 	require.Nil(t, err)
 	assert.True(
 		t,
@@ -410,8 +412,9 @@ func TestFindProjects(t *testing.T) {
 				if recursive != "" {
 					flags.Set("recursive", recursive)
 				}
-				configuration.Initialize(flags, testTable.projectPaths)
-				foundProjects, err := FindProjects()
+				toolConfiguration, err := configuration.Initialize(flags, testTable.projectPaths)
+				require.Nil(t, err)
+				foundProjects, err := FindProjects(toolConfiguration)
 				testTable.errorAssertion(t, err)
 				if err == nil {
 					assert.True(
@@ -441,7 +444,8 @@ func TestExamplefile(t *testing.T) {
 	err = libraryPath.Join("example").WriteFile([]byte{})
 	require.Nil(t, err)
 
-	configuration.Initialize(test.ConfigurationFlags(), []string{libraryPath.String()})
+	toolConfiguration, err := configuration.Initialize(test.ConfigurationFlags(), []string{libraryPath.String()})
+	require.Nil(t, err)
 
-	assert.NotPanics(t, func() { FindProjects() }, "Example file should not cause panic")
+	assert.NotPanics(t, func() { FindProjects(toolConfiguration) }, "Example file should not cause panic")
 }
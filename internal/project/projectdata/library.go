@@ -17,17 +17,16 @@
 package projectdata
 
 import (
+	"fmt"
 	"io"
 	"net/http"
-	"os"
+	"sync"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
-	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/library/libraryproperties"
-	"github.com/arduino/arduino-lint/internal/result/feedback"
 	"github.com/arduino/arduino-lint/internal/rule/schema"
 	"github.com/arduino/arduino-lint/internal/rule/schema/compliancelevel"
 	"github.com/arduino/go-paths-helper"
@@ -36,113 +35,189 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// InitializeForLibrary gathers the library rule data for the specified project.
-func InitializeForLibrary(project project.Type) {
+// libraryData is the type for the data of library projects.
+type libraryData struct {
+	libraryPropertiesLoadError              error
+	libraryProperties                       *properties.Map
+	libraryPropertiesSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
+	loadedLibrary                           *libraries.Library
+	sourceHeaders                           []string
+}
+
+// initializeForLibrary gathers the library rule data for the specified project.
+func (projectData *Type) initializeForLibrary(project project.Type) {
 	var err error
 
-	libraryProperties, libraryPropertiesLoadError = libraryproperties.Properties(project.Path)
-	if libraryPropertiesLoadError != nil {
-		logrus.Errorf("Error loading library.properties from %s: %s", project.Path, libraryPropertiesLoadError)
-		libraryPropertiesSchemaValidationResult = nil
+	projectData.libraryProperties, projectData.libraryPropertiesLoadError = libraryproperties.Properties(project.Path)
+	if projectData.libraryPropertiesLoadError != nil {
+		logrus.Errorf("Error loading library.properties from %s: %s", project.Path, projectData.libraryPropertiesLoadError)
+		projectData.libraryPropertiesSchemaValidationResult = nil
 	} else {
-		libraryPropertiesSchemaValidationResult = libraryproperties.Validate(libraryProperties)
+		projectData.libraryPropertiesSchemaValidationResult = libraryproperties.Validate(projectData.libraryProperties)
+
+		projectData.propertiesSuppressions[project.Path.Join("library.properties").String()], err = libraryproperties.Suppressions(project.Path)
+		if err != nil {
+			panic(err)
+		}
 	}
 
-	loadedLibrary, err = libraries.Load(project.Path, libraries.User)
+	projectData.loadedLibrary, err = libraries.Load(project.Path, libraries.User)
 	if err != nil {
 		logrus.Errorf("Error loading library from %s: %s", project.Path, err)
-		loadedLibrary = nil
-		sourceHeaders = nil
+		projectData.loadedLibrary = nil
+		projectData.sourceHeaders = nil
 	} else {
-		sourceHeaders, err = loadedLibrary.SourceHeaders()
+		projectData.sourceHeaders, err = projectData.loadedLibrary.SourceHeaders()
 		if err != nil {
 			panic(err)
 		}
 	}
 
-	// Download the Library Manager index if needed.
-	if !configuration.RuleModes(project.SuperprojectType)[rulemode.LibraryManagerIndexing] && libraryManagerIndex == nil {
-		// Set up the temporary folder for the index
-		libraryIndexFolderPath, err := paths.TempDir().MkTempDir("arduino-lint-library-index-folder")
-		defer libraryIndexFolderPath.RemoveAll()
-		if err != nil {
-			panic(err)
+	// The shared data is only gathered once per run, even when multiple projects are checked at the same time.
+	sharedLibraryDataMutex.Lock()
+	defer sharedLibraryDataMutex.Unlock()
+
+	if misspelledWordsReplacer == nil { // The replacer only needs to be compiled once per run.
+		misspelledWordsReplacer = misspell.New()
+		misspelledWordsReplacer.Compile()
+	}
+}
+
+// LibraryManagerIndexType is the type for the Library Manager index data, which is shared by the projects of a lint run.
+// The index is not loaded by Initialize, so that it is not downloaded when none of the rules that use it are run. The
+// zero value is an index that has not been loaded yet.
+type LibraryManagerIndexType struct {
+	mutex     sync.Mutex
+	index     *librariesmanager.LibrariesManager
+	loadError error
+	failed    bool
+}
+
+// Err returns the error that prevented loading the Library Manager index when it was needed, or nil if there was none.
+// The index not being available in offline mode is not a failure.
+func (libraryManagerIndex *LibraryManagerIndexType) Err() error {
+	libraryManagerIndex.mutex.Lock()
+	defer libraryManagerIndex.mutex.Unlock()
+
+	if !libraryManagerIndex.failed {
+		return nil
+	}
+	return libraryManagerIndex.loadError
+}
+
+// LoadLibraryManagerIndex loads the Library Manager index if needed and not already loaded.
+// The index is otherwise loaded on first use, so this allows the time taken to load it to be part of the project
+// initialization rather than of the first rule that uses it.
+func (projectData *Type) LoadLibraryManagerIndex() {
+	projectData.initializeLibraryManagerIndex()
+}
+
+// initializeLibraryManagerIndex loads the Library Manager index if needed.
+func (projectData *Type) initializeLibraryManagerIndex() {
+	libraryManagerIndex := projectData.libraryManagerIndex
+	libraryManagerIndex.mutex.Lock()
+	defer libraryManagerIndex.mutex.Unlock()
+
+	if !projectData.toolConfiguration.RuleModes(projectData.superprojectType)[rulemode.LibraryManagerIndexing] && libraryManagerIndex.index == nil && libraryManagerIndex.loadError == nil {
+		if projectData.toolConfiguration.LibraryIndexFilePath() == nil && projectData.toolConfiguration.Offline() {
+			libraryManagerIndex.loadError = fmt.Errorf("Library Manager index not available in offline mode. Use the --library-index flag to provide a local copy")
+		} else {
+			libraryManagerIndex.index, libraryManagerIndex.loadError = loadLibraryManagerIndex(projectData.toolConfiguration.LibraryIndexFilePath())
+			if libraryManagerIndex.loadError != nil {
+				libraryManagerIndex.failed = true
+			}
 		}
-		libraryIndexPath := libraryIndexFolderPath.Join("library_index.json")
+	}
+}
+
+// loadLibraryManagerIndex loads the Library Manager index from the given local file, or downloads it if the path is nil.
+func loadLibraryManagerIndex(libraryIndexFilePath *paths.Path) (*librariesmanager.LibrariesManager, error) {
+	// Set up the temporary folder for the index
+	libraryIndexFolderPath, err := paths.TempDir().MkTempDir("arduino-lint-library-index-folder")
+	if err != nil {
+		panic(err)
+	}
+	defer libraryIndexFolderPath.RemoveAll()
+	libraryIndexPath := libraryIndexFolderPath.Join("library_index.json")
 
+	if libraryIndexFilePath != nil {
+		if err := libraryIndexFilePath.CopyTo(libraryIndexPath); err != nil {
+			return nil, fmt.Errorf("Unable to read Library Manager index from %s: %s", libraryIndexFilePath, err)
+		}
+	} else {
 		// Download the index data
 		httpResponse, err := http.Get(librariesmanager.LibraryIndexURL.String())
 		if err != nil {
-			feedback.Errorf("Unable to download Library Manager index from %s: %s", err, librariesmanager.LibraryIndexURL)
-			os.Exit(1)
+			return nil, fmt.Errorf("Unable to download Library Manager index from %s: %s", librariesmanager.LibraryIndexURL, err)
 		}
 		defer httpResponse.Body.Close()
+		if httpResponse.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("Unable to download Library Manager index from %s: %s", librariesmanager.LibraryIndexURL, httpResponse.Status)
+		}
 
 		// Write the index data to file
 		libraryIndexFile, err := libraryIndexPath.Create()
 		if err != nil {
 			panic(err)
 		}
-		defer libraryIndexFile.Close()
-		if _, err := io.Copy(libraryIndexFile, httpResponse.Body); err != nil {
-			panic(err)
+		_, err = io.Copy(libraryIndexFile, httpResponse.Body)
+		libraryIndexFile.Close()
+		if err != nil {
+			return nil, fmt.Errorf("Unable to download Library Manager index from %s: %s", librariesmanager.LibraryIndexURL, err)
 		}
-
-		libraryManagerIndex = librariesmanager.NewLibraryManager(libraryIndexFolderPath, nil)
-		libraryManagerIndex.LoadIndex()
 	}
 
-	if misspelledWordsReplacer == nil { // The replacer only needs to be compiled once per run.
-		misspelledWordsReplacer = misspell.New()
-		misspelledWordsReplacer.Compile()
+	libraryManagerIndex := librariesmanager.NewLibraryManager(libraryIndexFolderPath, nil)
+	if err := libraryManagerIndex.LoadIndex(); err != nil {
+		return nil, fmt.Errorf("Unable to load Library Manager index: %s", err)
 	}
-}
 
-var libraryPropertiesLoadError error
+	return libraryManagerIndex, nil
+}
 
 // LibraryPropertiesLoadError returns the error output from loading the library.properties metadata file.
-func LibraryPropertiesLoadError() error {
-	return libraryPropertiesLoadError
+func (projectData *Type) LibraryPropertiesLoadError() error {
+	return projectData.libraryPropertiesLoadError
 }
 
-var libraryProperties *properties.Map
-
 // LibraryProperties returns the data from the library.properties metadata file.
-func LibraryProperties() *properties.Map {
-	return libraryProperties
+func (projectData *Type) LibraryProperties() *properties.Map {
+	return projectData.libraryProperties
 }
 
-var libraryPropertiesSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
-
 // LibraryPropertiesSchemaValidationResult returns the result of validating library.properties against the JSON schema.
-func LibraryPropertiesSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
-	return libraryPropertiesSchemaValidationResult
+func (projectData *Type) LibraryPropertiesSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
+	return projectData.libraryPropertiesSchemaValidationResult
 }
 
-var loadedLibrary *libraries.Library
-
 // LoadedLibrary returns the library object generated by Arduino CLI.
-func LoadedLibrary() *libraries.Library {
-	return loadedLibrary
+func (projectData *Type) LoadedLibrary() *libraries.Library {
+	return projectData.loadedLibrary
 }
 
-var sourceHeaders []string
-
 // SourceHeaders returns the list of library source header filenames discovered by Arduino CLI.
-func SourceHeaders() []string {
-	return sourceHeaders
+func (projectData *Type) SourceHeaders() []string {
+	return projectData.sourceHeaders
 }
 
-var libraryManagerIndex *librariesmanager.LibrariesManager
+// LibraryManagerIndex returns the Library Manager index data, or nil if it is not available.
+func (projectData *Type) LibraryManagerIndex() *librariesmanager.LibrariesManager {
+	projectData.initializeLibraryManagerIndex()
+	return projectData.libraryManagerIndex.index
+}
 
-// LibraryManagerIndex returns the Library Manager index data.
-func LibraryManagerIndex() *librariesmanager.LibrariesManager {
-	return libraryManagerIndex
+// LibraryManagerIndexLoadError returns the error output from loading the Library Manager index.
+func (projectData *Type) LibraryManagerIndexLoadError() error {
+	projectData.initializeLibraryManagerIndex()
+	return projectData.libraryManagerIndex.loadError
 }
 
+// sharedLibraryDataMutex protects the library data that is shared by all projects.
+var sharedLibraryDataMutex sync.Mutex
+
 var misspelledWordsReplacer *misspell.Replacer
 
 // MisspelledWordsReplacer returns the misspelled words replacer used for spell check.
-func MisspelledWordsReplacer() *misspell.Replacer {
+func (projectData *Type) MisspelledWordsReplacer() *misspell.Replacer {
 	return misspelledWordsReplacer
 }
@@ -0,0 +1,101 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package projectdata
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/util/test"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var libraryIndexTestDataPath *paths.Path
+
+func init() {
+	workingDirectory, err := paths.Getwd()
+	if err != nil {
+		panic(err)
+	}
+	libraryIndexTestDataPath = workingDirectory.Join("testdata", "libraryindexes")
+}
+
+func TestLoadLibraryManagerIndex(t *testing.T) {
+	testTables := []struct {
+		testName               string
+		libraryIndexFolderName string
+		indexAssertion         assert.ValueAssertionFunc
+		errorAssertion         assert.ValueAssertionFunc
+	}{
+		{"Local index", "valid", assert.NotNil, assert.Nil},
+		{"Invalid local index", "invalid-JSON", assert.Nil, assert.NotNil},
+		{"Missing local index", "nonexistent", assert.Nil, assert.NotNil},
+	}
+
+	for _, testTable := range testTables {
+		index, err := loadLibraryManagerIndex(libraryIndexTestDataPath.Join(testTable.libraryIndexFolderName, "library_index.json"))
+		testTable.indexAssertion(t, index, testTable.testName)
+		testTable.errorAssertion(t, err, testTable.testName)
+	}
+}
+
+func TestLoadLibraryManagerIndexData(t *testing.T) {
+	index, err := loadLibraryManagerIndex(libraryIndexTestDataPath.Join("valid", "library_index.json"))
+	require.Nil(t, err)
+	assert.NotNil(t, index.Index.FindIndexedLibrary(&libraries.Library{Name: "Servo"}))
+	assert.Nil(t, index.Index.FindIndexedLibrary(&libraries.Library{Name: "NotIndexed"}))
+}
+
+func TestLibraryManagerIndex(t *testing.T) {
+	testTables := []struct {
+		testName               string
+		libraryIndexFolderName string
+		offline                string
+		indexAssertion         assert.ValueAssertionFunc
+		loadErrorAssertion     assert.ValueAssertionFunc
+		errAssertion           assert.ValueAssertionFunc
+	}{
+		{"Local index", "valid", "false", assert.NotNil, assert.Nil, assert.Nil},
+		{"Local index, offline", "valid", "true", assert.NotNil, assert.Nil, assert.Nil},
+		{"Invalid local index", "invalid-JSON", "false", assert.Nil, assert.NotNil, assert.NotNil},
+		{"Offline without local index", "", "true", assert.Nil, assert.NotNil, assert.Nil},
+	}
+
+	for _, testTable := range testTables {
+		flags := test.ConfigurationFlags()
+		flags.Set("offline", testTable.offline)
+		if testTable.libraryIndexFolderName != "" {
+			flags.Set("library-index", libraryIndexTestDataPath.Join(testTable.libraryIndexFolderName, "library_index.json").String())
+		}
+		toolConfiguration, err := configuration.Initialize(flags, []string{libraryIndexTestDataPath.String()})
+		require.Nil(t, err)
+
+		var libraryManagerIndex LibraryManagerIndexType
+		projectData := Type{
+			toolConfiguration:   toolConfiguration,
+			libraryManagerIndex: &libraryManagerIndex,
+			superprojectType:    projecttype.Library,
+		}
+		testTable.indexAssertion(t, projectData.LibraryManagerIndex(), testTable.testName)
+		testTable.loadErrorAssertion(t, projectData.LibraryManagerIndexLoadError(), testTable.testName)
+		testTable.errAssertion(t, libraryManagerIndex.Err(), testTable.testName)
+	}
+}
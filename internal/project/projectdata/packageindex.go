@@ -34,127 +34,110 @@ type PackageIndexData struct {
 	Object      map[string]interface{} // The data of the object
 }
 
-// InitializeForPackageIndex gathers the package index rule data for the specified project.
-func InitializeForPackageIndex() {
-	packageIndex, packageIndexLoadError = packageindex.Properties(ProjectPath())
-	if ProjectPath() != nil {
-		_, packageIndexCLILoadError = clipackageindex.LoadIndex(ProjectPath())
+// packageIndexData is the type for the data of package index projects.
+type packageIndexData struct {
+	packageIndex                       map[string]interface{}
+	packageIndexLoadError              error
+	packageIndexCLILoadError           error
+	packageIndexPackages               []PackageIndexData
+	packageIndexPlatforms              []PackageIndexData
+	packageIndexBoards                 []PackageIndexData
+	packageIndexToolsDependencies      []PackageIndexData
+	packageIndexDiscoveryDependencies  []PackageIndexData
+	packageIndexMonitorDependencies    []PackageIndexData
+	packageIndexTools                  []PackageIndexData
+	packageIndexSystems                []PackageIndexData
+	packageIndexSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
+}
+
+// initializeForPackageIndex gathers the package index rule data for the specified project.
+func (projectData *Type) initializeForPackageIndex() {
+	projectData.packageIndex, projectData.packageIndexLoadError = packageindex.Properties(projectData.ProjectPath())
+	if projectData.ProjectPath() != nil {
+		_, projectData.packageIndexCLILoadError = clipackageindex.LoadIndex(projectData.ProjectPath())
 	}
 
-	packageIndexPackages = nil
-	packageIndexPlatforms = nil
-	packageIndexBoards = nil
-	packageIndexToolsDependencies = nil
-	packageIndexDiscoveryDependencies = nil
-	packageIndexMonitorDependencies = nil
-	packageIndexTools = nil
-	packageIndexSystems = nil
-	packageIndexSchemaValidationResult = nil
-	if packageIndexLoadError == nil {
-		packageIndexPackages = getPackageIndexData(PackageIndex(), "", "packages", "", "{{index . 0}}", []string{"name"})
-
-		for _, packageData := range PackageIndexPackages() {
-			packageIndexPlatforms = append(packageIndexPlatforms, getPackageIndexData(packageData.Object, packageData.JSONPointer, "platforms", packageData.ID, ":{{index . 0}}@{{index . 1}}", []string{"architecture", "version"})...)
-			packageIndexTools = append(packageIndexTools, getPackageIndexData(packageData.Object, packageData.JSONPointer, "tools", packageData.ID, ":{{index . 0}}@{{index . 1}}", []string{"name", "version"})...)
+	if projectData.packageIndexLoadError == nil {
+		projectData.packageIndexPackages = getPackageIndexData(projectData.PackageIndex(), "", "packages", "", "{{index . 0}}", []string{"name"})
+
+		for _, packageData := range projectData.PackageIndexPackages() {
+			projectData.packageIndexPlatforms = append(projectData.packageIndexPlatforms, getPackageIndexData(packageData.Object, packageData.JSONPointer, "platforms", packageData.ID, ":{{index . 0}}@{{index . 1}}", []string{"architecture", "version"})...)
+			projectData.packageIndexTools = append(projectData.packageIndexTools, getPackageIndexData(packageData.Object, packageData.JSONPointer, "tools", packageData.ID, ":{{index . 0}}@{{index . 1}}", []string{"name", "version"})...)
 		}
 
-		for _, platformData := range PackageIndexPlatforms() {
-			packageIndexBoards = append(packageIndexBoards, getPackageIndexData(platformData.Object, platformData.JSONPointer, "boards", platformData.ID, " >> {{index . 0}}", []string{"name"})...)
-			packageIndexToolsDependencies = append(packageIndexToolsDependencies, getPackageIndexData(platformData.Object, platformData.JSONPointer, "toolsDependencies", platformData.ID, " >> {{index . 0}}:{{index . 1}}@{{index . 2}}", []string{"packager", "name", "version"})...)
-			packageIndexDiscoveryDependencies = append(packageIndexDiscoveryDependencies, getPackageIndexData(platformData.Object, platformData.JSONPointer, "discoveryDependencies", platformData.ID, " >> {{index . 0}}:{{index . 1}}", []string{"packager", "name"})...)
-			packageIndexMonitorDependencies = append(packageIndexMonitorDependencies, getPackageIndexData(platformData.Object, platformData.JSONPointer, "monitorDependencies", platformData.ID, " >> {{index . 0}}:{{index . 1}}", []string{"packager", "name"})...)
+		for _, platformData := range projectData.PackageIndexPlatforms() {
+			projectData.packageIndexBoards = append(projectData.packageIndexBoards, getPackageIndexData(platformData.Object, platformData.JSONPointer, "boards", platformData.ID, " >> {{index . 0}}", []string{"name"})...)
+			projectData.packageIndexToolsDependencies = append(projectData.packageIndexToolsDependencies, getPackageIndexData(platformData.Object, platformData.JSONPointer, "toolsDependencies", platformData.ID, " >> {{index . 0}}:{{index . 1}}@{{index . 2}}", []string{"packager", "name", "version"})...)
+			projectData.packageIndexDiscoveryDependencies = append(projectData.packageIndexDiscoveryDependencies, getPackageIndexData(platformData.Object, platformData.JSONPointer, "discoveryDependencies", platformData.ID, " >> {{index . 0}}:{{index . 1}}", []string{"packager", "name"})...)
+			projectData.packageIndexMonitorDependencies = append(projectData.packageIndexMonitorDependencies, getPackageIndexData(platformData.Object, platformData.JSONPointer, "monitorDependencies", platformData.ID, " >> {{index . 0}}:{{index . 1}}", []string{"packager", "name"})...)
 		}
 
-		for _, toolData := range PackageIndexTools() {
-			packageIndexSystems = append(packageIndexSystems, getPackageIndexData(toolData.Object, toolData.JSONPointer, "systems", toolData.ID, " >> {{index . 0}}", []string{"host"})...)
+		for _, toolData := range projectData.PackageIndexTools() {
+			projectData.packageIndexSystems = append(projectData.packageIndexSystems, getPackageIndexData(toolData.Object, toolData.JSONPointer, "systems", toolData.ID, " >> {{index . 0}}", []string{"host"})...)
 		}
 
-		packageIndexSchemaValidationResult = packageindex.Validate(PackageIndex())
+		projectData.packageIndexSchemaValidationResult = packageindex.Validate(projectData.PackageIndex())
 	}
 }
 
-var packageIndex map[string]interface{}
-
 // PackageIndex returns the package index data.
-func PackageIndex() map[string]interface{} {
-	return packageIndex
+func (projectData *Type) PackageIndex() map[string]interface{} {
+	return projectData.packageIndex
 }
 
-var packageIndexLoadError error
-
 // PackageIndexLoadError returns the error from loading the package index.
-func PackageIndexLoadError() error {
-	return packageIndexLoadError
+func (projectData *Type) PackageIndexLoadError() error {
+	return projectData.packageIndexLoadError
 }
 
-var packageIndexCLILoadError error
-
 // PackageIndexCLILoadError returns the error return of Arduino CLI's packageindex.LoadIndex().
-func PackageIndexCLILoadError() error {
-	return packageIndexCLILoadError
+func (projectData *Type) PackageIndexCLILoadError() error {
+	return projectData.packageIndexCLILoadError
 }
 
-var packageIndexPackages []PackageIndexData
-
 // PackageIndexPackages returns the slice of package data for the package index.
-func PackageIndexPackages() []PackageIndexData {
-	return packageIndexPackages
+func (projectData *Type) PackageIndexPackages() []PackageIndexData {
+	return projectData.packageIndexPackages
 }
 
-var packageIndexPlatforms []PackageIndexData
-
 // PackageIndexPlatforms returns the slice of platform data for the package index.
-func PackageIndexPlatforms() []PackageIndexData {
-	return packageIndexPlatforms
+func (projectData *Type) PackageIndexPlatforms() []PackageIndexData {
+	return projectData.packageIndexPlatforms
 }
 
-var packageIndexBoards []PackageIndexData
-
 // PackageIndexBoards returns the slice of board data for the package index.
-func PackageIndexBoards() []PackageIndexData {
-	return packageIndexBoards
+func (projectData *Type) PackageIndexBoards() []PackageIndexData {
+	return projectData.packageIndexBoards
 }
 
-var packageIndexToolsDependencies []PackageIndexData
-
 // PackageIndexToolsDependencies returns the slice of tool dependency data for the package index.
-func PackageIndexToolsDependencies() []PackageIndexData {
-	return packageIndexToolsDependencies
+func (projectData *Type) PackageIndexToolsDependencies() []PackageIndexData {
+	return projectData.packageIndexToolsDependencies
 }
 
-var packageIndexDiscoveryDependencies []PackageIndexData
-
 // PackageIndexDiscoveryDependencies returns the slice of pluggable discovery tool dependency data for the package index.
-func PackageIndexDiscoveryDependencies() []PackageIndexData {
-	return packageIndexDiscoveryDependencies
+func (projectData *Type) PackageIndexDiscoveryDependencies() []PackageIndexData {
+	return projectData.packageIndexDiscoveryDependencies
 }
 
-var packageIndexMonitorDependencies []PackageIndexData
-
 // PackageIndexMonitorDependencies returns the slice of pluggable monitor tool dependency data for the package index.
-func PackageIndexMonitorDependencies() []PackageIndexData {
-	return packageIndexMonitorDependencies
+func (projectData *Type) PackageIndexMonitorDependencies() []PackageIndexData {
+	return projectData.packageIndexMonitorDependencies
 }
 
-var packageIndexTools []PackageIndexData
-
 // PackageIndexTools returns the slice of tool data for the package index.
-func PackageIndexTools() []PackageIndexData {
-	return packageIndexTools
+func (projectData *Type) PackageIndexTools() []PackageIndexData {
+	return projectData.packageIndexTools
 }
 
-var packageIndexSystems []PackageIndexData
-
 // PackageIndexSystems returns the slice of system data for the package index.
-func PackageIndexSystems() []PackageIndexData {
-	return packageIndexSystems
+func (projectData *Type) PackageIndexSystems() []PackageIndexData {
+	return projectData.packageIndexSystems
 }
 
-var packageIndexSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
-
 // PackageIndexSchemaValidationResult returns the result of validating the package index against the JSON schema.
-func PackageIndexSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
-	return packageIndexSchemaValidationResult
+func (projectData *Type) PackageIndexSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
+	return projectData.packageIndexSchemaValidationResult
 }
 
 func getPackageIndexData(interfaceObject map[string]interface{}, pointerPrefix string, dataKey string, iDPrefix string, iDSuffixTemplateString string, iDSuffixKeys []string) []PackageIndexData {
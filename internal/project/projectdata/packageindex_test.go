@@ -821,73 +821,73 @@ func TestInitializeForPackageIndex(t *testing.T) {
 			ProjectType:      projecttype.PackageIndex,
 			SuperprojectType: projecttype.PackageIndex,
 		}
-		Initialize(testProject)
+		projectData := Initialize(testProject, nil, nil)
 
-		testTable.packageIndexLoadErrorAssertion(t, PackageIndexLoadError(), testTable.testName)
-		testTable.packageIndexCLILoadErrorAssertion(t, PackageIndexCLILoadError(), testTable.testName)
-		if PackageIndexLoadError() == nil {
-			testTable.packageIndexAssertion(t, PackageIndex(), testTable.testName)
+		testTable.packageIndexLoadErrorAssertion(t, projectData.PackageIndexLoadError(), testTable.testName)
+		testTable.packageIndexCLILoadErrorAssertion(t, projectData.PackageIndexCLILoadError(), testTable.testName)
+		if projectData.PackageIndexLoadError() == nil {
+			testTable.packageIndexAssertion(t, projectData.PackageIndex(), testTable.testName)
 		}
 
-		testTable.packageIndexPackagesAssertion(t, PackageIndexPackages(), testTable.testName)
-		if PackageIndexPackages() != nil {
-			for index, packageIndexPackage := range PackageIndexPackages() {
+		testTable.packageIndexPackagesAssertion(t, projectData.PackageIndexPackages(), testTable.testName)
+		if projectData.PackageIndexPackages() != nil {
+			for index, packageIndexPackage := range projectData.PackageIndexPackages() {
 				assert.Equal(t, testTable.packageIndexPackagesDataAssertion[index].ID, packageIndexPackage.ID, testTable.testName)
 				assert.Equal(t, testTable.packageIndexPackagesDataAssertion[index].JSONPointer, packageIndexPackage.JSONPointer, testTable.testName)
 			}
 		}
 
-		testTable.packageIndexPlatformsAssertion(t, PackageIndexPlatforms(), testTable.testName)
-		if PackageIndexPlatforms() != nil {
-			for index, packageIndexPlatform := range PackageIndexPlatforms() {
+		testTable.packageIndexPlatformsAssertion(t, projectData.PackageIndexPlatforms(), testTable.testName)
+		if projectData.PackageIndexPlatforms() != nil {
+			for index, packageIndexPlatform := range projectData.PackageIndexPlatforms() {
 				assert.Equal(t, testTable.packageIndexPlatformsDataAssertion[index].ID, packageIndexPlatform.ID, testTable.testName)
 				assert.Equal(t, testTable.packageIndexPlatformsDataAssertion[index].JSONPointer, packageIndexPlatform.JSONPointer, testTable.testName)
 			}
 		}
 
-		testTable.packageIndexBoardsAssertion(t, PackageIndexBoards(), testTable.testName)
-		if PackageIndexBoards() != nil {
-			for index, packageIndexBoard := range PackageIndexBoards() {
+		testTable.packageIndexBoardsAssertion(t, projectData.PackageIndexBoards(), testTable.testName)
+		if projectData.PackageIndexBoards() != nil {
+			for index, packageIndexBoard := range projectData.PackageIndexBoards() {
 				assert.Equal(t, testTable.packageIndexBoardsDataAssertion[index].ID, packageIndexBoard.ID, testTable.testName)
 				assert.Equal(t, testTable.packageIndexBoardsDataAssertion[index].JSONPointer, packageIndexBoard.JSONPointer, testTable.testName)
 			}
 		}
 
-		testTable.packageIndexToolsDependenciesAssertion(t, PackageIndexToolsDependencies(), testTable.testName)
-		if PackageIndexToolsDependencies() != nil {
-			for index, packageIndexToolsDependency := range PackageIndexToolsDependencies() {
+		testTable.packageIndexToolsDependenciesAssertion(t, projectData.PackageIndexToolsDependencies(), testTable.testName)
+		if projectData.PackageIndexToolsDependencies() != nil {
+			for index, packageIndexToolsDependency := range projectData.PackageIndexToolsDependencies() {
 				assert.Equal(t, testTable.packageIndexToolsDependenciesDataAssertion[index].ID, packageIndexToolsDependency.ID, testTable.testName)
 				assert.Equal(t, testTable.packageIndexToolsDependenciesDataAssertion[index].JSONPointer, packageIndexToolsDependency.JSONPointer, testTable.testName)
 			}
 		}
 
-		testTable.packageIndexDiscoveryDependenciesAssertion(t, PackageIndexDiscoveryDependencies(), testTable.testName)
-		if PackageIndexDiscoveryDependencies() != nil {
-			for index, packageIndexDiscoveryDependency := range PackageIndexDiscoveryDependencies() {
+		testTable.packageIndexDiscoveryDependenciesAssertion(t, projectData.PackageIndexDiscoveryDependencies(), testTable.testName)
+		if projectData.PackageIndexDiscoveryDependencies() != nil {
+			for index, packageIndexDiscoveryDependency := range projectData.PackageIndexDiscoveryDependencies() {
 				assert.Equal(t, testTable.packageIndexDiscoveryDependenciesDataAssertion[index].ID, packageIndexDiscoveryDependency.ID, testTable.testName)
 				assert.Equal(t, testTable.packageIndexDiscoveryDependenciesDataAssertion[index].JSONPointer, packageIndexDiscoveryDependency.JSONPointer, testTable.testName)
 			}
 		}
 
-		testTable.packageIndexMonitorDependenciesAssertion(t, PackageIndexMonitorDependencies(), testTable.testName)
-		if PackageIndexMonitorDependencies() != nil {
-			for index, packageIndexMonitorDependency := range PackageIndexMonitorDependencies() {
+		testTable.packageIndexMonitorDependenciesAssertion(t, projectData.PackageIndexMonitorDependencies(), testTable.testName)
+		if projectData.PackageIndexMonitorDependencies() != nil {
+			for index, packageIndexMonitorDependency := range projectData.PackageIndexMonitorDependencies() {
 				assert.Equal(t, testTable.packageIndexMonitorDependenciesDataAssertion[index].ID, packageIndexMonitorDependency.ID, testTable.testName)
 				assert.Equal(t, testTable.packageIndexMonitorDependenciesDataAssertion[index].JSONPointer, packageIndexMonitorDependency.JSONPointer, testTable.testName)
 			}
 		}
 
-		testTable.packageIndexToolsAssertion(t, PackageIndexTools(), testTable.testName)
-		if PackageIndexTools() != nil {
-			for index, packageIndexTool := range PackageIndexTools() {
+		testTable.packageIndexToolsAssertion(t, projectData.PackageIndexTools(), testTable.testName)
+		if projectData.PackageIndexTools() != nil {
+			for index, packageIndexTool := range projectData.PackageIndexTools() {
 				assert.Equal(t, testTable.packageIndexToolsDataAssertion[index].ID, packageIndexTool.ID, testTable.testName)
 				assert.Equal(t, testTable.packageIndexToolsDataAssertion[index].JSONPointer, packageIndexTool.JSONPointer, testTable.testName)
 			}
 		}
 
-		testTable.packageIndexSystemsAssertion(t, PackageIndexSystems(), testTable.testName)
-		if PackageIndexSystems() != nil {
-			for index, packageIndexSystem := range PackageIndexSystems() {
+		testTable.packageIndexSystemsAssertion(t, projectData.PackageIndexSystems(), testTable.testName)
+		if projectData.PackageIndexSystems() != nil {
+			for index, packageIndexSystem := range projectData.PackageIndexSystems() {
 				assert.Equal(t, testTable.packageIndexSystemsDataAssertion[index].ID, packageIndexSystem.ID, testTable.testName)
 				assert.Equal(t, testTable.packageIndexSystemsDataAssertion[index].JSONPointer, packageIndexSystem.JSONPointer, testTable.testName)
 			}
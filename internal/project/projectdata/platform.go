@@ -27,172 +27,170 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// InitializeForPlatform gathers the platform rule data for the specified project.
-func InitializeForPlatform(project project.Type) {
-	boardsTxt, boardsTxtLoadError = boardstxt.Properties(ProjectPath())
-	if boardsTxtLoadError != nil {
-		logrus.Errorf("Error loading boards.txt from %s: %s", project.Path, boardsTxtLoadError)
-		boardsTxtSchemaValidationResult = nil
+// platformData is the type for the data of platform projects.
+type platformData struct {
+	boardsTxt                            *properties.Map
+	boardsTxtLoadError                   error
+	boardsTxtSchemaValidationResult      map[compliancelevel.Type]schema.ValidationResult
+	boardsTxtMenuIds                     []string
+	boardsTxtBoardIds                    []string
+	boardsTxtVisibleBoardIds             []string
+	programmersTxtExists                 bool
+	programmersTxt                       *properties.Map
+	programmersTxtLoadError              error
+	programmersTxtSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
+	programmersTxtProgrammerIds          []string
+	platformTxtExists                    bool
+	platformTxt                          *properties.Map
+	platformTxtLoadError                 error
+	platformTxtSchemaValidationResult    map[compliancelevel.Type]schema.ValidationResult
+	platformTxtPluggableDiscoveryNames   []string
+	platformTxtUserProvidedFieldNames    map[string][]string
+	platformTxtToolNames                 []string
+}
+
+// initializeForPlatform gathers the platform rule data for the specified project.
+func (projectData *Type) initializeForPlatform(project project.Type) {
+	var err error
+
+	projectData.boardsTxt, projectData.boardsTxtLoadError = boardstxt.Properties(projectData.ProjectPath())
+	if projectData.boardsTxtLoadError != nil {
+		logrus.Errorf("Error loading boards.txt from %s: %s", project.Path, projectData.boardsTxtLoadError)
+		projectData.boardsTxtSchemaValidationResult = nil
 	} else {
-		boardsTxtSchemaValidationResult = boardstxt.Validate(boardsTxt)
+		projectData.boardsTxtSchemaValidationResult = boardstxt.Validate(projectData.boardsTxt)
 
-		boardsTxtMenuIds = boardstxt.MenuIDs(boardsTxt)
-		boardsTxtBoardIds = boardstxt.BoardIDs(boardsTxt)
-		boardsTxtVisibleBoardIds = boardstxt.VisibleBoardIDs(boardsTxt)
+		projectData.boardsTxtMenuIds = boardstxt.MenuIDs(projectData.boardsTxt)
+		projectData.boardsTxtBoardIds = boardstxt.BoardIDs(projectData.boardsTxt)
+		projectData.boardsTxtVisibleBoardIds = boardstxt.VisibleBoardIDs(projectData.boardsTxt)
+
+		projectData.propertiesSuppressions[projectData.ProjectPath().Join("boards.txt").String()], err = boardstxt.Suppressions(projectData.ProjectPath())
+		if err != nil {
+			panic(err)
+		}
 	}
 
-	programmersTxtExists = ProjectPath().Join("programmers.txt").Exist()
+	projectData.programmersTxtExists = projectData.ProjectPath().Join("programmers.txt").Exist()
 
-	programmersTxt, programmersTxtLoadError = programmerstxt.Properties(ProjectPath())
-	if programmersTxtLoadError != nil {
-		logrus.Tracef("Error loading programmers.txt from %s: %s", project.Path, programmersTxtLoadError)
-		programmersTxtSchemaValidationResult = nil
+	projectData.programmersTxt, projectData.programmersTxtLoadError = programmerstxt.Properties(projectData.ProjectPath())
+	if projectData.programmersTxtLoadError != nil {
+		logrus.Tracef("Error loading programmers.txt from %s: %s", project.Path, projectData.programmersTxtLoadError)
+		projectData.programmersTxtSchemaValidationResult = nil
 	} else {
-		programmersTxtSchemaValidationResult = programmerstxt.Validate(programmersTxt)
+		projectData.programmersTxtSchemaValidationResult = programmerstxt.Validate(projectData.programmersTxt)
 
-		programmersTxtProgrammerIds = programmerstxt.ProgrammerIDs(programmersTxt)
+		projectData.programmersTxtProgrammerIds = programmerstxt.ProgrammerIDs(projectData.programmersTxt)
 	}
 
-	platformTxtExists = ProjectPath().Join("platform.txt").Exist()
+	projectData.platformTxtExists = projectData.ProjectPath().Join("platform.txt").Exist()
 
-	platformTxt, platformTxtLoadError = platformtxt.Properties(ProjectPath())
-	if platformTxtLoadError != nil {
-		logrus.Tracef("Error loading platform.txt from %s: %s", project.Path, platformTxtLoadError)
-		platformTxtSchemaValidationResult = nil
-		platformTxtPluggableDiscoveryNames = nil
-		platformTxtUserProvidedFieldNames = nil
-		platformTxtToolNames = nil
+	projectData.platformTxt, projectData.platformTxtLoadError = platformtxt.Properties(projectData.ProjectPath())
+	if projectData.platformTxtLoadError != nil {
+		logrus.Tracef("Error loading platform.txt from %s: %s", project.Path, projectData.platformTxtLoadError)
+		projectData.platformTxtSchemaValidationResult = nil
+		projectData.platformTxtPluggableDiscoveryNames = nil
+		projectData.platformTxtUserProvidedFieldNames = nil
+		projectData.platformTxtToolNames = nil
 	} else {
-		platformTxtSchemaValidationResult = platformtxt.Validate(platformTxt)
+		projectData.platformTxtSchemaValidationResult = platformtxt.Validate(projectData.platformTxt)
+
+		projectData.platformTxtPluggableDiscoveryNames = platformtxt.PluggableDiscoveryNames(projectData.platformTxt)
+		projectData.platformTxtUserProvidedFieldNames = platformtxt.UserProvidedFieldNames(projectData.platformTxt)
+		projectData.platformTxtToolNames = platformtxt.ToolNames(projectData.platformTxt)
 
-		platformTxtPluggableDiscoveryNames = platformtxt.PluggableDiscoveryNames(platformTxt)
-		platformTxtUserProvidedFieldNames = platformtxt.UserProvidedFieldNames(platformTxt)
-		platformTxtToolNames = platformtxt.ToolNames(platformTxt)
+		projectData.propertiesSuppressions[projectData.ProjectPath().Join("platform.txt").String()], err = platformtxt.Suppressions(projectData.ProjectPath())
+		if err != nil {
+			panic(err)
+		}
 	}
 }
 
-var boardsTxt *properties.Map
-
 // BoardsTxt returns the data from the boards.txt configuration file.
-func BoardsTxt() *properties.Map {
-	return boardsTxt
+func (projectData *Type) BoardsTxt() *properties.Map {
+	return projectData.boardsTxt
 }
 
-var boardsTxtLoadError error
-
 // BoardsTxtLoadError returns the error output from loading the boards.txt configuration file.
-func BoardsTxtLoadError() error {
-	return boardsTxtLoadError
+func (projectData *Type) BoardsTxtLoadError() error {
+	return projectData.boardsTxtLoadError
 }
 
-var boardsTxtSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
-
 // BoardsTxtSchemaValidationResult returns the result of validating boards.txt against the JSON schema.
-func BoardsTxtSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
-	return boardsTxtSchemaValidationResult
+func (projectData *Type) BoardsTxtSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
+	return projectData.boardsTxtSchemaValidationResult
 }
 
-var boardsTxtMenuIds []string
-
 // BoardsTxtMenuIds returns the list of menu IDs present in the platform's boards.txt.
-func BoardsTxtMenuIds() []string {
-	return boardsTxtMenuIds
+func (projectData *Type) BoardsTxtMenuIds() []string {
+	return projectData.boardsTxtMenuIds
 }
 
-var boardsTxtBoardIds []string
-
 // BoardsTxtBoardIds returns the list of board IDs present in the platform's boards.txt.
-func BoardsTxtBoardIds() []string {
-	return boardsTxtBoardIds
+func (projectData *Type) BoardsTxtBoardIds() []string {
+	return projectData.boardsTxtBoardIds
 }
 
-var boardsTxtVisibleBoardIds []string
-
 // BoardsTxtVisibleBoardIds returns the list of IDs for visible boards present in the platform's boards.txt.
-func BoardsTxtVisibleBoardIds() []string {
-	return boardsTxtVisibleBoardIds
+func (projectData *Type) BoardsTxtVisibleBoardIds() []string {
+	return projectData.boardsTxtVisibleBoardIds
 }
 
-var programmersTxtExists bool
-
 // ProgrammersTxtExists returns whether the platform contains a programmer.txt file.
-func ProgrammersTxtExists() bool {
-	return programmersTxtExists
+func (projectData *Type) ProgrammersTxtExists() bool {
+	return projectData.programmersTxtExists
 }
 
-var programmersTxt *properties.Map
-
 // ProgrammersTxt returns the data from the programmers.txt configuration file.
-func ProgrammersTxt() *properties.Map {
-	return programmersTxt
+func (projectData *Type) ProgrammersTxt() *properties.Map {
+	return projectData.programmersTxt
 }
 
-var programmersTxtLoadError error
-
 // ProgrammersTxtLoadError returns the error output from loading the programmers.txt configuration file.
-func ProgrammersTxtLoadError() error {
-	return programmersTxtLoadError
+func (projectData *Type) ProgrammersTxtLoadError() error {
+	return projectData.programmersTxtLoadError
 }
 
-var programmersTxtSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
-
 // ProgrammersTxtSchemaValidationResult returns the result of validating programmers.txt against the JSON schema.
-func ProgrammersTxtSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
-	return programmersTxtSchemaValidationResult
+func (projectData *Type) ProgrammersTxtSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
+	return projectData.programmersTxtSchemaValidationResult
 }
 
-var programmersTxtProgrammerIds []string
-
 // ProgrammersTxtProgrammerIds returns the list of board IDs present in the platform's programmers.txt.
-func ProgrammersTxtProgrammerIds() []string {
-	return programmersTxtProgrammerIds
+func (projectData *Type) ProgrammersTxtProgrammerIds() []string {
+	return projectData.programmersTxtProgrammerIds
 }
 
-var platformTxtExists bool
-
 // PlatformTxtExists returns whether the platform contains a platform.txt file.
-func PlatformTxtExists() bool {
-	return platformTxtExists
+func (projectData *Type) PlatformTxtExists() bool {
+	return projectData.platformTxtExists
 }
 
-var platformTxt *properties.Map
-
 // PlatformTxt returns the data from the platform.txt configuration file.
-func PlatformTxt() *properties.Map {
-	return platformTxt
+func (projectData *Type) PlatformTxt() *properties.Map {
+	return projectData.platformTxt
 }
 
-var platformTxtLoadError error
-
 // PlatformTxtLoadError returns the error output from loading the platform.txt configuration file.
-func PlatformTxtLoadError() error {
-	return platformTxtLoadError
+func (projectData *Type) PlatformTxtLoadError() error {
+	return projectData.platformTxtLoadError
 }
 
-var platformTxtSchemaValidationResult map[compliancelevel.Type]schema.ValidationResult
-
 // PlatformTxtSchemaValidationResult returns the result of validating platform.txt against the JSON schema.
-func PlatformTxtSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
-	return platformTxtSchemaValidationResult
+func (projectData *Type) PlatformTxtSchemaValidationResult() map[compliancelevel.Type]schema.ValidationResult {
+	return projectData.platformTxtSchemaValidationResult
 }
 
-var platformTxtPluggableDiscoveryNames []string
-
 // PlatformTxtPluggableDiscoveryNames returns the list of pluggable discoveries present in the platform's platform.txt.
-func PlatformTxtPluggableDiscoveryNames() []string {
-	return platformTxtPluggableDiscoveryNames
+func (projectData *Type) PlatformTxtPluggableDiscoveryNames() []string {
+	return projectData.platformTxtPluggableDiscoveryNames
 }
 
-var platformTxtUserProvidedFieldNames map[string][]string
-
 // PlatformTxtUserProvidedFieldNames returns the list of user provided field names present in the platform's platform.txt, mapped by board name.
-func PlatformTxtUserProvidedFieldNames() map[string][]string {
-	return platformTxtUserProvidedFieldNames
+func (projectData *Type) PlatformTxtUserProvidedFieldNames() map[string][]string {
+	return projectData.platformTxtUserProvidedFieldNames
 }
 
-var platformTxtToolNames []string
-
 // PlatformTxtToolNames returns the list of tools present in the platform's platform.txt.
-func PlatformTxtToolNames() []string {
-	return platformTxtToolNames
+func (projectData *Type) PlatformTxtToolNames() []string {
+	return projectData.platformTxtToolNames
 }
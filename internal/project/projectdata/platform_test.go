@@ -65,19 +65,19 @@ func TestInitializeForPlatform(t *testing.T) {
 			ProjectType:      projecttype.Platform,
 			SuperprojectType: projecttype.Platform,
 		}
-		Initialize(testProject)
+		projectData := Initialize(testProject, nil, nil)
 
-		testTable.boardsTxtLoadErrorAssertion(t, BoardsTxtLoadError(), testTable.testName)
-		if BoardsTxtLoadError() == nil {
-			testTable.boardsTxtAssertion(t, BoardsTxt(), testTable.testName)
+		testTable.boardsTxtLoadErrorAssertion(t, projectData.BoardsTxtLoadError(), testTable.testName)
+		if projectData.BoardsTxtLoadError() == nil {
+			testTable.boardsTxtAssertion(t, projectData.BoardsTxt(), testTable.testName)
 		}
 
-		testTable.platformTxtExistsAssertion(t, PlatformTxtExists(), testTable.testName)
-		testTable.platformTxtAssertion(t, PlatformTxt(), testTable.testName)
-		testTable.platformTxtLoadErrorAssertion(t, PlatformTxtLoadError(), testTable.testName)
-		testTable.platformTxtSchemaValidationResultAssertion(t, PlatformTxtSchemaValidationResult(), testTable.testName)
-		assert.Equal(t, testTable.platformTxtPluggableDiscoveryNamesAssertion, PlatformTxtPluggableDiscoveryNames(), testTable.testName)
-		assert.True(t, reflect.DeepEqual(testTable.platformTxtUserProvidedFieldNamesAssertion, PlatformTxtUserProvidedFieldNames()), testTable.testName)
-		assert.Equal(t, testTable.platformTxtToolNamesAssertion, PlatformTxtToolNames(), testTable.testName)
+		testTable.platformTxtExistsAssertion(t, projectData.PlatformTxtExists(), testTable.testName)
+		testTable.platformTxtAssertion(t, projectData.PlatformTxt(), testTable.testName)
+		testTable.platformTxtLoadErrorAssertion(t, projectData.PlatformTxtLoadError(), testTable.testName)
+		testTable.platformTxtSchemaValidationResultAssertion(t, projectData.PlatformTxtSchemaValidationResult(), testTable.testName)
+		assert.Equal(t, testTable.platformTxtPluggableDiscoveryNamesAssertion, projectData.PlatformTxtPluggableDiscoveryNames(), testTable.testName)
+		assert.True(t, reflect.DeepEqual(testTable.platformTxtUserProvidedFieldNamesAssertion, projectData.PlatformTxtUserProvidedFieldNames()), testTable.testName)
+		assert.Equal(t, testTable.platformTxtToolNamesAssertion, projectData.PlatformTxtToolNames(), testTable.testName)
 	}
 }
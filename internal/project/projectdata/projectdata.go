@@ -21,53 +21,84 @@ This is for data required by multiple rules.
 package projectdata
 
 import (
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/general"
 	"github.com/arduino/arduino-lint/internal/project/packageindex"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
 	"github.com/arduino/go-paths-helper"
 )
 
-// Initialize gathers the check data for the specified project.
-func Initialize(project project.Type) {
-	superprojectType = project.SuperprojectType
-	projectType = project.ProjectType
-	projectPath = project.Path
+// Type is the type for the data of a project.
+// Each project has its own data, so that multiple projects can be checked at the same time.
+type Type struct {
+	toolConfiguration      *configuration.Type
+	libraryManagerIndex    *LibraryManagerIndexType
+	superprojectType       projecttype.Type
+	projectType            projecttype.Type
+	projectPath            *paths.Path
+	propertiesSuppressions map[string]general.PropertiesSuppressions
+
+	sketchData
+	libraryData
+	platformData
+	packageIndexData
+}
+
+// Initialize gathers the check data for the specified project, under the given tool configuration.
+// The Library Manager index is shared by the projects of a lint run.
+func Initialize(project project.Type, toolConfiguration *configuration.Type, libraryManagerIndex *LibraryManagerIndexType) *Type {
+	projectData := Type{
+		toolConfiguration:      toolConfiguration,
+		libraryManagerIndex:    libraryManagerIndex,
+		superprojectType:       project.SuperprojectType,
+		projectType:            project.ProjectType,
+		projectPath:            project.Path,
+		propertiesSuppressions: make(map[string]general.PropertiesSuppressions),
+	}
+
 	switch project.ProjectType {
 	case projecttype.Sketch:
-		InitializeForSketch(project)
+		projectData.initializeForSketch(project)
 	case projecttype.Library:
-		InitializeForLibrary(project)
+		projectData.initializeForLibrary(project)
 	case projecttype.Platform:
-		InitializeForPlatform(project)
+		projectData.initializeForPlatform(project)
 	case projecttype.PackageIndex:
 		var err error
 		// Because a package index project is a file, but project.Path may be a folder, an extra discovery step is needed for this project type.
-		projectPath, err = packageindex.Find(project.Path)
+		projectData.projectPath, err = packageindex.Find(project.Path)
 		if err != nil {
 			panic(err)
 		}
 
-		InitializeForPackageIndex()
+		projectData.initializeForPackageIndex()
 	}
+
+	return &projectData
 }
 
-var superprojectType projecttype.Type
+// ToolConfiguration returns the tool configuration the project is checked under.
+func (projectData *Type) ToolConfiguration() *configuration.Type {
+	return projectData.toolConfiguration
+}
 
 // SuperProjectType returns the type of the project being checked.
-func SuperProjectType() projecttype.Type {
-	return superprojectType
+func (projectData *Type) SuperProjectType() projecttype.Type {
+	return projectData.superprojectType
 }
 
-var projectType projecttype.Type
-
 // ProjectType returns the type of the project being checked.
-func ProjectType() projecttype.Type {
-	return projectType
+func (projectData *Type) ProjectType() projecttype.Type {
+	return projectData.projectType
 }
 
-var projectPath *paths.Path
-
 // ProjectPath returns the path to the project being checked.
-func ProjectPath() *paths.Path {
-	return projectPath
+func (projectData *Type) ProjectPath() *paths.Path {
+	return projectData.projectPath
+}
+
+// PropertiesSuppressions returns the rule suppression data from the comments of the properties file at the given path.
+func (projectData *Type) PropertiesSuppressions(propertiesPath *paths.Path) general.PropertiesSuppressions {
+	return projectData.propertiesSuppressions[propertiesPath.String()]
 }
@@ -21,21 +21,23 @@ import (
 	"github.com/arduino/arduino-lint/internal/project"
 )
 
-// InitializeForSketch gathers the check data for the specified sketch project.
-func InitializeForSketch(project project.Type) {
-	loadedSketch, sketchLoadError = sketch.New(ProjectPath())
+// sketchData is the type for the data of sketch projects.
+type sketchData struct {
+	sketchLoadError error
+	loadedSketch    *sketch.Sketch
 }
 
-var sketchLoadError error
+// initializeForSketch gathers the check data for the specified sketch project.
+func (projectData *Type) initializeForSketch(project project.Type) {
+	projectData.loadedSketch, projectData.sketchLoadError = sketch.New(projectData.ProjectPath())
+}
 
 // SketchLoadError returns the error output from Arduino CLI loading the sketch.
-func SketchLoadError() error {
-	return sketchLoadError
+func (projectData *Type) SketchLoadError() error {
+	return projectData.sketchLoadError
 }
 
-var loadedSketch *sketch.Sketch
-
 // Sketch returns the sketch object generated by Arduino CLI.
-func Sketch() *sketch.Sketch {
-	return loadedSketch
+func (projectData *Type) Sketch() *sketch.Sketch {
+	return projectData.loadedSketch
 }
@@ -0,0 +1,196 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// The baseline file records the existing rule violations so that only new violations cause a failure.
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulefunction"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/go-paths-helper"
+)
+
+const baselineVersion = 1
+
+// baselineType is the type of the baseline file's data.
+type baselineType struct {
+	Version  int                       `json:"version"`
+	Findings []baselineFingerprintType `json:"findings"`
+}
+
+// baselineFingerprintType is the type of the data that identifies a rule violation.
+// Line numbers are intentionally omitted, including from the rule output, so that unrelated edits to a file don't cause a
+// mismatch.
+type baselineFingerprintType struct {
+	RuleID  string `json:"ruleID"`
+	Project string `json:"project"` // Project path, relative to the PROJECT_PATH argument it was found under.
+	Path    string `json:"path"`    // Path of the violation's location, relative to the project.
+	Output  string `json:"output"`  // Normalized rule output.
+}
+
+// LoadBaseline loads the baseline file specified by the configuration, if any.
+func (results *Type) LoadBaseline() error {
+	baselineFilePath := results.toolConfiguration.BaselineFilePath()
+	if baselineFilePath == nil {
+		return nil
+	}
+
+	baselineData, err := baselineFilePath.ReadFile()
+	if err != nil {
+		return fmt.Errorf("Unable to read baseline file %s: %v", baselineFilePath, err)
+	}
+
+	var baseline baselineType
+	if err := json.Unmarshal(baselineData, &baseline); err != nil {
+		return fmt.Errorf("Unable to parse baseline file %s: %v", baselineFilePath, err)
+	}
+	if baseline.Version != baselineVersion {
+		return fmt.Errorf("Baseline file %s version %v not supported", baselineFilePath, baseline.Version)
+	}
+
+	results.baseline = make(map[baselineFingerprintType]bool)
+	for _, fingerprint := range baseline.Findings {
+		results.baseline[fingerprint] = true
+	}
+
+	return nil
+}
+
+// WriteBaseline writes a baseline of the rule violations of all projects to the specified file.
+func (results Type) WriteBaseline() error {
+	writeBaselineFilePath := results.toolConfiguration.WriteBaselineFilePath()
+	if err := writeBaselineFilePath.Parent().MkdirAll(); err != nil {
+		return fmt.Errorf("Unable to create baseline file path (%v): %v", writeBaselineFilePath.Parent(), err)
+	}
+
+	if err := writeBaselineFilePath.WriteFile(results.baselineRaw()); err != nil {
+		return fmt.Errorf("While writing baseline: %v", err)
+	}
+
+	return nil
+}
+
+// baselineRaw returns the baseline of the rule violations marshaled into JSON format in byte encoding.
+func (results Type) baselineRaw() []byte {
+	baseline := baselineType{
+		Version:  baselineVersion,
+		Findings: []baselineFingerprintType{},
+	}
+	recorded := make(map[baselineFingerprintType]bool)
+	for _, projectReport := range results.Projects {
+		// The complete record of rule results is used so that the baseline is independent of the verbosity setting.
+		for _, ruleReport := range projectReport.allRules {
+			if ruleReport.Result != ruleresult.Fail.String() || recorded[ruleReport.fingerprint] {
+				continue
+			}
+			baseline.Findings = append(baseline.Findings, ruleReport.fingerprint)
+			recorded[ruleReport.fingerprint] = true
+		}
+	}
+
+	baselineJSON, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		panic(fmt.Sprintf("Error while formatting baseline: %v", err))
+	}
+
+	return append(baselineJSON, '\n')
+}
+
+// inBaseline returns whether the given rule violation fingerprint is recorded in the baseline.
+func (results Type) inBaseline(fingerprint baselineFingerprintType) bool {
+	return results.baseline[fingerprint]
+}
+
+// baselineFingerprint returns the data that identifies the given rule violation.
+func (results Type) baselineFingerprint(lintedProject project.Type, ruleConfiguration ruleconfiguration.Type, ruleOutput string, ruleFindings []rulefunction.Finding) baselineFingerprintType {
+	fingerprint := baselineFingerprintType{
+		RuleID:  ruleConfiguration.ID,
+		Project: results.baselineProjectPath(lintedProject.Path),
+		Output:  removeFindingLines(normalizeRuleOutput(ruleOutput, lintedProject.Path), ruleFindings, lintedProject.Path),
+	}
+	if len(ruleFindings) > 0 && ruleFindings[0].Path != nil {
+		fingerprint.Path = relativeSlashPath(ruleFindings[0].Path, lintedProject.Path)
+	}
+
+	return fingerprint
+}
+
+// removeFindingLines returns the normalized rule output with the line and column numbers of the given findings removed
+// from the references to their locations (e.g., "foo.h:12:3:" becomes "foo.h:").
+func removeFindingLines(normalizedRuleOutput string, ruleFindings []rulefunction.Finding, projectPath *paths.Path) string {
+	for _, ruleFinding := range ruleFindings {
+		if ruleFinding.Path == nil || ruleFinding.Line == 0 {
+			continue
+		}
+		findingPath := relativeSlashPath(ruleFinding.Path, projectPath)
+		if ruleFinding.Column != 0 {
+			normalizedRuleOutput = strings.ReplaceAll(normalizedRuleOutput, fmt.Sprintf("%s:%d:%d", findingPath, ruleFinding.Line, ruleFinding.Column), findingPath)
+		}
+		normalizedRuleOutput = strings.ReplaceAll(normalizedRuleOutput, fmt.Sprintf("%s:%d", findingPath, ruleFinding.Line), findingPath)
+	}
+
+	return normalizedRuleOutput
+}
+
+// baselineProjectPath returns the project path relative to the PROJECT_PATH argument it was found under.
+// This allows the baseline to be used regardless of the working directory.
+func (results Type) baselineProjectPath(projectPath *paths.Path) string {
+	for _, targetPath := range results.toolConfiguration.TargetPaths() {
+		targetFolderPath := targetPath
+		if targetFolderPath.IsNotDir() {
+			targetFolderPath = targetFolderPath.Parent()
+		}
+		isInside, err := projectPath.IsInsideDir(targetFolderPath)
+		if projectPath.EquivalentTo(targetFolderPath) || (err == nil && isInside) {
+			return relativeSlashPath(projectPath, targetFolderPath)
+		}
+	}
+
+	return filepath.ToSlash(projectPath.String())
+}
+
+// relativeSlashPath returns the path relative to the folder, or the folder of the file, at basePath, using forward slashes.
+func relativeSlashPath(path *paths.Path, basePath *paths.Path) string {
+	if basePath.IsNotDir() {
+		basePath = basePath.Parent()
+	}
+	relativePath, err := path.RelFrom(basePath)
+	if err != nil {
+		return filepath.ToSlash(path.String())
+	}
+
+	return filepath.ToSlash(relativePath.String())
+}
+
+// normalizeRuleOutput returns the rule output with the project location and whitespace variations removed.
+func normalizeRuleOutput(ruleOutput string, projectPath *paths.Path) string {
+	projectFolderPath := projectPath
+	if projectFolderPath.IsNotDir() {
+		projectFolderPath = projectFolderPath.Parent()
+	}
+	ruleOutput = strings.ReplaceAll(ruleOutput, projectFolderPath.String()+string(filepath.Separator), "")
+	ruleOutput = filepath.ToSlash(ruleOutput)
+
+	return strings.Join(strings.Fields(ruleOutput), " ")
+}
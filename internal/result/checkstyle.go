@@ -0,0 +1,119 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// The Checkstyle XML report format.
+// Each rule violation is an error element of the file element for its location.
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+)
+
+const checkstyleVersion = "4.3"
+
+// checkstyleType is the type of the Checkstyle XML root element.
+type checkstyleType struct {
+	XMLName xml.Name             `xml:"checkstyle"`
+	Version string               `xml:"version,attr"`
+	Files   []checkstyleFileType `xml:"file"`
+}
+
+// checkstyleFileType is the type of the Checkstyle XML file element.
+type checkstyleFileType struct {
+	Name   string                `xml:"name,attr"`
+	Errors []checkstyleErrorType `xml:"error"`
+}
+
+// checkstyleErrorType is the type of the Checkstyle XML error element for a rule violation.
+type checkstyleErrorType struct {
+	Line     int    `xml:"line,attr,omitempty"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// CheckstyleReport returns a Checkstyle XML formatted report of rules on all projects in string encoding.
+func (results Type) CheckstyleReport() string {
+	return string(results.checkstyleReportRaw())
+}
+
+// checkstyleReportRaw returns the report marshaled into Checkstyle XML format in byte encoding.
+func (results Type) checkstyleReportRaw() []byte {
+	checkstyle := checkstyleType{
+		Version: checkstyleVersion,
+		Files:   []checkstyleFileType{},
+	}
+	fileIndexes := make(map[string]int)
+
+	for _, projectReport := range results.Projects {
+		for _, ruleReport := range projectReport.Rules {
+			if ruleReport.Result != ruleresult.Fail.String() || ruleReport.baselined {
+				// Checkstyle errors are only used for rule violations that are not recorded in the baseline.
+				continue
+			}
+
+			// The project path is used as the location when the rule did not provide a more specific one.
+			fileName := workingDirectorySlashPath(projectReport.Path)
+			checkstyleError := checkstyleErrorType{
+				Severity: checkstyleSeverity(ruleReport.Level),
+				Message:  ruleReport.Message,
+				Source:   "arduino-lint." + ruleReport.ID,
+			}
+			if len(ruleReport.Locations) > 0 {
+				fileName = workingDirectorySlashPath(ruleReport.Locations[0].Path)
+				checkstyleError.Line = ruleReport.Locations[0].Line
+				checkstyleError.Column = ruleReport.Locations[0].Column
+			}
+
+			fileIndex, ok := fileIndexes[fileName]
+			if !ok {
+				fileIndex = len(checkstyle.Files)
+				fileIndexes[fileName] = fileIndex
+				checkstyle.Files = append(checkstyle.Files, checkstyleFileType{Name: fileName})
+			}
+			checkstyle.Files[fileIndex].Errors = append(checkstyle.Files[fileIndex].Errors, checkstyleError)
+		}
+	}
+
+	marshaledReportBuffer := bytes.NewBufferString(xml.Header)
+	xmlEncoder := xml.NewEncoder(marshaledReportBuffer)
+	xmlEncoder.Indent("", "  ")
+	if err := xmlEncoder.Encode(checkstyle); err != nil {
+		panic(fmt.Sprintf("Error while formatting Checkstyle rules report: %v", err))
+	}
+	marshaledReportBuffer.WriteString("\n")
+
+	return marshaledReportBuffer.Bytes()
+}
+
+// checkstyleSeverity returns the Checkstyle severity corresponding to the given rule level string.
+func checkstyleSeverity(ruleLevel string) string {
+	switch ruleLevel {
+	case rulelevel.Error.String():
+		return "error"
+	case rulelevel.Warning.String():
+		return "warning"
+	default:
+		return "info"
+	}
+}
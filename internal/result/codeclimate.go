@@ -0,0 +1,136 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// The Code Climate report format, as used by GitLab Code Quality.
+// https://docs.gitlab.com/ee/ci/testing/code_quality.html#implement-a-custom-tool
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+)
+
+// codeClimateIssueType is the type of the Code Climate issue object describing a rule violation.
+type codeClimateIssueType struct {
+	Type        string                  `json:"type"`
+	CheckName   string                  `json:"check_name"`
+	Description string                  `json:"description"`
+	Categories  []string                `json:"categories"`
+	Location    codeClimateLocationType `json:"location"`
+	Severity    string                  `json:"severity"`
+	Fingerprint string                  `json:"fingerprint"`
+}
+
+// codeClimateLocationType is the type of the Code Climate location object.
+type codeClimateLocationType struct {
+	Path  string               `json:"path"`
+	Lines codeClimateLinesType `json:"lines"`
+}
+
+// codeClimateLinesType is the type of the Code Climate line-based location object.
+type codeClimateLinesType struct {
+	Begin int `json:"begin"`
+}
+
+// CodeClimateReport returns a Code Climate formatted report of rules on all projects in string encoding.
+func (results Type) CodeClimateReport() string {
+	return string(results.codeClimateReportRaw())
+}
+
+// codeClimateReportRaw returns the report marshaled into Code Climate format in byte encoding.
+func (results Type) codeClimateReportRaw() []byte {
+	issues := []codeClimateIssueType{}
+	for _, projectReport := range results.Projects {
+		for _, ruleReport := range projectReport.Rules {
+			if ruleReport.Result != ruleresult.Fail.String() || ruleReport.baselined {
+				// Code Climate issues are only used for rule violations that are not recorded in the baseline.
+				continue
+			}
+
+			// The project path is used as the location when the rule did not provide a more specific one.
+			location := codeClimateLocationType{
+				Path:  workingDirectorySlashPath(projectReport.Path),
+				Lines: codeClimateLinesType{Begin: 1},
+			}
+			if len(ruleReport.Locations) > 0 {
+				location.Path = workingDirectorySlashPath(ruleReport.Locations[0].Path)
+				if ruleReport.Locations[0].Line > 0 {
+					location.Lines.Begin = ruleReport.Locations[0].Line
+				}
+			}
+
+			issues = append(issues, codeClimateIssueType{
+				Type:        "issue",
+				CheckName:   ruleReport.ID,
+				Description: ruleReport.Message,
+				Categories:  []string{"Compatibility"},
+				Location:    location,
+				Severity:    codeClimateSeverity(ruleReport.Level),
+				Fingerprint: results.findingFingerprint(projectReport, ruleReport),
+			})
+		}
+	}
+
+	var marshaledReportBuffer bytes.Buffer
+	jsonEncoder := json.NewEncoder(io.Writer(&marshaledReportBuffer))
+	jsonEncoder.SetEscapeHTML(false)
+	jsonEncoder.SetIndent("", "  ")
+	if err := jsonEncoder.Encode(issues); err != nil {
+		panic(fmt.Sprintf("Error while formatting Code Climate rules report: %v", err))
+	}
+
+	return marshaledReportBuffer.Bytes()
+}
+
+// codeClimateSeverity returns the Code Climate severity corresponding to the given rule level string.
+func codeClimateSeverity(ruleLevel string) string {
+	switch ruleLevel {
+	case rulelevel.Error.String():
+		return "major"
+	case rulelevel.Warning.String():
+		return "minor"
+	default:
+		return "info"
+	}
+}
+
+// findingFingerprint returns a fingerprint of the given rule violation which is stable between runs, built from the
+// rule ID, the project path, the message, and the locations.
+// The locations distinguish violations of a rule which have the same message.
+// The paths and message are normalized so that the fingerprint doesn't depend on where the project is located.
+func (results Type) findingFingerprint(projectReport projectReportType, ruleReport ruleReportType) string {
+	hash := sha256.New()
+	fmt.Fprintf(
+		hash,
+		"%s\x00%s\x00%s",
+		ruleReport.ID,
+		results.baselineProjectPath(projectReport.Path),
+		normalizeRuleOutput(ruleReport.Message, projectReport.Path),
+	)
+	for _, location := range ruleReport.Locations {
+		fmt.Fprintf(hash, "\x00%s:%d", relativeSlashPath(location.Path, projectReport.Path), location.Line)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,54 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package failon defines the rule violation levels that cause the linting to fail.
+package failon
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Type is the type for the --fail-on thresholds.
+//
+//go:generate go tool golang.org/x/tools/cmd/stringer -type=Type -linecomment
+type Type int
+
+const (
+	// Error fails on rule violations of the error level.
+	Error Type = iota // error
+	// Warning fails on rule violations of the warning or error level.
+	Warning // warning
+	// Info fails on rule violations of any level.
+	Info // info
+	// Never doesn't fail on rule violations.
+	Never // never
+)
+
+// FromString parses the --fail-on flag value and returns the corresponding threshold.
+func FromString(failOnString string) (Type, error) {
+	failOn, found := map[string]Type{
+		Error.String():   Error,
+		Warning.String(): Warning,
+		Info.String():    Info,
+		Never.String():   Never,
+	}[strings.ToLower(failOnString)]
+
+	if found {
+		return failOn, nil
+	}
+	return Error, fmt.Errorf("No matching fail threshold for string %s", failOnString)
+}
@@ -0,0 +1,27 @@
+// Code generated by "stringer -type=Type -linecomment"; DO NOT EDIT.
+
+package failon
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[Error-0]
+	_ = x[Warning-1]
+	_ = x[Info-2]
+	_ = x[Never-3]
+}
+
+const _Type_name = "errorwarninginfonever"
+
+var _Type_index = [...]uint8{0, 5, 12, 16, 21}
+
+func (i Type) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_Type_index)-1 {
+		return "Type(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Type_name[_Type_index[idx]:_Type_index[idx+1]]
+}
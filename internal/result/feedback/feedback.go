@@ -27,41 +27,49 @@ import (
 )
 
 // VerbosePrintln behaves like Println but only prints when verbosity is enabled.
-func VerbosePrintln(v ...interface{}) {
-	VerbosePrint(v...)
-	VerbosePrint("\n")
+func VerbosePrintln(toolConfiguration *configuration.Type, v ...interface{}) {
+	VerbosePrint(toolConfiguration, v...)
+	VerbosePrint(toolConfiguration, "\n")
 }
 
 // VerbosePrintf behaves like Printf but only prints when verbosity is enabled.
-func VerbosePrintf(format string, v ...interface{}) {
-	VerbosePrint(fmt.Sprintf(format, v...))
+func VerbosePrintf(toolConfiguration *configuration.Type, format string, v ...interface{}) {
+	VerbosePrint(toolConfiguration, fmt.Sprintf(format, v...))
 }
 
-// VerbosePrint behaves like Print but only prints when verbosity is enabled.
-func VerbosePrint(v ...interface{}) {
-	if configuration.Verbose() && (configuration.OutputFormat() == outputformat.Text) {
-		Print(v...)
+// VerbosePrint behaves like Print but only prints when verbosity is enabled in the tool configuration.
+func VerbosePrint(toolConfiguration *configuration.Type, v ...interface{}) {
+	if toolConfiguration.Verbose() && (toolConfiguration.OutputFormat() == outputformat.Text) {
+		Print(toolConfiguration, v...)
 	}
 }
 
 // Println behaves like fmt.Println but only prints when output format is set to `text`.
-func Println(v ...interface{}) {
-	Print(v...)
-	Print("\n")
+func Println(toolConfiguration *configuration.Type, v ...interface{}) {
+	Print(toolConfiguration, v...)
+	Print(toolConfiguration, "\n")
 }
 
 // Printf behaves like fmt.Printf but only prints when output format is set to `text`.
-func Printf(format string, v ...interface{}) {
-	Print(fmt.Sprintf(format, v...))
+func Printf(toolConfiguration *configuration.Type, format string, v ...interface{}) {
+	Print(toolConfiguration, fmt.Sprintf(format, v...))
 }
 
-// Print behaves like fmt.Print but only prints when output format is set to `text`.
-func Print(v ...interface{}) {
-	if configuration.OutputFormat() == outputformat.Text {
+// Print behaves like fmt.Print but only prints when the output format of the tool configuration is set to `text`.
+func Print(toolConfiguration *configuration.Type, v ...interface{}) {
+	if toolConfiguration.OutputFormat() == outputformat.Text {
 		fmt.Print(v...)
 	}
 }
 
+// Stream prints the given jsonl output format event line, but only when the output format of the tool configuration is
+// set to `jsonl`.
+func Stream(toolConfiguration *configuration.Type, event string) {
+	if toolConfiguration.OutputFormat() == outputformat.JSONL {
+		fmt.Print(event)
+	}
+}
+
 // Errorf behaves like fmt.Printf but adds a newline and also logs the error.
 func Errorf(format string, v ...interface{}) {
 	Error(fmt.Sprintf(format, v...))
@@ -0,0 +1,98 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// The GitHub Actions workflow commands format.
+// https://docs.github.com/actions/using-workflows/workflow-commands-for-github-actions
+// Each rule violation is an annotation, and the output of each project is in a collapsible group.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+)
+
+// GitHubReport returns the rule violations of all projects as GitHub Actions workflow commands.
+func (results Type) GitHubReport() string {
+	var report strings.Builder
+
+	for _, projectReport := range results.Projects {
+		fmt.Fprintf(&report, "::group::Linting %s in %s\n", projectReport.ProjectType, projectReport.Path)
+
+		for _, ruleReport := range projectReport.Rules {
+			if ruleReport.Result != ruleresult.Fail.String() || ruleReport.baselined {
+				// Annotations are only used for rule violations that are not recorded in the baseline.
+				continue
+			}
+
+			properties := []string{}
+			if len(ruleReport.Locations) > 0 {
+				// Annotations only support a single location.
+				location := ruleReport.Locations[0]
+				properties = append(properties, "file="+githubEscapeProperty(workingDirectorySlashPath(location.Path)))
+				if location.Line > 0 {
+					properties = append(properties, fmt.Sprintf("line=%d", location.Line))
+				}
+				if location.Column > 0 {
+					properties = append(properties, fmt.Sprintf("col=%d", location.Column))
+				}
+			}
+			properties = append(properties, "title="+githubEscapeProperty(fmt.Sprintf("%s: %s", ruleReport.ID, ruleReport.Brief)))
+
+			fmt.Fprintf(
+				&report,
+				"::%s %s::%s\n",
+				githubCommand(ruleReport.Level),
+				strings.Join(properties, ","),
+				githubEscapeData(ruleReport.Message),
+			)
+		}
+
+		fmt.Fprintf(&report, "Linter results for project: %s\n", summaryCountsText(projectReport.Summary))
+		report.WriteString("::endgroup::\n")
+	}
+
+	if len(results.Projects) > 1 {
+		report.WriteString(results.SummaryText() + "\n")
+	}
+
+	return report.String()
+}
+
+// githubCommand returns the workflow command for an annotation of the given rule level string.
+func githubCommand(ruleLevel string) string {
+	switch ruleLevel {
+	case rulelevel.Error.String():
+		return "error"
+	case rulelevel.Warning.String():
+		return "warning"
+	default:
+		return "notice"
+	}
+}
+
+// githubEscapeData escapes the characters of the workflow command message that have special meaning.
+func githubEscapeData(data string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(data)
+}
+
+// githubEscapeProperty escapes the characters of the workflow command property value that have special meaning.
+func githubEscapeProperty(property string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(property)
+}
@@ -0,0 +1,241 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// The self-contained static HTML page report format, intended for human readers.
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+)
+
+// htmlReportType is the data of the HTML report template.
+type htmlReportType struct {
+	Version  string
+	Levels   []string // The levels of the findings, in order of severity.
+	Projects []htmlProjectReportType
+	Summary  summaryReportType
+}
+
+// htmlProjectReportType is the HTML report data for a project.
+type htmlProjectReportType struct {
+	Path        string
+	ProjectType string
+	Summary     summaryReportType
+	LevelCounts map[string]int // Number of findings of each level.
+	Categories  []htmlCategoryReportType
+}
+
+// htmlCategoryReportType is the HTML report data for the findings of a rule category/subcategory.
+type htmlCategoryReportType struct {
+	Category    string
+	Subcategory string
+	Findings    []htmlFindingReportType
+}
+
+// htmlFindingReportType is the HTML report data for a rule violation.
+type htmlFindingReportType struct {
+	ID          string
+	Brief       string
+	Description string
+	Reference   string
+	Level       string
+	Message     string
+	Locations   []string
+}
+
+// htmlLevels are the levels of the findings shown in the HTML report, in order of severity.
+var htmlLevels = []string{
+	rulelevel.Error.String(),
+	rulelevel.Warning.String(),
+	rulelevel.Info.String(),
+	rulelevel.Notice.String(),
+}
+
+// HTMLReport returns a static HTML page report of rules on all projects in string encoding.
+func (results Type) HTMLReport() string {
+	return string(results.htmlReportRaw())
+}
+
+// htmlReportRaw returns the report rendered as a static HTML page in byte encoding.
+func (results Type) htmlReportRaw() []byte {
+	htmlReport := htmlReportType{
+		Version:  configuration.BuildVersion(),
+		Levels:   htmlLevels,
+		Projects: []htmlProjectReportType{},
+		Summary:  results.Summary,
+	}
+	for _, projectReport := range results.Projects {
+		htmlProjectReport := htmlProjectReportType{
+			Path:        workingDirectorySlashPath(projectReport.Path),
+			ProjectType: projectReport.ProjectType,
+			Summary:     projectReport.Summary,
+			LevelCounts: make(map[string]int),
+			Categories:  []htmlCategoryReportType{},
+		}
+
+		// The complete record of rule results is used so that the report is independent of the verbosity setting.
+		categoryIndexes := make(map[string]int)
+		for _, ruleReport := range projectReport.allRules {
+			if ruleReport.Result != ruleresult.Fail.String() {
+				continue
+			}
+
+			categoryKey := ruleReport.Category + "\x00" + ruleReport.Subcategory
+			categoryIndex, ok := categoryIndexes[categoryKey]
+			if !ok {
+				categoryIndex = len(htmlProjectReport.Categories)
+				categoryIndexes[categoryKey] = categoryIndex
+				htmlProjectReport.Categories = append(
+					htmlProjectReport.Categories,
+					htmlCategoryReportType{Category: ruleReport.Category, Subcategory: ruleReport.Subcategory},
+				)
+			}
+
+			finding := htmlFindingReportType{
+				ID:          ruleReport.ID,
+				Brief:       ruleReport.Brief,
+				Description: ruleReport.Description,
+				Reference:   ruleReport.reference,
+				Level:       ruleReport.Level,
+				Message:     ruleReport.Message,
+			}
+			for _, location := range ruleReport.Locations {
+				finding.Locations = append(finding.Locations, htmlLocation(location))
+			}
+
+			htmlProjectReport.Categories[categoryIndex].Findings = append(htmlProjectReport.Categories[categoryIndex].Findings, finding)
+			htmlProjectReport.LevelCounts[ruleReport.Level]++
+		}
+
+		htmlReport.Projects = append(htmlReport.Projects, htmlProjectReport)
+	}
+
+	marshaledReportBuffer := new(bytes.Buffer)
+	if err := htmlReportTemplate.Execute(marshaledReportBuffer, htmlReport); err != nil {
+		panic(fmt.Sprintf("Error while formatting HTML rules report: %v", err))
+	}
+
+	return marshaledReportBuffer.Bytes()
+}
+
+// htmlLocation returns the text representation of the location of a rule violation.
+func htmlLocation(location locationReportType) string {
+	locationText := workingDirectorySlashPath(location.Path)
+	if location.Line > 0 {
+		locationText += fmt.Sprintf(":%d", location.Line)
+		if location.Column > 0 {
+			locationText += fmt.Sprintf(":%d", location.Column)
+		}
+	}
+
+	return locationText
+}
+
+// htmlReportTemplate is the template of the HTML report page.
+// The page has no external dependencies, so that it can be viewed offline or attached to other documents.
+var htmlReportTemplate = template.Must(template.New("htmlReport").Funcs(template.FuncMap{
+	"lower": strings.ToLower,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="generator" content="arduino-lint {{.Version}}">
+<title>Arduino Lint report</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #1f2328; }
+h1 { margin-bottom: 0.2em; }
+table.summary { border-collapse: collapse; margin: 1em 0; }
+table.summary th, table.summary td { border: 1px solid #d0d7de; padding: 0.3em 0.8em; text-align: left; }
+.pass { color: #1a7f37; font-weight: bold; }
+.fail { color: #cf222e; font-weight: bold; }
+.finding { border-left: 4px solid #d0d7de; margin: 0.5em 0; padding: 0.3em 0.8em; }
+.finding.level-error { border-color: #cf222e; }
+.finding.level-warning { border-color: #bf8700; }
+.finding.level-info { border-color: #0969da; }
+.finding.level-notice { border-color: #8c959f; }
+.level { font-weight: bold; font-size: 0.8em; }
+.message { white-space: pre-wrap; }
+.location { font-family: monospace; }
+details { margin: 0.3em 0; }
+summary { cursor: pointer; }
+</style>
+</head>
+<body>
+<h1>Arduino Lint report</h1>
+<p>Overall result: {{if .Summary.Pass}}<span class="pass">pass</span>{{else}}<span class="fail">fail</span>{{end}}
+({{.Summary.ErrorCount}} errors, {{.Summary.WarningCount}} warnings)</p>
+<fieldset id="level-filter">
+<legend>Show levels</legend>
+{{- range .Levels}}
+<label><input type="checkbox" value="{{lower .}}" checked> {{.}}</label>
+{{- end}}
+</fieldset>
+<h2>Summary</h2>
+<table class="summary">
+<tr><th>Project</th><th>Type</th><th>Result</th>{{range .Levels}}<th>{{.}}</th>{{end}}</tr>
+{{- range .Projects}}
+{{- $project := .}}
+<tr><td>{{.Path}}</td><td>{{.ProjectType}}</td><td>{{if .Summary.Pass}}<span class="pass">pass</span>{{else}}<span class="fail">fail</span>{{end}}</td>{{range $.Levels}}<td>{{index $project.LevelCounts .}}</td>{{end}}</tr>
+{{- end}}
+</table>
+{{- range .Projects}}
+<section class="project">
+<h2>{{.ProjectType}}: {{.Path}}</h2>
+{{- if not .Categories}}
+<p>No rule violations.</p>
+{{- end}}
+{{- range .Categories}}
+<h3>{{.Category}}{{if .Subcategory}} / {{.Subcategory}}{{end}}</h3>
+{{- range .Findings}}
+<div class="finding level-{{lower .Level}}">
+<span class="level">{{.Level}}</span> <strong>{{.ID}}</strong>: {{.Brief}}
+<div class="message">{{.Message}}</div>
+{{- range .Locations}}
+<div class="location">{{.}}</div>
+{{- end}}
+<details>
+<summary>Rule description</summary>
+<p>{{.Description}}</p>
+{{- if .Reference}}
+<p><a href="{{.Reference}}">More information</a></p>
+{{- end}}
+</details>
+</div>
+{{- end}}
+{{- end}}
+</section>
+{{- end}}
+<script>
+document.querySelectorAll("#level-filter input").forEach(function (checkbox) {
+  checkbox.addEventListener("change", function () {
+    document.querySelectorAll(".finding.level-" + checkbox.value).forEach(function (finding) {
+      finding.style.display = checkbox.checked ? "" : "none";
+    });
+  });
+});
+</script>
+</body>
+</html>
+`))
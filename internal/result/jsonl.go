@@ -0,0 +1,113 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// The newline delimited JSON event stream format.
+// Each event is output on a single line as soon as it happens, so consumers can show progress while linting.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/go-paths-helper"
+)
+
+// The types of the jsonl format events.
+const (
+	jsonlProjectStartEvent   = "projectStart"   // Linting of a project started.
+	jsonlRuleResultEvent     = "ruleResult"     // A rule was applied to the project.
+	jsonlProjectSummaryEvent = "projectSummary" // Linting of a project finished.
+	jsonlSummaryEvent        = "summary"        // Linting of all projects finished.
+)
+
+// jsonlEventType is the type of the jsonl format events.
+type jsonlEventType struct {
+	Event   string             `json:"event"`
+	Project *jsonlProjectType  `json:"project,omitempty"`
+	Rule    *ruleReportType    `json:"rule,omitempty"`
+	Summary *summaryReportType `json:"summary,omitempty"`
+}
+
+// jsonlProjectType is the type of the project identification in the jsonl format events.
+type jsonlProjectType struct {
+	Path        *paths.Path `json:"path"`
+	ProjectType string      `json:"projectType"`
+}
+
+// JSONLProjectStart returns the jsonl format event for the start of linting the given project.
+func (results Type) JSONLProjectStart(lintedProject project.Type) string {
+	return jsonlEvent(jsonlEventType{
+		Event:   jsonlProjectStartEvent,
+		Project: &jsonlProjectType{Path: lintedProject.Path, ProjectType: lintedProject.ProjectType.String()},
+	})
+}
+
+// JSONLRuleResult returns the jsonl format event for the last rule result recorded for the given project.
+func (results Type) JSONLRuleResult(lintedProject project.Type) string {
+	projectReport := results.projectReport(lintedProject)
+	// The complete record of rule results is used so that the stream is independent of the verbosity setting.
+	ruleReport := projectReport.allRules[len(projectReport.allRules)-1]
+
+	return jsonlEvent(jsonlEventType{
+		Event:   jsonlRuleResultEvent,
+		Project: &jsonlProjectType{Path: projectReport.Path, ProjectType: projectReport.ProjectType},
+		Rule:    &ruleReport,
+	})
+}
+
+// JSONLProjectSummary returns the jsonl format event for the summary of the rule results of the given project.
+func (results Type) JSONLProjectSummary(lintedProject project.Type) string {
+	projectReport := results.projectReport(lintedProject)
+
+	return jsonlEvent(jsonlEventType{
+		Event:   jsonlProjectSummaryEvent,
+		Project: &jsonlProjectType{Path: projectReport.Path, ProjectType: projectReport.ProjectType},
+		Summary: &projectReport.Summary,
+	})
+}
+
+// JSONLSummary returns the jsonl format event for the summary of the rule results of all projects.
+func (results Type) JSONLSummary() string {
+	return jsonlEvent(jsonlEventType{
+		Event:   jsonlSummaryEvent,
+		Summary: &results.Summary,
+	})
+}
+
+// projectReport returns the report of the given project, which must have been recorded.
+func (results Type) projectReport(lintedProject project.Type) projectReportType {
+	projectReportExists, projectReportIndex := results.getProjectReportIndex(lintedProject.Path)
+	if !projectReportExists {
+		panic(fmt.Sprintf("Unable to find report for %v when generating jsonl event", lintedProject.Path))
+	}
+
+	return results.Projects[projectReportIndex]
+}
+
+// jsonlEvent returns the given event marshaled into a single line of JSON.
+func jsonlEvent(event jsonlEventType) string {
+	var marshaledEventBuffer bytes.Buffer
+	jsonEncoder := json.NewEncoder(&marshaledEventBuffer)
+	jsonEncoder.SetEscapeHTML(false)
+	if err := jsonEncoder.Encode(event); err != nil {
+		panic(fmt.Sprintf("Error while formatting jsonl event: %v", err))
+	}
+
+	return marshaledEventBuffer.String() // The encoder terminates the line.
+}
@@ -0,0 +1,144 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// The JUnit XML report format.
+// Each project is a test suite and each rule that ran on the project is a test case.
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+)
+
+// junitTestSuitesType is the type of the JUnit XML root element.
+type junitTestSuitesType struct {
+	XMLName    xml.Name             `xml:"testsuites"`
+	Name       string               `xml:"name,attr"`
+	Tests      int                  `xml:"tests,attr"`
+	Failures   int                  `xml:"failures,attr"`
+	Skipped    int                  `xml:"skipped,attr"`
+	TestSuites []junitTestSuiteType `xml:"testsuite"`
+}
+
+// junitTestSuiteType is the type of the JUnit XML test suite element for a project.
+type junitTestSuiteType struct {
+	Name       string              `xml:"name,attr"`
+	Tests      int                 `xml:"tests,attr"`
+	Failures   int                 `xml:"failures,attr"`
+	Errors     int                 `xml:"errors,attr"`
+	Skipped    int                 `xml:"skipped,attr"`
+	Properties []junitPropertyType `xml:"properties>property"`
+	TestCases  []junitTestCaseType `xml:"testcase"`
+}
+
+// junitPropertyType is the type of the JUnit XML test suite property element.
+type junitPropertyType struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// junitTestCaseType is the type of the JUnit XML test case element for a rule.
+type junitTestCaseType struct {
+	Name      string            `xml:"name,attr"`
+	ClassName string            `xml:"classname,attr"`
+	Failure   *junitMessageType `xml:"failure,omitempty"`
+	Skipped   *junitMessageType `xml:"skipped,omitempty"`
+	SystemOut string            `xml:"system-out,omitempty"`
+}
+
+// junitMessageType is the type of the JUnit XML failure and skipped elements.
+type junitMessageType struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnitReport returns a JUnit XML formatted report of rules on all projects in string encoding.
+func (results Type) JUnitReport() string {
+	return string(results.junitReportRaw())
+}
+
+// junitReportRaw returns the report marshaled into JUnit XML format in byte encoding.
+func (results Type) junitReportRaw() []byte {
+	testSuites := junitTestSuitesType{
+		Name:       "arduino-lint",
+		TestSuites: []junitTestSuiteType{},
+	}
+
+	for _, projectReport := range results.Projects {
+		testSuite := junitTestSuiteType{
+			Name: projectReport.Path.String(),
+			Properties: []junitPropertyType{
+				{Name: "projectType", Value: projectReport.ProjectType},
+				{Name: "compliance", Value: projectReport.Configuration.Compliance},
+				{Name: "libraryManager", Value: projectReport.Configuration.LibraryManager},
+				{Name: "official", Value: fmt.Sprint(projectReport.Configuration.Official)},
+			},
+			TestCases: []junitTestCaseType{},
+		}
+
+		// The complete record of rule results is used so that the report is independent of the verbosity setting.
+		for _, ruleReport := range projectReport.allRules {
+			testCase := junitTestCaseType{
+				Name:      fmt.Sprintf("%s: %s", ruleReport.ID, ruleReport.Brief),
+				ClassName: fmt.Sprintf("%s.%s.%s", projectReport.ProjectType, ruleReport.Category, ruleReport.Subcategory),
+			}
+
+			switch ruleReport.Result {
+			case ruleresult.Fail.String():
+				if ruleReport.Level == rulelevel.Error.String() {
+					testCase.Failure = &junitMessageType{
+						Message: ruleReport.Brief,
+						Type:    ruleReport.Level,
+						Text:    ruleReport.Message,
+					}
+					testSuite.Failures++
+				} else {
+					// Only errors cause the lint to fail, so lower level violations are reported as passing test case output.
+					testCase.SystemOut = fmt.Sprintf("%s: %s", ruleReport.Level, ruleReport.Message)
+				}
+			case ruleresult.Skip.String(), ruleresult.NotRun.String(), ruleresult.Suppressed.String():
+				testCase.Skipped = &junitMessageType{
+					Message: fmt.Sprintf("%s: %s", ruleReport.Result, ruleReport.Message),
+				}
+				testSuite.Skipped++
+			}
+
+			testSuite.TestCases = append(testSuite.TestCases, testCase)
+			testSuite.Tests++
+		}
+
+		testSuites.TestSuites = append(testSuites.TestSuites, testSuite)
+		testSuites.Tests += testSuite.Tests
+		testSuites.Failures += testSuite.Failures
+		testSuites.Skipped += testSuite.Skipped
+	}
+
+	marshaledReportBuffer := bytes.NewBufferString(xml.Header)
+	xmlEncoder := xml.NewEncoder(marshaledReportBuffer)
+	xmlEncoder.Indent("", "  ")
+	if err := xmlEncoder.Encode(testSuites); err != nil {
+		panic(fmt.Sprintf("Error while formatting JUnit rules report: %v", err))
+	}
+	marshaledReportBuffer.WriteString("\n")
+
+	return marshaledReportBuffer.Bytes()
+}
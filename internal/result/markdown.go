@@ -0,0 +1,131 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// The Markdown format, for use in GitHub Actions job summaries and pull request comments.
+// It uses the GitHub Flavored Markdown table extension and HTML <details> elements.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+)
+
+// MarkdownReport returns a Markdown formatted report of rules on all projects in string encoding.
+func (results Type) MarkdownReport() string {
+	return string(results.markdownReportRaw())
+}
+
+// markdownReportRaw returns the report formatted as Markdown in byte encoding.
+func (results Type) markdownReportRaw() []byte {
+	var report strings.Builder
+
+	report.WriteString("## Arduino Lint results\n\n")
+	report.WriteString("| Project | Type | Result | Errors | Warnings |\n")
+	report.WriteString("| --- | --- | --- | ---: | ---: |\n")
+	for _, projectReport := range results.Projects {
+		fmt.Fprintf(
+			&report,
+			"| %s | %s | %s | %d | %d |\n",
+			markdownEscape(workingDirectorySlashPath(projectReport.Path)),
+			projectReport.ProjectType,
+			markdownResult(projectReport.Summary.Pass),
+			projectReport.Summary.ErrorCount,
+			projectReport.Summary.WarningCount,
+		)
+	}
+	if len(results.Projects) > 1 {
+		fmt.Fprintf(
+			&report,
+			"| **Total** | | %s | %d | %d |\n",
+			markdownResult(results.Summary.Pass),
+			results.Summary.ErrorCount,
+			results.Summary.WarningCount,
+		)
+	}
+
+	for _, projectReport := range results.Projects {
+		// The complete record of rule results is used so that the report is independent of the verbosity setting.
+		findings := []ruleReportType{}
+		for _, ruleReport := range projectReport.allRules {
+			if ruleReport.Result == ruleresult.Fail.String() {
+				findings = append(findings, ruleReport)
+			}
+		}
+		if len(findings) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(
+			&report,
+			"\n<details>\n<summary>%s %s: %d rule violation(s)</summary>\n\n",
+			projectReport.ProjectType,
+			markdownEscape(workingDirectorySlashPath(projectReport.Path)),
+			len(findings),
+		)
+		for _, ruleReport := range findings {
+			report.WriteString(markdownFinding(ruleReport))
+		}
+		report.WriteString("\n</details>\n")
+	}
+
+	return []byte(report.String())
+}
+
+// markdownResult returns the Markdown representation of the pass/fail result.
+func markdownResult(pass bool) string {
+	if pass {
+		return ":white_check_mark: pass"
+	}
+	return ":x: fail"
+}
+
+// markdownFinding returns the Markdown list item for the given rule violation.
+func markdownFinding(ruleReport ruleReportType) string {
+	ruleID := ruleReport.ID
+	message := ruleReport.Message
+	if ruleReport.reference != "" {
+		ruleID = fmt.Sprintf("[%s](%s)", ruleReport.ID, ruleReport.reference)
+		// The rule ID is linked to the reference, so the reference URL appended to the message would be redundant.
+		message = strings.TrimSuffix(message, "\nSee: "+ruleReport.reference)
+	}
+
+	item := fmt.Sprintf("- **%s** %s: %s", ruleReport.Level, ruleID, markdownEscape(message))
+	for _, location := range ruleReport.Locations {
+		item += fmt.Sprintf(" `%s`", htmlLocation(location))
+	}
+
+	// Indentation keeps the lines of multi-line messages in the list item.
+	return strings.ReplaceAll(item, "\n", "\n  ") + "\n"
+}
+
+// markdownEscape escapes the characters of the text that have special meaning in Markdown.
+func markdownEscape(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		"`", "\\`",
+		"*", `\*`,
+		"_", `\_`,
+		"[", `\[`,
+		"]", `\]`,
+		"<", `\<`,
+		">", `\>`,
+		"|", `\|`,
+		"#", `\#`,
+	).Replace(text)
+}
@@ -32,13 +32,37 @@ const (
 	Text Type = iota // text
 	// JSON is the JSON output format.
 	JSON // json
+	// SARIF is the SARIF 2.1.0 output format.
+	SARIF // sarif
+	// JUnit is the JUnit XML output format.
+	JUnit // junit
+	// GitHub is the GitHub Actions workflow commands output format.
+	GitHub // github
+	// CodeClimate is the Code Climate JSON output format used by GitLab Code Quality.
+	CodeClimate // codeclimate
+	// Checkstyle is the Checkstyle XML output format.
+	Checkstyle // checkstyle
+	// HTML is the static HTML page output format.
+	HTML // html
+	// Markdown is the Markdown output format.
+	Markdown // markdown
+	// JSONL is the newline delimited JSON event stream output format.
+	JSONL // jsonl
 )
 
 // FromString parses the --format flag value and returns the corresponding output format type.
 func FromString(outputFormatString string) (Type, error) {
 	formatType, found := map[string]Type{
-		Text.String(): Text,
-		JSON.String(): JSON,
+		Text.String():        Text,
+		JSON.String():        JSON,
+		SARIF.String():       SARIF,
+		JUnit.String():       JUnit,
+		GitHub.String():      GitHub,
+		CodeClimate.String(): CodeClimate,
+		Checkstyle.String():  Checkstyle,
+		HTML.String():        HTML,
+		Markdown.String():    Markdown,
+		JSONL.String():       JSONL,
 	}[strings.ToLower(outputFormatString)]
 
 	if found {
@@ -30,6 +30,14 @@ func TestFromString(t *testing.T) {
 	}{
 		{"text", Text, assert.NoError},
 		{"json", JSON, assert.NoError},
+		{"sarif", SARIF, assert.NoError},
+		{"junit", JUnit, assert.NoError},
+		{"github", GitHub, assert.NoError},
+		{"codeclimate", CodeClimate, assert.NoError},
+		{"checkstyle", Checkstyle, assert.NoError},
+		{"html", HTML, assert.NoError},
+		{"markdown", Markdown, assert.NoError},
+		{"jsonl", JSONL, assert.NoError},
 		{"TEXT", Text, assert.NoError},
 		{"foo", 0, assert.Error},
 	}
@@ -10,11 +10,19 @@ func _() {
 	var x [1]struct{}
 	_ = x[Text-0]
 	_ = x[JSON-1]
+	_ = x[SARIF-2]
+	_ = x[JUnit-3]
+	_ = x[GitHub-4]
+	_ = x[CodeClimate-5]
+	_ = x[Checkstyle-6]
+	_ = x[HTML-7]
+	_ = x[Markdown-8]
+	_ = x[JSONL-9]
 }
 
-const _Type_name = "textjson"
+const _Type_name = "textjsonsarifjunitgithubcodeclimatecheckstylehtmlmarkdownjsonl"
 
-var _Type_index = [...]uint8{0, 4, 8}
+var _Type_index = [...]uint8{0, 4, 8, 13, 18, 24, 35, 45, 49, 57, 62}
 
 func (i Type) String() string {
 	idx := int(i) - 0
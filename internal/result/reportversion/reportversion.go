@@ -0,0 +1,50 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package reportversion defines the versions of the JSON report format.
+package reportversion
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Type is the type for the JSON report format versions.
+type Type int
+
+const (
+	// V1 is the original report format, which has no reportVersion field and no rule violation locations.
+	V1 Type = iota + 1
+	// V2 adds the reportVersion field and the rule violation locations.
+	V2
+	// V3 adds the counts of passed, skipped, and not run rules to the summaries.
+	V3
+	// V4 reports each violation of a rule that checks multiple items of a project separately.
+	V4
+)
+
+// Latest is the version of the report format produced by default.
+const Latest = V4
+
+// FromString parses the --report-version flag value and returns the corresponding report version.
+func FromString(reportVersionString string) (Type, error) {
+	reportVersion, err := strconv.Atoi(reportVersionString)
+	if err != nil || reportVersion < int(V1) || reportVersion > int(Latest) {
+		return Latest, fmt.Errorf("No matching report version for string %s", reportVersionString)
+	}
+
+	return Type(reportVersion), nil
+}
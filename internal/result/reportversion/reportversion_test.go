@@ -0,0 +1,47 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package reportversion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromString(t *testing.T) {
+	testTables := []struct {
+		reportVersionString   string
+		expectedReportVersion Type
+		errorAssertion        assert.ErrorAssertionFunc
+	}{
+		{"1", V1, assert.NoError},
+		{"2", V2, assert.NoError},
+		{"3", V3, assert.NoError},
+		{"4", V4, assert.NoError},
+		{"0", Latest, assert.Error},
+		{"5", Latest, assert.Error},
+		{"foo", Latest, assert.Error},
+	}
+
+	for _, testTable := range testTables {
+		reportVersion, err := FromString(testTable.reportVersionString)
+		testTable.errorAssertion(t, err, testTable.reportVersionString)
+		if err == nil {
+			assert.Equal(t, testTable.expectedReportVersion, reportVersion, testTable.reportVersionString)
+		}
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"text/template"
@@ -29,7 +31,11 @@ import (
 	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
 	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/result/failon"
+	"github.com/arduino/arduino-lint/internal/result/outputformat"
+	"github.com/arduino/arduino-lint/internal/result/reportversion"
 	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulefunction"
 	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
 	"github.com/arduino/go-paths-helper"
@@ -38,14 +44,14 @@ import (
 	"github.com/olekukonko/tablewriter/tw"
 )
 
-// Results is the global instance of the rule results result.Type struct
-var Results Type
-
 // Type is the type for the rule results data
 type Type struct {
-	Configuration toolConfigurationReportType `json:"configuration"`
-	Projects      []projectReportType         `json:"projects"`
-	Summary       summaryReportType           `json:"summary"`
+	ReportVersion     reportversion.Type               `json:"reportVersion,omitempty"`
+	Configuration     toolConfigurationReportType      `json:"configuration"`
+	Projects          []projectReportType              `json:"projects"`
+	Summary           summaryReportType                `json:"summary"`
+	baseline          map[baselineFingerprintType]bool // Rule violations recorded in the baseline file.
+	toolConfiguration *configuration.Type              // The tool configuration the rules were run under.
 }
 
 // toolConfigurationReportType is the type for the Arduino Lint tool configuration.
@@ -62,6 +68,8 @@ type projectReportType struct {
 	Configuration projectConfigurationReportType `json:"configuration"`
 	Rules         []ruleReportType               `json:"rules"`
 	Summary       summaryReportType              `json:"summary"`
+	Timings       *timingsReportType             `json:"timings,omitempty"` // Only recorded when the --timings flag is set.
+	allRules      []ruleReportType               // Reports of every rule that ran, regardless of verbosity setting.
 }
 
 // projectConfigurationReportType is the type for the individual project tool configurations.
@@ -73,54 +81,100 @@ type projectConfigurationReportType struct {
 
 // ruleReportType is the type of the rule reports.
 type ruleReportType struct {
-	Category    string `json:"category"`
-	Subcategory string `json:"subcategory"`
-	ID          string `json:"ID"`
-	Brief       string `json:"brief"`
-	Description string `json:"description"`
-	Result      string `json:"result"`
-	Level       string `json:"level"`
-	Message     string `json:"message"`
+	Category    string                  `json:"category"`
+	Subcategory string                  `json:"subcategory"`
+	ID          string                  `json:"ID"`
+	Brief       string                  `json:"brief"`
+	Description string                  `json:"description"`
+	Result      string                  `json:"result"`
+	Level       string                  `json:"level"`
+	Message     string                  `json:"message"`
+	Locations   []locationReportType    `json:"locations,omitempty"`
+	reference   string                  // URL of the rule's reference documentation.
+	fingerprint baselineFingerprintType // Identifies the rule violation in the baseline file.
+	baselined   bool                    // The rule violation is recorded in the baseline file.
+	violation   bool                    // The report is one of the violations of a rule, which are reported separately.
+	ruleMessage string                  // Message for all violations of the rule, used when they are combined in a single report.
+}
+
+// locationReportType is the type of the rule violation location reports.
+type locationReportType struct {
+	Path   *paths.Path `json:"path"`
+	Line   int         `json:"line,omitempty"`
+	Column int         `json:"column,omitempty"`
 }
 
 // summaryReportType is the type of the rule result summary reports.
 type summaryReportType struct {
 	Pass         bool `json:"pass"`
-	WarningCount int  `json:"warningCount"`
-	ErrorCount   int  `json:"errorCount"`
+	WarningCount int  `json:"warningCount"` // Number of rule violations of warning level. Each violation of a rule is counted.
+	ErrorCount   int  `json:"errorCount"`   // Number of rule violations of error level. Each violation of a rule is counted.
+	PassCount    int  `json:"passCount"`    // Number of rules that passed.
+	SkipCount    int  `json:"skipCount"`    // Number of rules that were skipped because they don't apply to the project or were disabled by the user.
+	NotRunCount  int  `json:"notRunCount"`  // Number of rules that were unable to run.
+	legacyFormat bool // Omit the rule counts, which were added in report format version 3.
 }
 
-// Initialize adds the tool configuration data to the results data.
-func (results *Type) Initialize() {
+// MarshalJSON returns the summary report in the shape of its report format version.
+func (summaryReport summaryReportType) MarshalJSON() ([]byte, error) {
+	if summaryReport.legacyFormat {
+		return json.Marshal(struct {
+			Pass         bool `json:"pass"`
+			WarningCount int  `json:"warningCount"`
+			ErrorCount   int  `json:"errorCount"`
+		}{
+			Pass:         summaryReport.Pass,
+			WarningCount: summaryReport.WarningCount,
+			ErrorCount:   summaryReport.ErrorCount,
+		})
+	}
+
+	type summaryReportFieldsType summaryReportType // Type without the MarshalJSON method, to avoid recursion.
+	return json.Marshal(summaryReportFieldsType(summaryReport))
+}
+
+// Initialize adds the data of the given tool configuration to the results data.
+func (results *Type) Initialize(toolConfiguration *configuration.Type) {
 	*results = *new(Type)
+	results.toolConfiguration = toolConfiguration
 	results.Configuration = toolConfigurationReportType{
-		Paths:       configuration.TargetPaths(),
-		ProjectType: configuration.SuperprojectTypeFilter().String(),
-		Recursive:   configuration.Recursive(),
+		Paths:       toolConfiguration.TargetPaths(),
+		ProjectType: toolConfiguration.SuperprojectTypeFilter().String(),
+		Recursive:   toolConfiguration.Recursive(),
 	}
 }
 
 var blankLineRegexp = regexp.MustCompile("\n[[:space:]]*\n")
 
 // Record records the result of a rule and returns a text summary for it.
-func (results *Type) Record(lintedProject project.Type, ruleConfiguration ruleconfiguration.Type, ruleResult ruleresult.Type, ruleOutput string) string {
-	ruleLevel, err := rulelevel.RuleLevel(ruleConfiguration, ruleResult, lintedProject)
+func (results *Type) Record(lintedProject project.Type, ruleConfiguration ruleconfiguration.Type, ruleResult ruleresult.Type, ruleOutput string, ruleFindings []rulefunction.Finding) string {
+	return results.record(lintedProject, ruleConfiguration, ruleResult, ruleOutput, ruleFindings, nil)
+}
+
+// RecordViolation records the result of one of the violations of a rule, which are reported separately, and returns a
+// text summary for it. The rule output is the output for all violations of the rule, which is used for the report
+// format versions where they are combined in a single report.
+func (results *Type) RecordViolation(lintedProject project.Type, ruleConfiguration ruleconfiguration.Type, ruleOutput string, violationResult ruleresult.Type, violationOutput string, violationFindings []rulefunction.Finding) string {
+	return results.record(lintedProject, ruleConfiguration, violationResult, violationOutput, violationFindings, &ruleOutput)
+}
+
+// record records the result of a rule, or of one of its violations when the output for all violations of the rule is
+// provided, and returns a text summary for it.
+func (results *Type) record(lintedProject project.Type, ruleConfiguration ruleconfiguration.Type, ruleResult ruleresult.Type, ruleOutput string, ruleFindings []rulefunction.Finding, violationsOutput *string) string {
+	ruleLevel, err := rulelevel.RuleLevel(ruleConfiguration, ruleResult, lintedProject, results.toolConfiguration)
 	if err != nil {
 		panic(fmt.Errorf("Error while determining rule level: %v", err))
 	}
 
-	ruleMessage := ""
-	if ruleResult == ruleresult.Fail {
-		ruleMessage = message(ruleConfiguration.MessageTemplate, ruleOutput)
-		if ruleConfiguration.Reference != "" {
-			ruleMessage = fmt.Sprintf("%s\nSee: %s", ruleMessage, ruleConfiguration.Reference)
-		}
-	} else {
-		// Rules may provide an explanation for their non-fail result.
-		// The message template should not be used in this case, since it is written for a failure result.
-		ruleMessage = ruleOutput
+	fingerprint := results.baselineFingerprint(lintedProject, ruleConfiguration, ruleOutput, ruleFindings)
+	baselined := ruleResult == ruleresult.Fail && results.inBaseline(fingerprint)
+	if baselined {
+		// The violation is recorded in the baseline, so it should not cause a failure.
+		ruleLevel = rulelevel.Notice
 	}
 
+	ruleMessage := resultMessage(ruleConfiguration, ruleResult, ruleOutput)
+
 	summaryText := ""
 
 	formatRuleText := func(level rulelevel.Type, message string) string {
@@ -170,18 +224,22 @@ func (results *Type) Record(lintedProject project.Type, ruleConfiguration ruleco
 		return formattedOutput.String()
 	}
 
-	if configuration.Verbose() {
+	if results.toolConfiguration.Verbose() {
 		summaryText = fmt.Sprintf("Rule %s result: %s\n", ruleConfiguration.ID, ruleResult)
 		// Add explanation of rule result if present.
 		if ruleMessage != "" {
 			summaryText += formatRuleText(ruleLevel, ruleMessage)
 		}
 	} else {
-		if ruleResult == ruleresult.Fail {
+		if ruleResult == ruleresult.Fail || ruleResult == ruleresult.Suppressed {
+			ruleText := fmt.Sprintf("Rule %s", ruleConfiguration.ID)
+			if ruleResult == ruleresult.Suppressed {
+				ruleText += ", suppressed"
+			}
 			if strings.Contains(ruleMessage, "\n") {
-				summaryText = formatRuleText(ruleLevel, fmt.Sprintf("%s\n(Rule %s)", ruleMessage, ruleConfiguration.ID))
+				summaryText = formatRuleText(ruleLevel, fmt.Sprintf("%s\n(%s)", ruleMessage, ruleText))
 			} else {
-				summaryText = formatRuleText(ruleLevel, fmt.Sprintf("%s (Rule %s)", ruleMessage, ruleConfiguration.ID))
+				summaryText = formatRuleText(ruleLevel, fmt.Sprintf("%s (%s)", ruleMessage, ruleText))
 			}
 		}
 	}
@@ -195,32 +253,74 @@ func (results *Type) Record(lintedProject project.Type, ruleConfiguration ruleco
 				Path:        lintedProject.Path,
 				ProjectType: lintedProject.ProjectType.String(),
 				Configuration: projectConfigurationReportType{
-					Compliance:     rulemode.Compliance(configuration.RuleModes(lintedProject.ProjectType)),
-					LibraryManager: rulemode.LibraryManager(configuration.RuleModes(lintedProject.ProjectType)),
-					Official:       configuration.RuleModes(lintedProject.ProjectType)[rulemode.Official],
+					Compliance:     rulemode.Compliance(results.toolConfiguration.RuleModes(lintedProject.ProjectType)),
+					LibraryManager: rulemode.LibraryManager(results.toolConfiguration.RuleModes(lintedProject.ProjectType)),
+					Official:       results.toolConfiguration.RuleModes(lintedProject.ProjectType)[rulemode.Official],
 				},
 				Rules: []ruleReportType{},
 			},
 		)
 	}
 
-	if (ruleResult == ruleresult.Fail) || configuration.Verbose() {
-		ruleReport := ruleReportType{
-			Category:    ruleConfiguration.Category,
-			Subcategory: ruleConfiguration.Subcategory,
-			ID:          ruleConfiguration.ID,
-			Brief:       ruleConfiguration.Brief,
-			Description: ruleConfiguration.Description,
-			Result:      ruleResult.String(),
-			Level:       ruleLevel.String(),
-			Message:     ruleMessage,
-		}
+	ruleReport := ruleReportType{
+		Category:    ruleConfiguration.Category,
+		Subcategory: ruleConfiguration.Subcategory,
+		ID:          ruleConfiguration.ID,
+		Brief:       ruleConfiguration.Brief,
+		Description: ruleConfiguration.Description,
+		Result:      ruleResult.String(),
+		Level:       ruleLevel.String(),
+		Message:     ruleMessage,
+		Locations:   locationReports(ruleFindings),
+		reference:   ruleConfiguration.Reference,
+		fingerprint: fingerprint,
+		baselined:   baselined,
+	}
+	if violationsOutput != nil {
+		ruleReport.violation = true
+		ruleReport.ruleMessage = resultMessage(ruleConfiguration, ruleResult, *violationsOutput)
+	}
+	results.Projects[projectReportIndex].allRules = append(results.Projects[projectReportIndex].allRules, ruleReport)
+	if (ruleResult == ruleresult.Fail) || (ruleResult == ruleresult.Suppressed) || results.toolConfiguration.Verbose() || results.toolConfiguration.FullReportDetail() {
 		results.Projects[projectReportIndex].Rules = append(results.Projects[projectReportIndex].Rules, ruleReport)
 	}
 
 	return summaryText
 }
 
+// resultMessage returns the report message for the given rule result and output.
+func resultMessage(ruleConfiguration ruleconfiguration.Type, ruleResult ruleresult.Type, ruleOutput string) string {
+	if ruleResult != ruleresult.Fail && ruleResult != ruleresult.Suppressed {
+		// Rules may provide an explanation for their non-fail result.
+		// The message template should not be used in this case, since it is written for a failure result.
+		return ruleOutput
+	}
+
+	ruleMessage := message(ruleConfiguration.MessageTemplate, ruleOutput)
+	if ruleConfiguration.Reference != "" {
+		ruleMessage = fmt.Sprintf("%s\nSee: %s", ruleMessage, ruleConfiguration.Reference)
+	}
+
+	return ruleMessage
+}
+
+// locationReports returns the location reports for the given rule findings.
+func locationReports(ruleFindings []rulefunction.Finding) []locationReportType {
+	var locations []locationReportType
+	for _, ruleFinding := range ruleFindings {
+		locations = append(
+			locations,
+			locationReportType{
+				Path:   ruleFinding.Path,
+				Line:   ruleFinding.Line,
+				Column: ruleFinding.Column,
+			},
+		)
+	}
+
+	return locations
+}
+
 // AddProjectSummary summarizes the results of all rules on the given project and adds it to the report.
 func (results *Type) AddProjectSummary(lintedProject project.Type) {
 	reportExists, projectReportIndex := results.getProjectReportIndex(lintedProject.Path)
@@ -228,24 +328,56 @@ func (results *Type) AddProjectSummary(lintedProject project.Type) {
 		panic(fmt.Sprintf("Unable to find report for %v when generating report summary", lintedProject.Path))
 	}
 
-	pass := true
-	warningCount := 0
-	errorCount := 0
+	summaryReport := summaryReportType{Pass: true}
+	summaryReport.WarningCount, summaryReport.ErrorCount = violationCounts(results.Projects[projectReportIndex].Rules)
 	for _, ruleReport := range results.Projects[projectReportIndex].Rules {
+		if ruleReport.Result == ruleresult.Fail.String() && results.failsThreshold(ruleReport.Level) {
+			summaryReport.Pass = false
+		}
+	}
+
+	// The complete record of rule results is used so that the counts are independent of the verbosity setting.
+	for _, ruleReport := range results.Projects[projectReportIndex].allRules {
+		switch ruleReport.Result {
+		case ruleresult.Pass.String():
+			summaryReport.PassCount++
+		case ruleresult.Skip.String():
+			summaryReport.SkipCount++
+		case ruleresult.NotRun.String():
+			summaryReport.NotRunCount++
+		}
+	}
+
+	results.Projects[projectReportIndex].Summary = summaryReport
+}
+
+// violationCounts returns the number of rule violations of warning and error level in the given rule reports.
+func violationCounts(ruleReports []ruleReportType) (warningCount int, errorCount int) {
+	for _, ruleReport := range ruleReports {
 		if ruleReport.Result == ruleresult.Fail.String() {
 			if ruleReport.Level == rulelevel.Warning.String() {
 				warningCount++
 			} else if ruleReport.Level == rulelevel.Error.String() {
 				errorCount++
-				pass = false
 			}
 		}
 	}
 
-	results.Projects[projectReportIndex].Summary = summaryReportType{
-		Pass:         pass,
-		WarningCount: warningCount,
-		ErrorCount:   errorCount,
+	return warningCount, errorCount
+}
+
+// failsThreshold returns whether a rule violation of the given level causes the linting to fail under the --fail-on
+// setting.
+func (results Type) failsThreshold(level string) bool {
+	switch results.toolConfiguration.FailOn() {
+	case failon.Never:
+		return false
+	case failon.Info:
+		return level == rulelevel.Info.String() || level == rulelevel.Warning.String() || level == rulelevel.Error.String()
+	case failon.Warning:
+		return level == rulelevel.Warning.String() || level == rulelevel.Error.String()
+	default:
+		return level == rulelevel.Error.String()
 	}
 }
 
@@ -256,47 +388,37 @@ func (results Type) ProjectSummaryText(lintedProject project.Type) string {
 		panic(fmt.Sprintf("Unable to find report for %v when generating report summary text", lintedProject.Path))
 	}
 
-	projectSummaryReport := "Linter results for project: "
-	projectSummaryData := results.Projects[projectReportIndex].Summary
-	if projectSummaryData.ErrorCount == 0 && projectSummaryData.WarningCount == 0 {
-		projectSummaryReport += "no errors or warnings"
-	} else {
-		projectSummaryReport += fmt.Sprintf("%v ERRORS, %v WARNINGS", projectSummaryData.ErrorCount, projectSummaryData.WarningCount)
-	}
-
-	return projectSummaryReport
+	return "Linter results for project: " + summaryCountsText(results.Projects[projectReportIndex].Summary)
 }
 
 // AddSummary summarizes the rule results for all projects and adds it to the report.
 func (results *Type) AddSummary() {
-	pass := true
-	warningCount := 0
-	errorCount := 0
+	summaryReport := summaryReportType{Pass: true}
 	for _, projectReport := range results.Projects {
 		if !projectReport.Summary.Pass {
-			pass = false
+			summaryReport.Pass = false
 		}
-		warningCount += projectReport.Summary.WarningCount
-		errorCount += projectReport.Summary.ErrorCount
+		summaryReport.WarningCount += projectReport.Summary.WarningCount
+		summaryReport.ErrorCount += projectReport.Summary.ErrorCount
+		summaryReport.PassCount += projectReport.Summary.PassCount
+		summaryReport.SkipCount += projectReport.Summary.SkipCount
+		summaryReport.NotRunCount += projectReport.Summary.NotRunCount
 	}
 
-	results.Summary = summaryReportType{
-		Pass:         pass,
-		WarningCount: warningCount,
-		ErrorCount:   errorCount,
-	}
+	results.Summary = summaryReport
 }
 
 // SummaryText returns a text summary of the cumulative rule results.
 func (results Type) SummaryText() string {
-	summaryReport := "Linter results for projects: "
-	if results.Summary.ErrorCount == 0 && results.Summary.WarningCount == 0 {
-		summaryReport += "no errors or warnings"
-	} else {
-		summaryReport += fmt.Sprintf("%v ERRORS, %v WARNINGS", results.Summary.ErrorCount, results.Summary.WarningCount)
-	}
+	return "Linter results for projects: " + summaryCountsText(results.Summary)
+}
 
-	return summaryReport
+// summaryCountsText returns a text summary of the rule violation counts of the given summary report.
+func summaryCountsText(summaryReport summaryReportType) string {
+	if summaryReport.ErrorCount == 0 && summaryReport.WarningCount == 0 {
+		return "no errors or warnings"
+	}
+	return fmt.Sprintf("%v ERRORS, %v WARNINGS", summaryReport.ErrorCount, summaryReport.WarningCount)
 }
 
 // JSONReport returns a JSON formatted report of rules on all projects in string encoding.
@@ -312,7 +434,7 @@ func (results Type) jsonReportRaw() []byte {
 	// This means that the simple json.MarshalIndent() approach would result in the report containing gibberish.
 	jsonEncoder.SetEscapeHTML(false)
 	jsonEncoder.SetIndent("", "  ")
-	err := jsonEncoder.Encode(results)
+	err := jsonEncoder.Encode(results.versionedReport(results.toolConfiguration.ReportVersion()))
 	if err != nil {
 		panic(fmt.Sprintf("Error while formatting rules report: %v", err))
 	}
@@ -320,9 +442,92 @@ func (results Type) jsonReportRaw() []byte {
 	return marshaledReportBuffer.Bytes()
 }
 
+// versionedReport returns the report data in the shape of the given report format version.
+func (results Type) versionedReport(reportVersion reportversion.Type) Type {
+	versionedResults := results
+	versionedResults.ReportVersion = reportVersion
+	if reportVersion == reportversion.Latest {
+		return versionedResults
+	}
+
+	versionedResults.Summary.legacyFormat = reportVersion < reportversion.V3
+	// The violations of a rule are combined in a single report, so the counts of the summaries are of rules.
+	versionedResults.Summary.WarningCount = 0
+	versionedResults.Summary.ErrorCount = 0
+	versionedResults.Projects = make([]projectReportType, len(results.Projects))
+	for projectIndex, projectReport := range results.Projects {
+		projectReport.Summary.legacyFormat = reportVersion < reportversion.V3
+		projectReport.Rules = combinedViolationReports(results.Projects[projectIndex].Rules)
+		for ruleIndex := range projectReport.Rules {
+			if reportVersion == reportversion.V1 {
+				projectReport.Rules[ruleIndex].Locations = nil
+			}
+		}
+		projectReport.Summary.WarningCount, projectReport.Summary.ErrorCount = violationCounts(projectReport.Rules)
+		versionedResults.Summary.WarningCount += projectReport.Summary.WarningCount
+		versionedResults.Summary.ErrorCount += projectReport.Summary.ErrorCount
+		versionedResults.Projects[projectIndex] = projectReport
+	}
+
+	if reportVersion == reportversion.V1 {
+		// The original report format has no version field.
+		versionedResults.ReportVersion = 0
+	}
+
+	return versionedResults
+}
+
+// combinedViolationReports returns the given rule reports with the separately reported violations of each rule combined
+// in a single report, as in the report format versions before 4.
+// The combined report fails if any of the violations failed, with the level of that violation.
+func combinedViolationReports(ruleReports []ruleReportType) []ruleReportType {
+	combinedReports := []ruleReportType{}
+	for _, ruleReport := range ruleReports {
+		lastIndex := len(combinedReports) - 1
+		if ruleReport.violation && lastIndex >= 0 && combinedReports[lastIndex].violation && combinedReports[lastIndex].ID == ruleReport.ID {
+			combinedReport := &combinedReports[lastIndex]
+			combinedReport.Locations = append(combinedReport.Locations, ruleReport.Locations...)
+			if ruleReport.Result == ruleresult.Fail.String() && (combinedReport.Result != ruleresult.Fail.String() || combinedReport.Level == rulelevel.Notice.String()) {
+				combinedReport.Result = ruleReport.Result
+				combinedReport.Level = ruleReport.Level
+			}
+			continue
+		}
+
+		if ruleReport.violation {
+			ruleReport.Message = ruleReport.ruleMessage
+			// The locations of the other violations are added to a copy, so that the original report is not modified.
+			ruleReport.Locations = append([]locationReportType(nil), ruleReport.Locations...)
+		}
+		combinedReports = append(combinedReports, ruleReport)
+	}
+
+	return combinedReports
+}
+
+// reportRaw returns the report marshaled into the given format in byte encoding.
+func (results Type) reportRaw(format outputformat.Type) []byte {
+	switch format {
+	case outputformat.SARIF:
+		return results.sarifReportRaw()
+	case outputformat.JUnit:
+		return results.junitReportRaw()
+	case outputformat.CodeClimate:
+		return results.codeClimateReportRaw()
+	case outputformat.Checkstyle:
+		return results.checkstyleReportRaw()
+	case outputformat.HTML:
+		return results.htmlReportRaw()
+	case outputformat.Markdown:
+		return results.markdownReportRaw()
+	default:
+		return results.jsonReportRaw()
+	}
+}
+
 // WriteReport writes a report for all projects to the specified file.
 func (results Type) WriteReport() error {
-	reportFilePath := configuration.ReportFilePath()
+	reportFilePath := results.toolConfiguration.ReportFilePath()
 	reportFilePathParentExists, err := reportFilePath.Parent().ExistCheck()
 	if err != nil {
 		return fmt.Errorf("Problem processing --report-file flag value %v: %v", reportFilePath, err)
@@ -334,7 +539,7 @@ func (results Type) WriteReport() error {
 		}
 	}
 
-	err = reportFilePath.WriteFile(results.jsonReportRaw())
+	err = reportFilePath.WriteFile(results.reportRaw(results.toolConfiguration.ReportFormat()))
 	if err != nil {
 		return fmt.Errorf("While writing report: %v", err)
 	}
@@ -371,3 +576,33 @@ func message(templateText string, ruleOutput string) string {
 
 	return messageBuffer.String()
 }
+
+// workingDirectoryRelativePath returns the given absolute path relative to the working directory, and whether the path
+// is inside the working directory.
+func workingDirectoryRelativePath(absolutePath *paths.Path) (*paths.Path, bool) {
+	workingDirectoryPath, err := os.Getwd()
+	if err != nil {
+		panic(err)
+	}
+
+	relativePath, err := absolutePath.RelFrom(paths.New(workingDirectoryPath))
+	if err != nil || relativePath.String() == ".." || strings.HasPrefix(relativePath.String(), ".."+string(filepath.Separator)) {
+		return nil, false
+	}
+
+	return relativePath, true
+}
+
+// workingDirectorySlashPath returns the path relative to the working directory if possible, using forward slashes.
+func workingDirectorySlashPath(path *paths.Path) string {
+	absolutePath, err := path.Abs()
+	if err != nil {
+		panic(err)
+	}
+
+	if relativePath, ok := workingDirectoryRelativePath(absolutePath); ok {
+		return filepath.ToSlash(relativePath.String())
+	}
+
+	return filepath.ToSlash(absolutePath.String())
+}
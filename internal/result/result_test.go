@@ -17,18 +17,28 @@
 package result
 
 import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/result/reportversion"
 	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulefunction"
 	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/arduino-lint/internal/rule/schema"
+	"github.com/arduino/arduino-lint/internal/rule/schema/schemadata"
 	"github.com/arduino/arduino-lint/internal/util/test"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
@@ -52,10 +62,10 @@ func TestInitialize(t *testing.T) {
 	workingDirectoryPath, err := os.Getwd() // A convenient path that is guaranteed to exist.
 	require.Nil(t, err)
 
-	err = configuration.Initialize(flags, []string{workingDirectoryPath})
+	toolConfiguration, err := configuration.Initialize(flags, []string{workingDirectoryPath})
 	require.Nil(t, err)
 	var results Type
-	results.Initialize()
+	results.Initialize(toolConfiguration)
 	assert.Equal(t, paths.NewPathList(workingDirectoryPath), results.Configuration.Paths)
 	assert.Equal(t, projecttype.Sketch.String(), results.Configuration.ProjectType)
 	assert.False(t, results.Configuration.Recursive)
@@ -63,7 +73,8 @@ func TestInitialize(t *testing.T) {
 
 func TestRecord(t *testing.T) {
 	flags := test.ConfigurationFlags()
-	require.Nil(t, configuration.Initialize(flags, projectPaths))
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
 
 	lintedProject := project.Type{
 		Path:             paths.New("/foo/bar"),
@@ -72,52 +83,57 @@ func TestRecord(t *testing.T) {
 	}
 
 	var results Type
-	results.Initialize()
+	results.Initialize(toolConfiguration)
 	ruleConfiguration := ruleconfiguration.Configurations()[0]
 	ruleOutput := "foo"
 	flags.Set("verbose", "true")
-	require.Nil(t, configuration.Initialize(flags, projectPaths))
+	toolConfiguration, err = configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+	results.toolConfiguration = toolConfiguration
 	ruleConfiguration.Reference = ""
-	summaryText := results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput)
+	summaryText := results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput, nil)
 	outputAssertion := "Rule LS001 result: fail\nERROR: Path does not contain a valid Arduino library.\n"
 	assert.Equal(t, outputAssertion, summaryText, "No reference URL")
 	ruleConfiguration.Reference = "https://arduino.github.io/arduino-cli/latest/library-specification"
-	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput)
+	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput, nil)
 	outputAssertion = "Rule LS001 result: fail\nERROR: Path does not contain a valid Arduino library.                         \n       See: https://arduino.github.io/arduino-cli/latest/library-specification\n"
 	assert.Equal(t, outputAssertion, summaryText, "Reference URL is appended if one is defined")
-	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.NotRun, ruleOutput)
+	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.NotRun, ruleOutput, nil)
 	assert.Equal(t, fmt.Sprintf("Rule %s result: %s\n%s: %s\n", ruleConfiguration.ID, ruleresult.NotRun, rulelevel.Notice, ruleOutput), summaryText, "Non-fail result should not use message")
-	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.Pass, "")
+	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.Pass, "", nil)
 	assert.Equal(t, fmt.Sprintf("Rule %s result: %s\n", ruleConfiguration.ID, ruleresult.Pass), summaryText, "Non-failure result with no rule function output should only use preface")
 	flags.Set("verbose", "false")
-	require.Nil(t, configuration.Initialize(flags, projectPaths))
+	toolConfiguration, err = configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+	results.toolConfiguration = toolConfiguration
 	ruleConfigurationCopy := ruleConfiguration
 	ruleConfigurationCopy.MessageTemplate = "bar"
 	ruleConfigurationCopy.Reference = ""
-	summaryText = results.Record(lintedProject, ruleConfigurationCopy, ruleresult.Fail, ruleOutput)
+	summaryText = results.Record(lintedProject, ruleConfigurationCopy, ruleresult.Fail, ruleOutput, nil)
 	outputAssertion = "ERROR: bar (Rule LS001)\n"
 	assert.Equal(t, outputAssertion, summaryText, "Rule ID is appended to non-verbose fail message on same line when rule message is single line")
 	ruleConfigurationCopy.MessageTemplate = "bar\nbaz"
-	summaryText = results.Record(lintedProject, ruleConfigurationCopy, ruleresult.Fail, ruleOutput)
+	summaryText = results.Record(lintedProject, ruleConfigurationCopy, ruleresult.Fail, ruleOutput, nil)
 	outputAssertion = "ERROR: bar         \n       baz         \n       (Rule LS001)\n"
 	assert.Equal(t, outputAssertion, summaryText, "Rule ID is appended to non-verbose fail message on same line when rule message is multiple lines")
-	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.NotRun, ruleOutput)
+	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.NotRun, ruleOutput, nil)
 	assert.Equal(t, "", summaryText, "Non-fail result should not result in output in non-verbose mode")
-	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.Pass, "")
+	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.Pass, "", nil)
 	assert.Equal(t, "", summaryText, "Non-fail result should not result in output in non-verbose mode")
 
 	flags.Set("verbose", "true")
-	require.Nil(t, configuration.Initialize(flags, projectPaths))
+	toolConfiguration, err = configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
 	ruleResult := ruleresult.Pass
-	results.Initialize()
-	results.Record(lintedProject, ruleConfiguration, ruleResult, ruleOutput)
+	results.Initialize(toolConfiguration)
+	results.Record(lintedProject, ruleConfiguration, ruleResult, ruleOutput, nil)
 	projectReport := results.Projects[0]
 	assert.Equal(t, lintedProject.Path, projectReport.Path)
 	assert.Equal(t, lintedProject.ProjectType.String(), projectReport.ProjectType)
 	projectConfigurationReport := projectReport.Configuration
-	assert.Equal(t, rulemode.Compliance(configuration.RuleModes(lintedProject.ProjectType)), projectConfigurationReport.Compliance)
-	assert.Equal(t, rulemode.LibraryManager(configuration.RuleModes(lintedProject.ProjectType)), projectConfigurationReport.LibraryManager)
-	assert.Equal(t, configuration.RuleModes(lintedProject.ProjectType)[rulemode.Official], projectConfigurationReport.Official)
+	assert.Equal(t, rulemode.Compliance(toolConfiguration.RuleModes(lintedProject.ProjectType)), projectConfigurationReport.Compliance)
+	assert.Equal(t, rulemode.LibraryManager(toolConfiguration.RuleModes(lintedProject.ProjectType)), projectConfigurationReport.LibraryManager)
+	assert.Equal(t, toolConfiguration.RuleModes(lintedProject.ProjectType)[rulemode.Official], projectConfigurationReport.Official)
 	assert.Equal(t, 1, len(results.Projects[0].Rules), "Passing rule reports should be written to report in verbose mode")
 	ruleReport := projectReport.Rules[0]
 	assert.Equal(t, ruleConfiguration.Category, ruleReport.Category)
@@ -126,29 +142,53 @@ func TestRecord(t *testing.T) {
 	assert.Equal(t, ruleConfiguration.Brief, ruleReport.Brief)
 	assert.Equal(t, ruleConfiguration.Description, ruleReport.Description)
 	assert.Equal(t, ruleResult.String(), ruleReport.Result)
-	ruleLevel, _ := rulelevel.RuleLevel(ruleConfiguration, ruleResult, lintedProject)
+	ruleLevel, _ := rulelevel.RuleLevel(ruleConfiguration, ruleResult, lintedProject, toolConfiguration)
 	assert.Equal(t, ruleLevel.String(), ruleReport.Level)
 	assert.Equal(t, ruleOutput, ruleReport.Message)
+	assert.Nil(t, ruleReport.Locations)
+
+	results.Initialize(toolConfiguration)
+	ruleFindings := []rulefunction.Finding{
+		{Path: paths.New("/foo/bar/baz.ino"), Line: 42, Column: 3},
+		{Path: paths.New("/foo/bar/qux")},
+	}
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput, ruleFindings)
+	assert.Equal(t, []locationReportType{{Path: paths.New("/foo/bar/baz.ino"), Line: 42, Column: 3}, {Path: paths.New("/foo/bar/qux")}}, results.Projects[0].Rules[0].Locations, "Rule findings are recorded as locations")
 
 	flags.Set("verbose", "false")
-	require.Nil(t, configuration.Initialize(flags, projectPaths))
-	results.Initialize()
-	results.Record(lintedProject, ruleConfiguration, ruleresult.Pass, ruleOutput)
+	toolConfiguration, err = configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+	results.Initialize(toolConfiguration)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Pass, ruleOutput, nil)
 	assert.Equal(t, 0, len(results.Projects[0].Rules), "Passing rule reports should not be written to report in non-verbose mode")
+	assert.Equal(t, 1, len(results.Projects[0].allRules), "Passing rule reports should always be recorded")
+
+	flags.Set("report-detail", "full")
+	toolConfiguration, err = configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+	results.Initialize(toolConfiguration)
+	summaryText = results.Record(lintedProject, ruleConfiguration, ruleresult.NotRun, ruleOutput, nil)
+	assert.Equal(t, "", summaryText, "Report detail setting doesn't affect text output")
+	require.Equal(t, 1, len(results.Projects[0].Rules), "Non-fail rule reports should be written to report with full report detail")
+	assert.Equal(t, ruleresult.NotRun.String(), results.Projects[0].Rules[0].Result)
+	assert.Equal(t, ruleOutput, results.Projects[0].Rules[0].Message, "Explanation of non-fail result is recorded")
+	flags.Set("report-detail", "violations")
+	toolConfiguration, err = configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
 
-	results.Initialize()
-	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput)
+	results.Initialize(toolConfiguration)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput, nil)
 	require.Equal(t, 1, len(projectReport.Rules), "Failing rule reports should be written to report in non-verbose mode")
 
 	assert.Len(t, results.Projects, 1)
 	previousProjectPath := lintedProject.Path
 	lintedProject.Path = paths.New("/foo/baz")
-	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput, nil)
 	assert.Len(t, results.Projects, 2)
 
 	assert.Len(t, results.Projects[0].Rules, 1)
 	lintedProject.Path = previousProjectPath
-	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Fail, ruleOutput)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Fail, ruleOutput, nil)
 	assert.Len(t, results.Projects[0].Rules, 2)
 }
 
@@ -204,15 +244,16 @@ func TestAddProjectSummary(t *testing.T) {
 	for _, testTable := range testTables {
 		flags := test.ConfigurationFlags()
 		flags.Set("verbose", testTable.verbose)
-		require.Nil(t, configuration.Initialize(flags, projectPaths))
+		toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+		require.Nil(t, err)
 
 		var results Type
-		results.Initialize()
+		results.Initialize(toolConfiguration)
 
 		ruleIndex := 0
 		for testDataIndex, result := range testTable.results {
-			results.Record(lintedProject, ruleconfiguration.Configurations()[0], result, "")
-			if (result == ruleresult.Fail) || configuration.Verbose() {
+			results.Record(lintedProject, ruleconfiguration.Configurations()[0], result, "", nil)
+			if (result == ruleresult.Fail) || toolConfiguration.Verbose() {
 				level := testTable.levels[testDataIndex].String()
 				results.Projects[0].Rules[ruleIndex].Level = level
 				ruleIndex++
@@ -230,6 +271,74 @@ func TestAddProjectSummary(t *testing.T) {
 	}
 }
 
+func TestAddProjectSummaryFailOn(t *testing.T) {
+	lintedProject := project.Type{
+		Path:             paths.New("/foo/bar"),
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}
+
+	testTables := []struct {
+		failOn       string
+		level        rulelevel.Type
+		expectedPass bool
+	}{
+		{"error", rulelevel.Error, false},
+		{"error", rulelevel.Warning, true},
+		{"warning", rulelevel.Error, false},
+		{"warning", rulelevel.Warning, false},
+		{"warning", rulelevel.Info, true},
+		{"info", rulelevel.Info, false},
+		{"info", rulelevel.Notice, true},
+		{"never", rulelevel.Error, true},
+	}
+
+	for _, testTable := range testTables {
+		flags := test.ConfigurationFlags()
+		flags.Set("fail-on", testTable.failOn)
+		toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+		require.Nil(t, err)
+
+		var results Type
+		results.Initialize(toolConfiguration)
+		results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Fail, "", nil)
+		results.Projects[0].Rules[0].Level = testTable.level.String()
+		results.AddProjectSummary(lintedProject)
+		results.AddSummary()
+		assert.Equal(t, testTable.expectedPass, results.Passed(), fmt.Sprintf("%s violation with --fail-on %s", testTable.level, testTable.failOn))
+	}
+}
+
+func TestAddProjectSummaryRuleCounts(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New("/foo/bar"),
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}
+
+	var results Type
+	results.Initialize(toolConfiguration)
+	for _, result := range []ruleresult.Type{ruleresult.Pass, ruleresult.Pass, ruleresult.Skip, ruleresult.NotRun, ruleresult.Fail, ruleresult.Suppressed} {
+		results.Record(lintedProject, ruleconfiguration.Configurations()[0], result, "", nil)
+	}
+	results.AddProjectSummary(lintedProject)
+	assert.Equal(t, 2, results.Projects[0].Summary.PassCount, "Rules that didn't fail are counted regardless of verbosity")
+	assert.Equal(t, 1, results.Projects[0].Summary.SkipCount)
+	assert.Equal(t, 1, results.Projects[0].Summary.NotRunCount)
+
+	lintedProject.Path = paths.New("/foo/baz")
+	results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Pass, "", nil)
+	results.AddProjectSummary(lintedProject)
+	results.AddSummary()
+	assert.Equal(t, 3, results.Summary.PassCount)
+	assert.Equal(t, 1, results.Summary.SkipCount)
+	assert.Equal(t, 1, results.Summary.NotRunCount)
+}
+
 func TestAddSummary(t *testing.T) {
 	lintedProject := project.Type{
 		Path:             paths.New("/foo/bar"),
@@ -296,11 +405,15 @@ func TestAddSummary(t *testing.T) {
 		},
 	}
 
+	toolConfiguration, err := configuration.Initialize(test.ConfigurationFlags(), projectPaths)
+	require.Nil(t, err)
+
 	for _, testTable := range testTables {
 		var results Type
+		results.Initialize(toolConfiguration)
 		for projectIndex, projectSummary := range testTable.projectSummaries {
 			lintedProject.Path = paths.New(fmt.Sprintf("/foo/bar%v", projectIndex)) // Use a unique path to generate a new project report.
-			results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Pass, "")
+			results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Pass, "", nil)
 			results.AddProjectSummary(lintedProject)
 			results.Projects[projectIndex].Summary = projectSummary
 		}
@@ -330,13 +443,18 @@ func TestWriteReport(t *testing.T) {
 	require.Nil(t, err)
 
 	flags.Set("report-file", reportFilePath.Join("report-file.json").String())
-	require.Nil(t, configuration.Initialize(flags, projectPaths))
-	assert.Error(t, Results.WriteReport(), "Parent folder creation should fail due to a collision with an existing file at that path")
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+	var results Type
+	results.Initialize(toolConfiguration)
+	assert.Error(t, results.WriteReport(), "Parent folder creation should fail due to a collision with an existing file at that path")
 
 	reportFilePath = reportFolderPath.Join("report-file-subfolder", "report-file-subsubfolder", "report-file.json")
 	flags.Set("report-file", reportFilePath.String())
-	require.Nil(t, configuration.Initialize(flags, projectPaths))
-	assert.NoError(t, Results.WriteReport(), "Creation of multiple levels of parent folders")
+	toolConfiguration, err = configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+	results.Initialize(toolConfiguration)
+	assert.NoError(t, results.WriteReport(), "Creation of multiple levels of parent folders")
 
 	reportFile, err := reportFilePath.Open()
 	require.Nil(t, err)
@@ -345,5 +463,679 @@ func TestWriteReport(t *testing.T) {
 	reportFileBytes := make([]byte, reportFileInfo.Size())
 	_, err = reportFile.Read(reportFileBytes)
 	require.Nil(t, err)
-	assert.True(t, assert.ObjectsAreEqualValues(reportFileBytes, Results.jsonReportRaw()), "Report file contents are correct")
+	assert.True(t, assert.ObjectsAreEqualValues(reportFileBytes, results.jsonReportRaw()), "Report file contents are correct")
+}
+
+func TestJSONReport(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New(projectPaths[0]),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	var results Type
+	results.Initialize(toolConfiguration)
+	ruleFindings := []rulefunction.Finding{{Path: paths.New(projectPaths[0], "foo.ino"), Line: 42, Column: 3}}
+	results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Fail, "", ruleFindings)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Pass, "", nil)
+	results.AddProjectSummary(lintedProject)
+	results.AddSummary()
+
+	var report map[string]interface{}
+	// The schema validator requires numbers to be decoded as json.Number.
+	reportDecoder := json.NewDecoder(bytes.NewReader(results.jsonReportRaw()))
+	reportDecoder.UseNumber()
+	require.Nil(t, reportDecoder.Decode(&report))
+	assert.Equal(t, json.Number(fmt.Sprint(int(reportversion.Latest))), report["reportVersion"])
+	reportSchema := schema.Compile("arduino-lint-report-schema.json", nil, schemadata.Asset)
+	assert.Nil(t, schema.Validate(report, reportSchema).Result, "Report is valid according to the report schema")
+
+	flags.Set("report-version", "1")
+	toolConfiguration, err = configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+	results.toolConfiguration = toolConfiguration
+	report = nil
+	require.Nil(t, json.Unmarshal(results.jsonReportRaw(), &report))
+	assert.NotContains(t, report, "reportVersion")
+	assert.NotContains(t, report["projects"].([]interface{})[0].(map[string]interface{})["rules"].([]interface{})[0], "locations")
+	assert.NotContains(t, report["summary"], "passCount")
+
+	flags.Set("report-version", "2")
+	toolConfiguration, err = configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+	results.toolConfiguration = toolConfiguration
+	report = nil
+	require.Nil(t, json.Unmarshal(results.jsonReportRaw(), &report))
+	assert.Equal(t, float64(reportversion.V2), report["reportVersion"])
+	assert.Contains(t, report["projects"].([]interface{})[0].(map[string]interface{})["rules"].([]interface{})[0], "locations")
+	assert.NotContains(t, report["summary"], "passCount")
+	assert.NotContains(t, report["projects"].([]interface{})[0].(map[string]interface{})["summary"], "passCount")
+	assert.NotNil(t, results.Projects[0].Rules[0].Locations, "Report data is not modified")
+}
+
+func TestJSONReportViolations(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New(projectPaths[0]),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	var results Type
+	results.Initialize(toolConfiguration)
+	ruleConfiguration := ruleconfiguration.Configurations()[0]
+	fooFindings := []rulefunction.Finding{{Path: paths.New(projectPaths[0], "foo.ino"), Line: 1, Subject: "foo"}}
+	barFindings := []rulefunction.Finding{{Path: paths.New(projectPaths[0], "foo.ino"), Line: 2, Subject: "bar"}}
+	results.RecordViolation(lintedProject, ruleConfiguration, "foo, bar", ruleresult.Fail, "foo", fooFindings)
+	results.RecordViolation(lintedProject, ruleConfiguration, "foo, bar", ruleresult.Fail, "bar", barFindings)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Pass, "", nil)
+	results.AddProjectSummary(lintedProject)
+	results.AddSummary()
+	require.Len(t, results.Projects[0].Rules, 2)
+	assert.Equal(t, 2, results.Summary.WarningCount+results.Summary.ErrorCount, "Each violation is counted")
+
+	flags.Set("report-version", "3")
+	toolConfiguration, err = configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+	results.toolConfiguration = toolConfiguration
+	var report Type
+	require.Nil(t, json.Unmarshal(results.jsonReportRaw(), &report))
+	require.Len(t, report.Projects[0].Rules, 1, "Violations are combined")
+	assert.Equal(t, resultMessage(ruleConfiguration, ruleresult.Fail, "foo, bar"), report.Projects[0].Rules[0].Message)
+	assert.Len(t, report.Projects[0].Rules[0].Locations, 2)
+	assert.Equal(t, 1, report.Projects[0].Summary.WarningCount+report.Projects[0].Summary.ErrorCount, "Rule is counted once")
+	assert.Equal(t, 1, report.Summary.WarningCount+report.Summary.ErrorCount, "Rule is counted once")
+	assert.Len(t, results.Projects[0].Rules, 2, "Report data is not modified")
+	assert.Len(t, results.Projects[0].Rules[0].Locations, 1, "Report data is not modified")
+}
+
+func Test_combinedViolationReports(t *testing.T) {
+	fooLocations := []locationReportType{{Path: paths.New("/foo"), Line: 1}}
+	barLocations := []locationReportType{{Path: paths.New("/foo"), Line: 2}}
+	ruleReports := []ruleReportType{
+		{ID: "XX001", Result: "suppressed", Level: "NOTICE", Message: "foo", Locations: fooLocations, violation: true, ruleMessage: "foo, bar"},
+		{ID: "XX001", Result: "fail", Level: "WARNING", Message: "bar", Locations: barLocations, violation: true, ruleMessage: "foo, bar"},
+		{ID: "XX002", Result: "fail", Level: "ERROR", Message: "baz"},
+		{ID: "XX002", Result: "fail", Level: "ERROR", Message: "qux"},
+	}
+	assert.Equal(
+		t,
+		[]ruleReportType{
+			{ID: "XX001", Result: "fail", Level: "WARNING", Message: "foo, bar", Locations: append(fooLocations, barLocations...), violation: true, ruleMessage: "foo, bar"},
+			{ID: "XX002", Result: "fail", Level: "ERROR", Message: "baz"},
+			{ID: "XX002", Result: "fail", Level: "ERROR", Message: "qux"},
+		},
+		combinedViolationReports(ruleReports),
+		"Only the violations of a rule are combined",
+	)
+}
+
+func TestJSONLEvents(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New(projectPaths[0]),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	var results Type
+	results.Initialize(toolConfiguration)
+
+	var event jsonlEventType
+	projectStartEvent := results.JSONLProjectStart(lintedProject)
+	assert.Regexp(t, "^[^\n]+\n$", projectStartEvent, "Event is a single line")
+	require.Nil(t, json.Unmarshal([]byte(projectStartEvent), &event))
+	assert.Equal(t, jsonlEventType{Event: "projectStart", Project: &jsonlProjectType{Path: lintedProject.Path, ProjectType: "library"}}, event)
+
+	results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Fail, "", nil)
+	event = jsonlEventType{}
+	require.Nil(t, json.Unmarshal([]byte(results.JSONLRuleResult(lintedProject)), &event))
+	assert.Equal(t, "ruleResult", event.Event)
+	assert.Equal(t, ruleconfiguration.Configurations()[0].ID, event.Rule.ID)
+	assert.Equal(t, ruleresult.Fail.String(), event.Rule.Result)
+
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Pass, "", nil)
+	event = jsonlEventType{}
+	require.Nil(t, json.Unmarshal([]byte(results.JSONLRuleResult(lintedProject)), &event))
+	assert.Equal(t, ruleconfiguration.Configurations()[1].ID, event.Rule.ID, "Passing rule is streamed regardless of verbosity")
+
+	results.AddProjectSummary(lintedProject)
+	event = jsonlEventType{}
+	require.Nil(t, json.Unmarshal([]byte(results.JSONLProjectSummary(lintedProject)), &event))
+	assert.Equal(t, "projectSummary", event.Event)
+	assert.Equal(t, lintedProject.Path, event.Project.Path)
+	assert.Equal(t, summaryReportType{Pass: false, WarningCount: 0, ErrorCount: 1, PassCount: 1}, *event.Summary)
+
+	results.AddSummary()
+	event = jsonlEventType{}
+	require.Nil(t, json.Unmarshal([]byte(results.JSONLSummary()), &event))
+	assert.Equal(t, jsonlEventType{Event: "summary", Summary: &summaryReportType{Pass: false, WarningCount: 0, ErrorCount: 1, PassCount: 1}}, event)
+}
+
+func TestTimings(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New(projectPaths[0]),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	var results Type
+	results.Initialize(toolConfiguration)
+	for index, duration := range []time.Duration{time.Millisecond, 3 * time.Millisecond, 2 * time.Millisecond} {
+		results.Record(lintedProject, ruleconfiguration.Configurations()[index], ruleresult.Pass, "", nil)
+		results.RecordRuleDuration(lintedProject, ruleconfiguration.Configurations()[index], duration)
+	}
+	results.RecordInitializationDuration(lintedProject, 5*time.Millisecond)
+	results.AddProjectSummary(lintedProject)
+	results.AddSummary()
+
+	require.NotNil(t, results.Projects[0].Timings)
+	assert.Equal(t, 0.005, results.Projects[0].Timings.Initialization)
+	assert.Equal(t, ruleTimingReportType{ID: ruleconfiguration.Configurations()[1].ID, Duration: 0.003}, results.Projects[0].Timings.Rules[1])
+
+	timingsText := results.TimingsText()
+	assert.Regexp(t, "5ms +"+regexp.QuoteMeta(projectPaths[0]), timingsText)
+	assert.Regexp(
+		t,
+		fmt.Sprintf(
+			"(?s)Slowest rules:.*3ms +%s .*2ms +%s .*1ms +%s ",
+			ruleconfiguration.Configurations()[1].ID,
+			ruleconfiguration.Configurations()[2].ID,
+			ruleconfiguration.Configurations()[0].ID,
+		),
+		timingsText,
+		"Rules are sorted by duration",
+	)
+
+	var report map[string]interface{}
+	reportDecoder := json.NewDecoder(bytes.NewReader(results.jsonReportRaw()))
+	reportDecoder.UseNumber()
+	require.Nil(t, reportDecoder.Decode(&report))
+	reportSchema := schema.Compile("arduino-lint-report-schema.json", nil, schemadata.Asset)
+	assert.Nil(t, schema.Validate(report, reportSchema).Result, "Report with timings is valid according to the report schema")
+
+	results.Initialize(toolConfiguration)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Pass, "", nil)
+	assert.Nil(t, results.Projects[0].Timings, "Timings are not recorded unless requested")
+	assert.NotContains(t, results.JSONReport(), "timings")
+}
+
+func TestSARIFReport(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New(projectPaths[0]),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	var results Type
+	results.Initialize(toolConfiguration)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Fail, "", nil)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Pass, "", nil)
+	results.AddProjectSummary(lintedProject)
+	results.AddSummary()
+
+	var sarifReport sarifReportType
+	require.Nil(t, json.Unmarshal([]byte(results.SARIFReport()), &sarifReport))
+	assert.Equal(t, sarifVersion, sarifReport.Version)
+	require.Len(t, sarifReport.Runs, 1)
+
+	driver := sarifReport.Runs[0].Tool.Driver
+	assert.Len(t, driver.Rules, len(ruleconfiguration.Configurations()), "All rules are described")
+	assert.Equal(t, ruleconfiguration.Configurations()[0].ID, driver.Rules[0].ID)
+	assert.Equal(t, ruleconfiguration.Configurations()[0].Brief, driver.Rules[0].ShortDescription.Text)
+	assert.Equal(t, ruleconfiguration.Configurations()[0].Description, driver.Rules[0].FullDescription.Text)
+	assert.Equal(t, ruleconfiguration.Configurations()[0].Reference, driver.Rules[0].HelpURI)
+
+	sarifResults := sarifReport.Runs[0].Results
+	require.Len(t, sarifResults, 1, "Only failed rules are reported as results")
+	assert.Equal(t, ruleconfiguration.Configurations()[0].ID, sarifResults[0].RuleID)
+	assert.Equal(t, 0, sarifResults[0].RuleIndex)
+	assert.Equal(t, "error", sarifResults[0].Level)
+	assert.Equal(t, results.Projects[0].Rules[0].Message, sarifResults[0].Message.Text)
+	assert.Equal(t, ".", sarifResults[0].Locations[0].PhysicalLocation.ArtifactLocation.URI, "Project path is used when rule provides no location")
+	assert.Nil(t, sarifResults[0].Locations[0].PhysicalLocation.Region)
+
+	results.Initialize(toolConfiguration)
+	ruleFindings := []rulefunction.Finding{{Path: paths.New(projectPaths[0], "foo.ino"), Line: 42, Column: 3}}
+	results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Fail, "", ruleFindings)
+	require.Nil(t, json.Unmarshal([]byte(results.SARIFReport()), &sarifReport))
+	sarifLocation := sarifReport.Runs[0].Results[0].Locations[0].PhysicalLocation
+	assert.Equal(t, "foo.ino", sarifLocation.ArtifactLocation.URI)
+	require.NotNil(t, sarifLocation.Region)
+	assert.Equal(t, 42, sarifLocation.Region.StartLine)
+	assert.Equal(t, 3, sarifLocation.Region.StartColumn)
+
+	results.Initialize(toolConfiguration)
+	pluginRuleConfiguration := ruleconfiguration.Configurations()[0]
+	pluginRuleConfiguration.ID = "HR001"
+	pluginRuleConfiguration.Brief = "plugin brief"
+	results.Record(lintedProject, pluginRuleConfiguration, ruleresult.Fail, "", nil)
+	require.Nil(t, json.Unmarshal([]byte(results.SARIFReport()), &sarifReport))
+	driver = sarifReport.Runs[0].Tool.Driver
+	require.Len(t, driver.Rules, len(ruleconfiguration.Configurations())+1, "Rule not in the built-in rules is described")
+	assert.Equal(t, "HR001", driver.Rules[len(driver.Rules)-1].ID)
+	assert.Equal(t, "plugin brief", driver.Rules[len(driver.Rules)-1].ShortDescription.Text)
+	assert.Equal(t, len(driver.Rules)-1, sarifReport.Runs[0].Results[0].RuleIndex)
+}
+
+func TestGitHubReport(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New(projectPaths[0]),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	var results Type
+	results.Initialize(toolConfiguration)
+	ruleFindings := []rulefunction.Finding{{Path: paths.New(projectPaths[0], "foo.ino"), Line: 42, Column: 3}}
+	results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Fail, "", ruleFindings)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Pass, "", nil)
+	results.AddProjectSummary(lintedProject)
+	results.AddSummary()
+
+	ruleConfiguration := ruleconfiguration.Configurations()[0]
+	assert.Equal(
+		t,
+		fmt.Sprintf(
+			"::group::Linting library in %s\n::error file=foo.ino,line=42,col=3,title=%s%%3A %s::%s\nLinter results for project: 1 ERRORS, 0 WARNINGS\n::endgroup::\n",
+			projectPaths[0],
+			ruleConfiguration.ID,
+			githubEscapeProperty(ruleConfiguration.Brief),
+			githubEscapeData(results.Projects[0].Rules[0].Message),
+		),
+		results.GitHubReport(),
+	)
+}
+
+func Test_githubEscape(t *testing.T) {
+	assert.Equal(t, "100%25%0Afoo: bar, baz", githubEscapeData("100%\nfoo: bar, baz"))
+	assert.Equal(t, "100%25%0Afoo%3A bar%2C baz", githubEscapeProperty("100%\nfoo: bar, baz"))
+}
+
+func TestJUnitReport(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New("/foo/bar"),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	var results Type
+	results.Initialize(toolConfiguration)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Fail, "", nil)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Pass, "", nil)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[2], ruleresult.Skip, "foo", nil)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[3], ruleresult.NotRun, "bar", nil)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[4], ruleresult.Fail, "", nil)
+	results.Projects[0].allRules[4].Level = rulelevel.Warning.String()
+	results.AddProjectSummary(lintedProject)
+	results.AddSummary()
+
+	var testSuites junitTestSuitesType
+	require.Nil(t, xml.Unmarshal([]byte(results.JUnitReport()), &testSuites))
+	assert.Equal(t, 5, testSuites.Tests)
+	assert.Equal(t, 1, testSuites.Failures)
+	assert.Equal(t, 2, testSuites.Skipped)
+	require.Len(t, testSuites.TestSuites, 1)
+
+	testSuite := testSuites.TestSuites[0]
+	assert.Equal(t, lintedProject.Path.String(), testSuite.Name)
+	require.Len(t, testSuite.TestCases, 5, "Passing rules are reported in non-verbose mode")
+	assert.NotNil(t, testSuite.TestCases[0].Failure, "Error level failure")
+	assert.Equal(t, results.Projects[0].allRules[0].Message, testSuite.TestCases[0].Failure.Text)
+	assert.Nil(t, testSuite.TestCases[1].Failure, "Pass")
+	assert.Nil(t, testSuite.TestCases[1].Skipped, "Pass")
+	assert.NotNil(t, testSuite.TestCases[2].Skipped, "Skip")
+	assert.NotNil(t, testSuite.TestCases[3].Skipped, "Not run")
+	assert.Nil(t, testSuite.TestCases[4].Failure, "Warning level failure")
+	assert.Contains(t, testSuite.TestCases[4].SystemOut, rulelevel.Warning.String())
+}
+
+func TestCodeClimateReport(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New(projectPaths[0]),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	var results Type
+	results.Initialize(toolConfiguration)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Fail, "", nil)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Pass, "", nil)
+	ruleFindings := []rulefunction.Finding{{Path: paths.New(projectPaths[0], "foo.ino"), Line: 42, Column: 3}}
+	results.Record(lintedProject, ruleconfiguration.Configurations()[2], ruleresult.Fail, "", ruleFindings)
+	results.Projects[0].Rules[1].Level = rulelevel.Warning.String()
+	results.AddProjectSummary(lintedProject)
+	results.AddSummary()
+
+	var issues []codeClimateIssueType
+	require.Nil(t, json.Unmarshal([]byte(results.CodeClimateReport()), &issues))
+	require.Len(t, issues, 2, "Only failed rules are reported as issues")
+	assert.Equal(t, ruleconfiguration.Configurations()[0].ID, issues[0].CheckName)
+	assert.Equal(t, results.Projects[0].Rules[0].Message, issues[0].Description)
+	assert.Equal(t, "major", issues[0].Severity)
+	assert.Equal(t, codeClimateLocationType{Path: ".", Lines: codeClimateLinesType{Begin: 1}}, issues[0].Location, "Project path is used when rule provides no location")
+	assert.Equal(t, "minor", issues[1].Severity)
+	assert.Equal(t, codeClimateLocationType{Path: "foo.ino", Lines: codeClimateLinesType{Begin: 42}}, issues[1].Location)
+	assert.NotEqual(t, issues[0].Fingerprint, issues[1].Fingerprint)
+}
+
+func Test_findingFingerprint(t *testing.T) {
+	temporaryPath, err := paths.MkTempDir("", "arduino-lint-result-test")
+	require.Nil(t, err)
+	defer temporaryPath.RemoveAll()
+
+	var results Type
+	fingerprints := []string{}
+	for _, folderName := range []string{"foo", "bar"} {
+		projectPath := temporaryPath.Join(folderName, "Project")
+		require.Nil(t, projectPath.MkdirAll())
+		flags := test.ConfigurationFlags()
+		toolConfiguration, err := configuration.Initialize(flags, []string{projectPath.String()})
+		require.Nil(t, err)
+		results.Initialize(toolConfiguration)
+
+		projectReport := projectReportType{Path: projectPath}
+		ruleReport := ruleReportType{
+			ID:        "SS002",
+			Message:   "Prohibited character(s) in file name(s): " + projectPath.Join("Foo Bar.ino").String(),
+			Locations: []locationReportType{{Path: projectPath.Join("Foo Bar.ino"), Line: 1}},
+		}
+		fingerprints = append(fingerprints, results.findingFingerprint(projectReport, ruleReport))
+	}
+
+	assert.Equal(t, fingerprints[0], fingerprints[1], "Fingerprint doesn't depend on the project location")
+
+	projectReport := projectReportType{Path: temporaryPath.Join("bar", "Project")}
+	locations := []locationReportType{{Path: projectReport.Path.Join("Foo Bar.ino"), Line: 1}}
+	assert.Equal(t, fingerprints[0], results.findingFingerprint(projectReport, ruleReportType{ID: "SS002", Message: "Prohibited character(s) in file name(s): Foo Bar.ino", Locations: locations}), "Same violation")
+	assert.NotEqual(t, fingerprints[0], results.findingFingerprint(projectReport, ruleReportType{ID: "SS001", Message: "Prohibited character(s) in file name(s): Foo Bar.ino", Locations: locations}), "Different rule")
+	assert.NotEqual(t, fingerprints[0], results.findingFingerprint(projectReport, ruleReportType{ID: "SS002", Message: "Prohibited character(s) in file name(s): Baz.ino", Locations: locations}), "Different message")
+	assert.NotEqual(t, fingerprints[0], results.findingFingerprint(projectReport, ruleReportType{ID: "SS002", Message: "Prohibited character(s) in file name(s): Foo Bar.ino", Locations: []locationReportType{{Path: projectReport.Path.Join("Foo Bar.ino"), Line: 2}}}), "Different line")
+	assert.NotEqual(t, fingerprints[0], results.findingFingerprint(projectReport, ruleReportType{ID: "SS002", Message: "Prohibited character(s) in file name(s): Foo Bar.ino", Locations: []locationReportType{{Path: projectReport.Path.Join("Baz.ino"), Line: 1}}}), "Different path")
+}
+
+func TestCheckstyleReport(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New(projectPaths[0]),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	var results Type
+	results.Initialize(toolConfiguration)
+	ruleFindings := []rulefunction.Finding{{Path: paths.New(projectPaths[0], "foo.ino"), Line: 42, Column: 3}}
+	results.Record(lintedProject, ruleconfiguration.Configurations()[0], ruleresult.Fail, "", ruleFindings)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Pass, "", nil)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[2], ruleresult.Fail, "", nil)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[3], ruleresult.Fail, "", ruleFindings)
+	results.Projects[0].Rules[2].Level = rulelevel.Info.String()
+	results.AddProjectSummary(lintedProject)
+	results.AddSummary()
+
+	var checkstyle checkstyleType
+	require.Nil(t, xml.Unmarshal([]byte(results.CheckstyleReport()), &checkstyle))
+	assert.Equal(t, checkstyleVersion, checkstyle.Version)
+	require.Len(t, checkstyle.Files, 2, "Violations are grouped by file")
+	assert.Equal(t, "foo.ino", checkstyle.Files[0].Name)
+	assert.Equal(
+		t,
+		[]checkstyleErrorType{
+			{
+				Line:     42,
+				Column:   3,
+				Severity: "error",
+				Message:  results.Projects[0].Rules[0].Message,
+				Source:   "arduino-lint." + ruleconfiguration.Configurations()[0].ID,
+			},
+			{
+				Line:     42,
+				Column:   3,
+				Severity: "info",
+				Message:  results.Projects[0].Rules[2].Message,
+				Source:   "arduino-lint." + ruleconfiguration.Configurations()[3].ID,
+			},
+		},
+		checkstyle.Files[0].Errors,
+	)
+	assert.Equal(t, ".", checkstyle.Files[1].Name, "Project path is used when rule provides no location")
+	require.Len(t, checkstyle.Files[1].Errors, 1)
+	assert.Equal(t, "arduino-lint."+ruleconfiguration.Configurations()[2].ID, checkstyle.Files[1].Errors[0].Source)
+}
+
+func TestHTMLReport(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New(projectPaths[0]),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	ruleConfiguration := ruleconfiguration.Configurations()[0]
+	ruleConfiguration.Description = "Foo <bar> description"
+	ruleConfiguration.Reference = "https://example.com/foo"
+
+	var results Type
+	results.Initialize(toolConfiguration)
+	ruleFindings := []rulefunction.Finding{{Path: paths.New(projectPaths[0], "foo.ino"), Line: 42, Column: 3}}
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "", ruleFindings)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Pass, "", nil)
+	results.AddProjectSummary(lintedProject)
+	results.AddSummary()
+
+	htmlReport := results.HTMLReport()
+	assert.True(t, strings.HasPrefix(htmlReport, "<!DOCTYPE html>"))
+	assert.Contains(t, htmlReport, fmt.Sprintf("<strong>%s</strong>", ruleConfiguration.ID))
+	assert.NotContains(t, htmlReport, fmt.Sprintf("<strong>%s</strong>", ruleconfiguration.Configurations()[1].ID), "Only violations are listed")
+	assert.Contains(t, htmlReport, fmt.Sprintf("<h3>%s / %s</h3>", ruleConfiguration.Category, ruleConfiguration.Subcategory))
+	assert.Contains(t, htmlReport, "Foo &lt;bar&gt; description", "Content is escaped")
+	assert.Contains(t, htmlReport, `<a href="https://example.com/foo">`)
+	assert.Contains(t, htmlReport, `<div class="location">foo.ino:42:3</div>`)
+	assert.Contains(t, htmlReport, `<div class="finding level-error">`)
+	assert.Contains(t, htmlReport, `<input type="checkbox" value="warning" checked>`)
+}
+
+func TestMarkdownReport(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New(projectPaths[0]),
+		ProjectType:      projecttype.Library,
+		SuperprojectType: projecttype.Library,
+	}
+
+	ruleConfiguration := ruleconfiguration.Configurations()[0]
+	ruleConfiguration.MessageTemplate = "Foo_bar\nbaz"
+	ruleConfiguration.Reference = "https://example.com/foo"
+
+	var results Type
+	results.Initialize(toolConfiguration)
+	ruleFindings := []rulefunction.Finding{{Path: paths.New(projectPaths[0], "foo.ino"), Line: 42}}
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "", ruleFindings)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Pass, "", nil)
+	results.AddProjectSummary(lintedProject)
+	results.AddSummary()
+
+	markdownReport := results.MarkdownReport()
+	assert.Contains(t, markdownReport, "| . | library | :x: fail | 1 | 0 |\n")
+	assert.NotContains(t, markdownReport, "**Total**", "Total is only added for multiple projects")
+	assert.Contains(t, markdownReport, "<summary>library .: 1 rule violation(s)</summary>")
+	assert.Contains(
+		t,
+		markdownReport,
+		fmt.Sprintf("- **ERROR** [%s](https://example.com/foo): Foo\\_bar\n  baz `foo.ino:42`\n", ruleConfiguration.ID),
+		"Rule ID is linked to reference, message is escaped and indented",
+	)
+	assert.NotContains(t, markdownReport, ruleconfiguration.Configurations()[1].ID, "Only violations are listed")
+}
+
+func Test_markdownEscape(t *testing.T) {
+	assert.Equal(t, "foo", markdownEscape("foo"))
+	assert.Equal(t, "\\`\\*\\_\\[\\]\\<\\>\\|\\#\\\\", markdownEscape("`*_[]<>|#\\"))
+}
+
+func Test_sarifLevel(t *testing.T) {
+	assert.Equal(t, "error", sarifLevel(rulelevel.Error.String()))
+	assert.Equal(t, "warning", sarifLevel(rulelevel.Warning.String()))
+	assert.Equal(t, "note", sarifLevel(rulelevel.Info.String()))
+	assert.Equal(t, "none", sarifLevel(rulelevel.Notice.String()))
+}
+
+func TestBaseline(t *testing.T) {
+	temporaryPath, err := paths.MkTempDir("", "arduino-lint-result-test")
+	require.Nil(t, err)
+	defer temporaryPath.RemoveAll()
+	projectPath := temporaryPath.Join("foo")
+	require.Nil(t, projectPath.Mkdir())
+	baselineFilePath := temporaryPath.Join("baseline", "baseline.json")
+
+	lintedProject := project.Type{
+		Path:             projectPath,
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}
+	ruleConfiguration := ruleconfiguration.Configurations()[0]
+	ruleOutput := projectPath.Join("bar.ino").String() + ":1:  foo"
+	ruleFindings := []rulefunction.Finding{{Path: projectPath.Join("bar.ino"), Line: 1}}
+
+	flags := test.ConfigurationFlags()
+	flags.Set("write-baseline", baselineFilePath.String())
+	toolConfiguration, err := configuration.Initialize(flags, []string{temporaryPath.String()})
+	require.Nil(t, err)
+	var results Type
+	results.Initialize(toolConfiguration)
+	require.Nil(t, results.LoadBaseline())
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput, ruleFindings)
+	results.Record(lintedProject, ruleconfiguration.Configurations()[1], ruleresult.Pass, "", nil)
+	require.Nil(t, results.WriteBaseline())
+
+	var baseline baselineType
+	baselineData, err := baselineFilePath.ReadFile()
+	require.Nil(t, err)
+	require.Nil(t, json.Unmarshal(baselineData, &baseline))
+	assert.Equal(t, baselineVersion, baseline.Version)
+	assert.Equal(
+		t,
+		[]baselineFingerprintType{{RuleID: ruleConfiguration.ID, Project: "foo", Path: "bar.ino", Output: "bar.ino: foo"}},
+		baseline.Findings,
+		"Only failures are recorded, with paths relative to the project and without line numbers",
+	)
+
+	flags = test.ConfigurationFlags()
+	flags.Set("baseline", baselineFilePath.String())
+	toolConfiguration, err = configuration.Initialize(flags, []string{temporaryPath.String()})
+	require.Nil(t, err)
+	results.Initialize(toolConfiguration)
+	require.Nil(t, results.LoadBaseline())
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, ruleOutput, ruleFindings)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, "baz", ruleFindings)
+	results.Record(lintedProject, ruleConfiguration, ruleresult.Fail, projectPath.Join("bar.ino").String()+":5:  foo", []rulefunction.Finding{{Path: projectPath.Join("bar.ino"), Line: 5}})
+	assert.Equal(t, rulelevel.Notice.String(), results.Projects[0].Rules[0].Level, "Violation in baseline is downgraded")
+	assert.NotEqual(t, rulelevel.Notice.String(), results.Projects[0].Rules[1].Level, "New violation is not downgraded")
+	assert.Equal(t, rulelevel.Notice.String(), results.Projects[0].Rules[2].Level, "Violation in baseline that moved to another line is downgraded")
+
+	var sarifReport sarifReportType
+	require.Nil(t, json.Unmarshal(results.sarifReportRaw(), &sarifReport))
+	require.Len(t, sarifReport.Runs[0].Results, 3)
+	assert.Equal(t, "unchanged", sarifReport.Runs[0].Results[0].BaselineState, "SARIF result of violation in baseline is marked")
+	assert.Equal(t, []sarifSuppressionType{{Kind: "external"}}, sarifReport.Runs[0].Results[0].Suppressions)
+	assert.Empty(t, sarifReport.Runs[0].Results[1].BaselineState, "SARIF result of new violation is not marked")
+	assert.Empty(t, sarifReport.Runs[0].Results[1].Suppressions)
+
+	assert.Equal(t, 1, strings.Count(results.GitHubReport(), "::error"), "Only new violation is annotated")
+
+	var codeClimateReport []codeClimateIssueType
+	require.Nil(t, json.Unmarshal(results.codeClimateReportRaw(), &codeClimateReport))
+	require.Len(t, codeClimateReport, 1, "Only new violation is a Code Climate issue")
+
+	var checkstyleReport checkstyleType
+	require.Nil(t, xml.Unmarshal(results.checkstyleReportRaw(), &checkstyleReport))
+	require.Len(t, checkstyleReport.Files, 1)
+	assert.Len(t, checkstyleReport.Files[0].Errors, 1, "Only new violation is a Checkstyle error")
+
+	require.Nil(t, baselineFilePath.WriteFile([]byte(`{"version": 42, "findings": []}`)))
+	assert.Error(t, results.LoadBaseline(), "Unsupported baseline version")
+	require.Nil(t, baselineFilePath.WriteFile([]byte("foo")))
+	assert.Error(t, results.LoadBaseline(), "Invalid baseline")
+
+	flags = test.ConfigurationFlags()
+	flags.Set("baseline", temporaryPath.Join("nonexistent.json").String())
+	_, err = configuration.Initialize(flags, []string{temporaryPath.String()})
+	assert.Error(t, err, "Nonexistent baseline file")
+}
+
+func TestRecordSuppressed(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	toolConfiguration, err := configuration.Initialize(flags, projectPaths)
+	require.Nil(t, err)
+
+	lintedProject := project.Type{
+		Path:             paths.New("/foo/bar"),
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}
+
+	var results Type
+	results.Initialize(toolConfiguration)
+	ruleConfiguration := ruleconfiguration.Configurations()[0]
+	summaryText := results.Record(lintedProject, ruleConfiguration, ruleresult.Suppressed, "foo", nil)
+	assert.Contains(t, summaryText, fmt.Sprintf("(Rule %s, suppressed)", ruleConfiguration.ID))
+	require.Len(t, results.Projects[0].Rules, 1, "Suppressed violation is reported")
+	ruleReport := results.Projects[0].Rules[0]
+	assert.Equal(t, ruleresult.Suppressed.String(), ruleReport.Result)
+	assert.Equal(t, rulelevel.Notice.String(), ruleReport.Level)
+	assert.Contains(t, ruleReport.Message, message(ruleConfiguration.MessageTemplate, "foo"))
+
+	results.AddProjectSummary(lintedProject)
+	assert.True(t, results.Projects[0].Summary.Pass)
+	assert.Equal(t, 0, results.Projects[0].Summary.ErrorCount)
+	assert.Equal(t, 0, results.Projects[0].Summary.WarningCount)
+
+	var sarifReport sarifReportType
+	require.Nil(t, json.Unmarshal(results.sarifReportRaw(), &sarifReport))
+	require.Len(t, sarifReport.Runs[0].Results, 1)
+	assert.Equal(t, []sarifSuppressionType{{Kind: "inSource"}}, sarifReport.Runs[0].Results[0].Suppressions)
+
+	var junitReport junitTestSuitesType
+	require.Nil(t, xml.Unmarshal(results.junitReportRaw(), &junitReport))
+	assert.Equal(t, 1, junitReport.Skipped)
+	assert.Equal(t, 0, junitReport.Failures)
 }
@@ -0,0 +1,300 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// The SARIF report format.
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/go-paths-helper"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+// sarifReportType is the type of the top level SARIF log object.
+type sarifReportType struct {
+	Schema  string         `json:"$schema"`
+	Version string         `json:"version"`
+	Runs    []sarifRunType `json:"runs"`
+}
+
+// sarifRunType is the type of the SARIF run object.
+type sarifRunType struct {
+	Tool    sarifToolType     `json:"tool"`
+	Results []sarifResultType `json:"results"`
+}
+
+// sarifToolType is the type of the SARIF tool object.
+type sarifToolType struct {
+	Driver sarifDriverType `json:"driver"`
+}
+
+// sarifDriverType is the type of the SARIF toolComponent object describing Arduino Lint.
+type sarifDriverType struct {
+	Name           string                         `json:"name"`
+	Version        string                         `json:"version,omitempty"`
+	InformationURI string                         `json:"informationUri"`
+	Rules          []sarifReportingDescriptorType `json:"rules"`
+}
+
+// sarifReportingDescriptorType is the type of the SARIF reportingDescriptor object describing a rule.
+type sarifReportingDescriptorType struct {
+	ID               string                               `json:"id"`
+	ShortDescription sarifMultiformatMessageType          `json:"shortDescription"`
+	FullDescription  sarifMultiformatMessageType          `json:"fullDescription"`
+	HelpURI          string                               `json:"helpUri,omitempty"`
+	Properties       sarifReportingDescriptorPropertyType `json:"properties"`
+}
+
+// sarifReportingDescriptorPropertyType is the type of the property bag of the SARIF reportingDescriptor object.
+type sarifReportingDescriptorPropertyType struct {
+	Tags []string `json:"tags"`
+}
+
+// sarifMultiformatMessageType is the type of the SARIF multiformatMessageString object.
+type sarifMultiformatMessageType struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown,omitempty"`
+}
+
+// sarifMessageType is the type of the SARIF message object.
+type sarifMessageType struct {
+	Text string `json:"text"`
+}
+
+// sarifResultType is the type of the SARIF result object describing a rule violation.
+type sarifResultType struct {
+	RuleID        string                 `json:"ruleId"`
+	RuleIndex     int                    `json:"ruleIndex"`
+	Level         string                 `json:"level"`
+	Message       sarifMessageType       `json:"message"`
+	Locations     []sarifLocationType    `json:"locations"`
+	Suppressions  []sarifSuppressionType `json:"suppressions,omitempty"`
+	BaselineState string                 `json:"baselineState,omitempty"`
+}
+
+// sarifSuppressionType is the type of the SARIF suppression object.
+type sarifSuppressionType struct {
+	Kind string `json:"kind"`
+}
+
+// sarifLocationType is the type of the SARIF location object.
+type sarifLocationType struct {
+	PhysicalLocation sarifPhysicalLocationType `json:"physicalLocation"`
+}
+
+// sarifPhysicalLocationType is the type of the SARIF physicalLocation object.
+type sarifPhysicalLocationType struct {
+	ArtifactLocation sarifArtifactLocationType `json:"artifactLocation"`
+	Region           *sarifRegionType          `json:"region,omitempty"`
+}
+
+// sarifRegionType is the type of the SARIF region object.
+type sarifRegionType struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// sarifArtifactLocationType is the type of the SARIF artifactLocation object.
+type sarifArtifactLocationType struct {
+	URI string `json:"uri"`
+}
+
+// SARIFReport returns a SARIF formatted report of rules on all projects in string encoding.
+func (results Type) SARIFReport() string {
+	return string(results.sarifReportRaw())
+}
+
+// sarifReportRaw returns the report marshaled into SARIF format in byte encoding.
+func (results Type) sarifReportRaw() []byte {
+	ruleIndexes := make(map[string]int)
+	driver := sarifDriverType{
+		Name:           "arduino-lint",
+		Version:        configuration.BuildVersion(),
+		InformationURI: "https://arduino.github.io/arduino-lint/latest/",
+		Rules:          []sarifReportingDescriptorType{},
+	}
+	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
+		ruleIndexes[ruleConfiguration.ID] = len(driver.Rules)
+		driver.Rules = append(driver.Rules, sarifReportingDescriptor(ruleConfiguration))
+	}
+
+	run := sarifRunType{
+		Tool:    sarifToolType{Driver: driver},
+		Results: []sarifResultType{},
+	}
+	for _, projectReport := range results.Projects {
+		for _, ruleReport := range projectReport.Rules {
+			if ruleReport.Result != ruleresult.Fail.String() && ruleReport.Result != ruleresult.Suppressed.String() {
+				// SARIF results are only used for rule violations.
+				continue
+			}
+
+			ruleIndex, ok := ruleIndexes[ruleReport.ID]
+			if !ok {
+				// Custom rules and plugin rules are defined by the user configuration, so their descriptors are generated from
+				// the report.
+				ruleIndex = len(run.Tool.Driver.Rules)
+				ruleIndexes[ruleReport.ID] = ruleIndex
+				run.Tool.Driver.Rules = append(
+					run.Tool.Driver.Rules,
+					sarifReportingDescriptor(ruleconfiguration.Type{
+						ProjectType: projecttype.All,
+						Category:    ruleReport.Category,
+						Subcategory: ruleReport.Subcategory,
+						ID:          ruleReport.ID,
+						Brief:       ruleReport.Brief,
+						Description: ruleReport.Description,
+						Reference:   ruleReport.reference,
+					}),
+				)
+			}
+
+			result := sarifResultType{
+				RuleID:    ruleReport.ID,
+				RuleIndex: ruleIndex,
+				Level:     sarifLevel(ruleReport.Level),
+				Message:   sarifMessageType{Text: ruleReport.Message},
+				Locations: sarifLocations(projectReport, ruleReport),
+			}
+			if ruleReport.Result == ruleresult.Suppressed.String() {
+				// The violation was suppressed by a comment in the project files.
+				result.Suppressions = []sarifSuppressionType{{Kind: "inSource"}}
+			}
+			if ruleReport.baselined {
+				// The violation was recorded in the baseline file, so it doesn't cause a failure.
+				result.Suppressions = []sarifSuppressionType{{Kind: "external"}}
+				result.BaselineState = "unchanged"
+			}
+			run.Results = append(run.Results, result)
+		}
+	}
+
+	var marshaledReportBuffer bytes.Buffer
+	jsonEncoder := json.NewEncoder(io.Writer(&marshaledReportBuffer))
+	jsonEncoder.SetEscapeHTML(false)
+	jsonEncoder.SetIndent("", "  ")
+	err := jsonEncoder.Encode(sarifReportType{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRunType{run},
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Error while formatting SARIF rules report: %v", err))
+	}
+
+	return marshaledReportBuffer.Bytes()
+}
+
+// sarifLocations returns the SARIF location objects for the given rule report.
+// The project path is used as the location when the rule did not provide a more specific one.
+func sarifLocations(projectReport projectReportType, ruleReport ruleReportType) []sarifLocationType {
+	if len(ruleReport.Locations) == 0 {
+		return []sarifLocationType{
+			{
+				PhysicalLocation: sarifPhysicalLocationType{
+					ArtifactLocation: sarifArtifactLocationType{URI: sarifArtifactURI(projectReport.Path)},
+				},
+			},
+		}
+	}
+
+	locations := []sarifLocationType{}
+	for _, locationReport := range ruleReport.Locations {
+		location := sarifLocationType{
+			PhysicalLocation: sarifPhysicalLocationType{
+				ArtifactLocation: sarifArtifactLocationType{URI: sarifArtifactURI(locationReport.Path)},
+			},
+		}
+		if locationReport.Line > 0 {
+			location.PhysicalLocation.Region = &sarifRegionType{
+				StartLine:   locationReport.Line,
+				StartColumn: locationReport.Column,
+			}
+		}
+		locations = append(locations, location)
+	}
+
+	return locations
+}
+
+// sarifReportingDescriptor returns the SARIF reportingDescriptor object for the given rule configuration.
+func sarifReportingDescriptor(ruleConfiguration ruleconfiguration.Type) sarifReportingDescriptorType {
+	return sarifReportingDescriptorType{
+		ID: ruleConfiguration.ID,
+		ShortDescription: sarifMultiformatMessageType{
+			Text: ruleConfiguration.Brief,
+		},
+		FullDescription: sarifMultiformatMessageType{
+			Text:     ruleConfiguration.Description,
+			Markdown: ruleConfiguration.Description, // The rule descriptions may contain Markdown markup.
+		},
+		HelpURI: ruleConfiguration.Reference,
+		Properties: sarifReportingDescriptorPropertyType{
+			Tags: []string{ruleConfiguration.ProjectType.String(), ruleConfiguration.Category, ruleConfiguration.Subcategory},
+		},
+	}
+}
+
+// sarifLevel returns the SARIF level corresponding to the given rule level string.
+func sarifLevel(ruleLevel string) string {
+	switch ruleLevel {
+	case rulelevel.Error.String():
+		return "error"
+	case rulelevel.Warning.String():
+		return "warning"
+	case rulelevel.Info.String():
+		return "note"
+	default:
+		return "none"
+	}
+}
+
+// sarifArtifactURI returns the URI of the given path, relative to the working directory if possible.
+func sarifArtifactURI(path *paths.Path) string {
+	absolutePath, err := path.Abs()
+	if err != nil {
+		panic(err)
+	}
+
+	if relativePath, ok := workingDirectoryRelativePath(absolutePath); ok {
+		return filepath.ToSlash(relativePath.String())
+	}
+
+	// The path is outside the working directory, so an absolute file URI must be used.
+	uriPath := filepath.ToSlash(absolutePath.String())
+	if !strings.HasPrefix(uriPath, "/") {
+		uriPath = "/" + uriPath // Windows paths start with the drive letter.
+	}
+	return (&url.URL{Scheme: "file", Path: uriPath}).String()
+}
@@ -0,0 +1,114 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package result
+
+// The execution time profile, recorded when the --timings flag is set.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+)
+
+// slowestRulesCount is the number of rules listed in the timings text.
+const slowestRulesCount = 10
+
+// timingsReportType is the type of the project execution time reports.
+// Durations are in seconds.
+type timingsReportType struct {
+	Initialization float64                `json:"initialization"` // Time taken to gather the project data used by the rules.
+	Rules          []ruleTimingReportType `json:"rules"`
+}
+
+// ruleTimingReportType is the type of the rule execution time reports.
+type ruleTimingReportType struct {
+	ID       string  `json:"ID"`
+	Duration float64 `json:"duration"`
+}
+
+// RecordRuleDuration records the time taken by the rule function of the given rule on the given project.
+func (results *Type) RecordRuleDuration(lintedProject project.Type, ruleConfiguration ruleconfiguration.Type, duration time.Duration) {
+	timingsReport := results.timingsReport(lintedProject)
+	timingsReport.Rules = append(timingsReport.Rules, ruleTimingReportType{ID: ruleConfiguration.ID, Duration: duration.Seconds()})
+}
+
+// RecordInitializationDuration records the time taken to gather the project data of the given project.
+func (results *Type) RecordInitializationDuration(lintedProject project.Type, duration time.Duration) {
+	results.timingsReport(lintedProject).Initialization = duration.Seconds()
+}
+
+// timingsReport returns the timings report of the given project, which must have been recorded.
+func (results *Type) timingsReport(lintedProject project.Type) *timingsReportType {
+	reportExists, projectReportIndex := results.getProjectReportIndex(lintedProject.Path)
+	if !reportExists {
+		panic(fmt.Sprintf("Unable to find report for %v when recording timings", lintedProject.Path))
+	}
+
+	if results.Projects[projectReportIndex].Timings == nil {
+		results.Projects[projectReportIndex].Timings = &timingsReportType{Rules: []ruleTimingReportType{}}
+	}
+	return results.Projects[projectReportIndex].Timings
+}
+
+// TimingsText returns a text table of the slowest rules and the project data gathering times.
+func (results Type) TimingsText() string {
+	type ruleTiming struct {
+		ruleTimingReportType
+		projectPath string
+	}
+
+	ruleTimings := []ruleTiming{}
+	var text strings.Builder
+	tableWriter := tabwriter.NewWriter(&text, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tableWriter, "Project data initialization:")
+	fmt.Fprintln(tableWriter, "Duration\tProject")
+	for _, projectReport := range results.Projects {
+		if projectReport.Timings == nil {
+			continue
+		}
+		fmt.Fprintf(tableWriter, "%s\t%s\n", timingText(projectReport.Timings.Initialization), projectReport.Path)
+		for _, ruleTimingReport := range projectReport.Timings.Rules {
+			ruleTimings = append(ruleTimings, ruleTiming{ruleTimingReport, projectReport.Path.String()})
+		}
+	}
+
+	sort.SliceStable(ruleTimings, func(i, j int) bool {
+		return ruleTimings[i].Duration > ruleTimings[j].Duration
+	})
+	if len(ruleTimings) > slowestRulesCount {
+		ruleTimings = ruleTimings[:slowestRulesCount]
+	}
+
+	fmt.Fprintln(tableWriter, "\nSlowest rules:")
+	fmt.Fprintln(tableWriter, "Duration\tRule\tProject")
+	for _, ruleTiming := range ruleTimings {
+		fmt.Fprintf(tableWriter, "%s\t%s\t%s\n", timingText(ruleTiming.Duration), ruleTiming.ID, ruleTiming.projectPath)
+	}
+	tableWriter.Flush()
+
+	return text.String()
+}
+
+// timingText returns the text representation of the given duration in seconds.
+func timingText(seconds float64) string {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Microsecond).String()
+}
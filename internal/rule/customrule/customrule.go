@@ -0,0 +1,242 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package customrule implements the rules defined in the configuration file.
+// The conditions of each rule are converted to a JSON schema, which the value of each key matching the rule's key pattern
+// is validated against.
+package customrule
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
+	"github.com/arduino/arduino-lint/internal/project/general"
+	"github.com/arduino/arduino-lint/internal/project/platform/boardstxt"
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulefunction"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/arduino-lint/internal/rule/schema"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// Category is the rule category of all custom rules. The subcategory is the target file name.
+const Category = "custom"
+
+// keyPlaceholderRegexp matches the key pattern components that match any key component (e.g., BOARD_ID).
+var keyPlaceholderRegexp = regexp.MustCompile(`^[A-Z][A-Z0-9_]*_ID$`)
+
+// valueProperty is the property of the validated instance that contains the value of the key.
+const valueProperty = "value"
+
+// Configurations returns the configurations of the given custom rules, which are defined in the given configuration file.
+// An error is returned if the conditions of a rule are not valid.
+func Configurations(customRules []configuration.CustomRule, configurationFilePath *paths.Path) ([]ruleconfiguration.Type, error) {
+	var ruleConfigurations []ruleconfiguration.Type
+	for _, customRule := range customRules {
+		valueSchema, err := compileSchema(customRule)
+		if err != nil {
+			return nil, fmt.Errorf("Configuration file %s custom rule %s conditions not valid: %v", configurationFilePath, customRule.ID, err)
+		}
+
+		ruleConfiguration := ruleconfiguration.Type{
+			ProjectType:      projecttype.Platform,
+			SuperprojectType: projecttype.All,
+			Category:         Category,
+			Subcategory:      customRule.File,
+			ID:               customRule.ID,
+			Brief:            customRule.Brief,
+			Description:      fmt.Sprintf("Custom rule for the %s key of %s, defined in the configuration file.", customRule.Key, customRule.File),
+			MessageTemplate:  customRule.Message,
+			EnableModes:      []rulemode.Type{rulemode.Default},
+			RuleFunction:     ruleFunction(customRule, valueSchema),
+		}
+		if customRule.File == "library.properties" {
+			ruleConfiguration.ProjectType = projecttype.Library
+		}
+		if ruleConfiguration.Brief == "" {
+			ruleConfiguration.Brief = fmt.Sprintf("custom %s rule", customRule.File)
+		}
+		levelModes := []rulemode.Type{rulemode.Default}
+		switch customRule.Level {
+		case configuration.RuleSettingError:
+			ruleConfiguration.ErrorModes = levelModes
+		case configuration.RuleSettingWarning:
+			ruleConfiguration.WarningModes = levelModes
+		case configuration.RuleSettingInfo:
+			ruleConfiguration.InfoModes = levelModes
+		}
+
+		ruleConfigurations = append(ruleConfigurations, ruleConfiguration)
+	}
+
+	return ruleConfigurations, nil
+}
+
+// compileSchema returns the compiled JSON schema for the conditions of the given custom rule.
+// The schema validates an object with the value of the key in the value property, which is not present if the key is
+// not defined.
+func compileSchema(customRule configuration.CustomRule) (schema.Schema, error) {
+	if _, err := regexp.Compile(customRule.Regex); err != nil {
+		return schema.Schema{}, err
+	}
+
+	valueSchema := map[string]interface{}{"type": "string"}
+	if customRule.Regex != "" {
+		valueSchema["pattern"] = customRule.Regex
+	}
+	if customRule.Enum != nil {
+		valueSchema["enum"] = customRule.Enum
+	}
+	if customRule.MinLength != nil {
+		valueSchema["minLength"] = *customRule.MinLength
+	}
+	if customRule.MaxLength != nil {
+		valueSchema["maxLength"] = *customRule.MaxLength
+	}
+	if customRule.Schema != nil {
+		valueSchema["allOf"] = []interface{}{customRule.Schema}
+	}
+	instanceSchema := map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{valueProperty: valueSchema},
+	}
+	if customRule.Required {
+		instanceSchema["required"] = []string{valueProperty}
+	}
+
+	schemaData, err := json.Marshal(instanceSchema)
+	if err != nil {
+		return schema.Schema{}, err
+	}
+	schemaFilename := fmt.Sprintf("custom-rule-%s-schema.json", customRule.ID)
+	dataLoader := func(filename string) ([]byte, error) {
+		if path.Base(filename) != schemaFilename {
+			return nil, fmt.Errorf("Schema %s not found", filename)
+		}
+		return schemaData, nil
+	}
+
+	return schema.CompileWithError(schemaFilename, []string{}, dataLoader)
+}
+
+// ruleFunction returns the function that implements the given custom rule.
+// The rule output is the comma separated list of the keys that violate the rule.
+func ruleFunction(customRule configuration.CustomRule, valueSchema schema.Schema) rulefunction.Type {
+	return func(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []rulefunction.Finding) {
+		var propertiesMap *properties.Map
+		switch customRule.File {
+		case "library.properties":
+			if projectData.LibraryPropertiesLoadError() != nil {
+				return ruleresult.NotRun, "Couldn't load library.properties", nil
+			}
+			propertiesMap = projectData.LibraryProperties()
+		case "boards.txt":
+			if projectData.BoardsTxtLoadError() != nil {
+				return ruleresult.NotRun, "Couldn't load boards.txt", nil
+			}
+			propertiesMap = projectData.BoardsTxt()
+		case "platform.txt":
+			if !projectData.PlatformTxtExists() {
+				return ruleresult.Skip, "Platform has no platform.txt", nil
+			}
+			if projectData.PlatformTxtLoadError() != nil {
+				return ruleresult.NotRun, "Couldn't load platform.txt", nil
+			}
+			propertiesMap = projectData.PlatformTxt()
+		case "programmers.txt":
+			if !projectData.ProgrammersTxtExists() {
+				return ruleresult.Skip, "Platform has no programmers.txt", nil
+			}
+			if projectData.ProgrammersTxtLoadError() != nil {
+				return ruleresult.NotRun, "Couldn't load programmers.txt", nil
+			}
+			propertiesMap = projectData.ProgrammersTxt()
+		}
+
+		nonCompliantKeys := []string{}
+		for _, key := range expandKeyPattern(customRule, propertiesMap) {
+			instance := make(map[string]interface{})
+			if value, ok := propertiesMap.GetOk(key); ok {
+				instance[valueProperty] = value
+			}
+			if schema.Validate(instance, valueSchema).Result != nil {
+				nonCompliantKeys = append(nonCompliantKeys, key)
+			}
+		}
+
+		if len(nonCompliantKeys) == 0 {
+			return ruleresult.Pass, "", nil
+		}
+
+		propertiesPath := projectData.ProjectPath().Join(customRule.File)
+		keyLines, err := general.PropertiesKeyLines(propertiesPath)
+		if err != nil {
+			panic(err)
+		}
+		for _, key := range nonCompliantKeys {
+			// The line is 0 when the key is not defined, in which case the location is the file.
+			findings = append(findings, rulefunction.Finding{Path: propertiesPath, Line: keyLines[key], Subject: key})
+		}
+
+		return ruleresult.Fail, strings.Join(nonCompliantKeys, ", "), findings
+	}
+}
+
+// expandKeyPattern returns the keys matched by the key pattern of the given custom rule.
+// A placeholder component (e.g., BOARD_ID) is expanded to each of the key components defined at its position in the
+// properties, so that a required key is checked for each board, menu option, etc.
+func expandKeyPattern(customRule configuration.CustomRule, propertiesMap *properties.Map) []string {
+	keys := []string{""}
+	for componentIndex, keyPatternComponent := range strings.Split(customRule.Key, ".") {
+		expandedKeys := []string{}
+		for _, key := range keys {
+			prefix := key
+			if prefix != "" {
+				prefix += "."
+			}
+
+			if !keyPlaceholderRegexp.MatchString(keyPatternComponent) {
+				expandedKeys = append(expandedKeys, prefix+keyPatternComponent)
+				continue
+			}
+
+			var keyComponents []string
+			if componentIndex == 0 {
+				keyComponents = propertiesMap.FirstLevelKeys()
+				if customRule.File == "boards.txt" {
+					// The top level menu key contains the menu titles, not a board.
+					keyComponents = boardstxt.BoardIDs(propertiesMap)
+				}
+			} else {
+				keyComponents = propertiesMap.SubTree(key).FirstLevelKeys()
+			}
+			for _, keyComponent := range keyComponents {
+				expandedKeys = append(expandedKeys, prefix+keyComponent)
+			}
+		}
+		keys = expandedKeys
+	}
+
+	return keys
+}
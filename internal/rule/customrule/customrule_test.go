@@ -0,0 +1,116 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package customrule
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/rulefunction"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/arduino-lint/internal/util/test"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPointer(value int) *int {
+	return &value
+}
+
+func TestConfigurations(t *testing.T) {
+	ruleConfigurations, err := Configurations([]configuration.CustomRule{
+		{ID: "CR001", File: "library.properties", Key: "maintainer", Level: "warning", Message: "foo", Required: true},
+		{ID: "CR002", Brief: "bar", File: "boards.txt", Key: "BOARD_ID.name", Level: "info", Message: "foo", Regex: "^Foo"},
+	}, paths.New(".arduino-lint.yml"))
+	require.Nil(t, err)
+	require.Len(t, ruleConfigurations, 2)
+	assert.Equal(t, "CR001", ruleConfigurations[0].ID)
+	assert.Equal(t, projecttype.Library, ruleConfigurations[0].ProjectType)
+	assert.Equal(t, Category, ruleConfigurations[0].Category)
+	assert.Equal(t, "library.properties", ruleConfigurations[0].Subcategory)
+	assert.Equal(t, "custom library.properties rule", ruleConfigurations[0].Brief, "Default brief")
+	assert.NotEmpty(t, ruleConfigurations[0].WarningModes)
+	assert.Empty(t, ruleConfigurations[0].ErrorModes)
+	assert.Equal(t, projecttype.Platform, ruleConfigurations[1].ProjectType)
+	assert.Equal(t, "bar", ruleConfigurations[1].Brief)
+	assert.NotEmpty(t, ruleConfigurations[1].InfoModes)
+
+	_, err = Configurations([]configuration.CustomRule{{ID: "CR001", File: "library.properties", Key: "name", Level: "error", Message: "foo", Regex: "("}}, paths.New(".arduino-lint.yml"))
+	assert.Error(t, err, "Invalid regex")
+	_, err = Configurations([]configuration.CustomRule{{ID: "CR001", File: "library.properties", Key: "name", Level: "error", Message: "foo", Schema: map[string]interface{}{"type": 42}}}, paths.New(".arduino-lint.yml"))
+	assert.Error(t, err, "Invalid schema")
+}
+
+func TestRuleFunction(t *testing.T) {
+	projectsPath, err := paths.MkTempDir("", "arduino-lint-customrule-TestRuleFunction")
+	require.Nil(t, err)
+	defer projectsPath.RemoveAll()
+
+	libraryPath := projectsPath.Join("Foo")
+	require.Nil(t, libraryPath.Mkdir())
+	require.Nil(t, libraryPath.Join("library.properties").WriteFile([]byte("name=Foo\nversion=1.0.0\nmaintainer=Jane Doe <jane@example.com>\ncategory=Other\n")))
+	require.Nil(t, libraryPath.Join("Foo.h").WriteFile([]byte{}))
+	platformPath := projectsPath.Join("Platform")
+	require.Nil(t, platformPath.Mkdir())
+	require.Nil(t, platformPath.Join("boards.txt").WriteFile([]byte("menu.cpu=Processor\nuno.name=Example Uno\nuno.menu.cpu.foo=Foo\nuno.menu.cpu.bar=Bar\nmega.name=Mega\nmega.menu.cpu.foo=Foo\n")))
+	require.Nil(t, platformPath.Join("platform.txt").WriteFile([]byte("name=Example AVR\n")))
+
+	toolConfiguration, err := configuration.Initialize(test.ConfigurationFlags(), []string{projectsPath.String()})
+	require.Nil(t, err)
+	var libraryManagerIndex projectdata.LibraryManagerIndexType
+	libraryData := projectdata.Initialize(project.Type{Path: libraryPath, ProjectType: projecttype.Library, SuperprojectType: projecttype.Library}, toolConfiguration, &libraryManagerIndex)
+	platformData := projectdata.Initialize(project.Type{Path: platformPath, ProjectType: projecttype.Platform, SuperprojectType: projecttype.Platform}, toolConfiguration, &libraryManagerIndex)
+
+	testTables := []struct {
+		testName         string
+		customRule       configuration.CustomRule
+		projectData      *projectdata.Type
+		expectedResult   ruleresult.Type
+		expectedOutput   string
+		expectedFindings []rulefunction.Finding
+	}{
+		{"Required pass", configuration.CustomRule{File: "library.properties", Key: "maintainer", Required: true}, libraryData, ruleresult.Pass, "", nil},
+		{"Required fail", configuration.CustomRule{File: "library.properties", Key: "url", Required: true}, libraryData, ruleresult.Fail, "url", []rulefunction.Finding{{Path: libraryPath.Join("library.properties"), Subject: "url"}}},
+		{"Not required", configuration.CustomRule{File: "library.properties", Key: "url", Regex: "^https://"}, libraryData, ruleresult.Pass, "", nil},
+		{"Regex pass", configuration.CustomRule{File: "library.properties", Key: "maintainer", Regex: "<[^>]+@example\\.com>$"}, libraryData, ruleresult.Pass, "", nil},
+		{"Regex fail", configuration.CustomRule{File: "library.properties", Key: "maintainer", Regex: "<[^>]+@example\\.org>$"}, libraryData, ruleresult.Fail, "maintainer", []rulefunction.Finding{{Path: libraryPath.Join("library.properties"), Line: 3, Subject: "maintainer"}}},
+		{"Enum pass", configuration.CustomRule{File: "library.properties", Key: "category", Enum: []string{"Other", "Sensors"}}, libraryData, ruleresult.Pass, "", nil},
+		{"Enum fail", configuration.CustomRule{File: "library.properties", Key: "category", Enum: []string{"Sensors"}}, libraryData, ruleresult.Fail, "category", []rulefunction.Finding{{Path: libraryPath.Join("library.properties"), Line: 4, Subject: "category"}}},
+		{"Min length fail", configuration.CustomRule{File: "library.properties", Key: "name", MinLength: intPointer(4)}, libraryData, ruleresult.Fail, "name", []rulefunction.Finding{{Path: libraryPath.Join("library.properties"), Line: 1, Subject: "name"}}},
+		{"Max length pass", configuration.CustomRule{File: "library.properties", Key: "name", MaxLength: intPointer(3)}, libraryData, ruleresult.Pass, "", nil},
+		{"Schema fail", configuration.CustomRule{File: "library.properties", Key: "version", Schema: map[string]interface{}{"not": map[string]interface{}{"const": "1.0.0"}}}, libraryData, ruleresult.Fail, "version", []rulefunction.Finding{{Path: libraryPath.Join("library.properties"), Line: 2, Subject: "version"}}},
+		{"Board ID placeholder", configuration.CustomRule{File: "boards.txt", Key: "BOARD_ID.name", Regex: "^Example "}, platformData, ruleresult.Fail, "mega.name", []rulefunction.Finding{{Path: platformPath.Join("boards.txt"), Line: 5, Subject: "mega.name"}}},
+		{"Multiple placeholders", configuration.CustomRule{File: "boards.txt", Key: "BOARD_ID.menu.MENU_ID.OPTION_ID", Enum: []string{"Foo"}}, platformData, ruleresult.Fail, "uno.menu.cpu.bar", []rulefunction.Finding{{Path: platformPath.Join("boards.txt"), Line: 4, Subject: "uno.menu.cpu.bar"}}},
+		{"Placeholder required", configuration.CustomRule{File: "boards.txt", Key: "BOARD_ID.menu.cpu.bar", Required: true}, platformData, ruleresult.Fail, "mega.menu.cpu.bar", []rulefunction.Finding{{Path: platformPath.Join("boards.txt"), Subject: "mega.menu.cpu.bar"}}},
+		{"platform.txt", configuration.CustomRule{File: "platform.txt", Key: "name", Regex: "^Example "}, platformData, ruleresult.Pass, "", nil},
+		{"No programmers.txt", configuration.CustomRule{File: "programmers.txt", Key: "PROGRAMMER_ID.name", Required: true}, platformData, ruleresult.Skip, "Platform has no programmers.txt", nil},
+	}
+
+	for _, testTable := range testTables {
+		testTable.customRule.ID = "CR001"
+		ruleConfigurations, err := Configurations([]configuration.CustomRule{testTable.customRule}, toolConfiguration.ConfigurationFilePath())
+		require.Nil(t, err, testTable.testName)
+		result, output, findings := ruleConfigurations[0].RuleFunction(testTable.projectData)
+		assert.Equal(t, testTable.expectedResult, result, testTable.testName)
+		assert.Equal(t, testTable.expectedOutput, output, testTable.testName)
+		assert.Equal(t, testTable.expectedFindings, findings, testTable.testName)
+	}
+}
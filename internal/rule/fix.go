@@ -0,0 +1,260 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package rule
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/result/feedback"
+	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulefunction"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"github.com/sirupsen/logrus"
+)
+
+// diffContextLines is the number of unchanged lines shown around each change in the diff.
+const diffContextLines = 3
+
+// Fixer fixes the violations of the rules that have an automatic fix for the given project under the given tool configuration.
+// In dry run mode, the changes are printed as a unified diff instead of being applied.
+func Fixer(project project.Type, toolConfiguration *configuration.Type, libraryManagerIndex *projectdata.LibraryManagerIndexType) error {
+	projectData := projectdata.Initialize(project, toolConfiguration, libraryManagerIndex)
+
+	ruleConfigurations := make(map[string]ruleconfiguration.Type)
+	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
+		ruleConfigurations[ruleConfiguration.ID] = ruleConfiguration
+	}
+
+	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
+		if ruleConfiguration.FixFunction == nil {
+			continue
+		}
+
+		runRule, err := shouldRun(ruleConfiguration, project, toolConfiguration)
+		if err != nil {
+			panic(err)
+		}
+
+		if !runRule || fixBlocked(ruleConfiguration, ruleConfigurations, projectData) {
+			continue
+		}
+
+		ruleResult, ruleOutput, ruleFindings := ruleConfiguration.RuleFunction(projectData)
+		if ruleResult != ruleresult.Fail || isSuppressed(projectData, ruleConfiguration.ID, ruleFindings) {
+			continue
+		}
+
+		changes := ruleConfiguration.FixFunction(projectData, ruleOutput, ruleFindings)
+		if len(changes) == 0 {
+			logrus.Infof("No automatic fix available for rule %s violation", ruleConfiguration.ID)
+			continue
+		}
+
+		if toolConfiguration.DryRun() {
+			feedback.Printf(toolConfiguration, "Fix for rule %s (%s) in %s:\n", ruleConfiguration.ID, ruleConfiguration.Brief, project.Path)
+			for _, change := range changes {
+				feedback.Print(toolConfiguration, changeDiff(change, project.Path))
+			}
+			continue
+		}
+
+		for _, change := range changes {
+			if err := applyChange(change); err != nil {
+				return fmt.Errorf("Unable to fix rule %s violation: %v", ruleConfiguration.ID, err)
+			}
+		}
+		feedback.Printf(toolConfiguration, "Fixed rule %s (%s) in %s\n", ruleConfiguration.ID, ruleConfiguration.Brief, project.Path)
+
+		// The project files were changed, so the rule data must be gathered again.
+		projectData = projectdata.Initialize(project, toolConfiguration, libraryManagerIndex)
+	}
+
+	return nil
+}
+
+// fixBlocked returns whether the results of the rules the given rule depends on, out of the given rules, prevent the rule from being run on the
+// project, as they do when linting.
+func fixBlocked(ruleConfiguration ruleconfiguration.Type, ruleConfigurations map[string]ruleconfiguration.Type, projectData *projectdata.Type) bool {
+	for _, dependencyID := range ruleConfiguration.DependsOn {
+		dependencyConfiguration := ruleConfigurations[dependencyID]
+		if _, blockingOutput, blocked := dependencyBlocks(dependencyConfiguration, checkDependency(dependencyConfiguration, projectData)); blocked {
+			logrus.Infof("Not fixing rule %s: %s", ruleConfiguration.ID, blockingOutput)
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyChange makes the given change to the project files.
+func applyChange(change rulefunction.Change) error {
+	if change.NewPath != nil {
+		if change.NewPath.Exist() && !strings.EqualFold(change.Path.String(), change.NewPath.String()) {
+			return fmt.Errorf("%s already exists", change.NewPath)
+		}
+
+		// Renaming via a temporary path allows changing only the case on case-insensitive file systems.
+		temporaryPath := change.NewPath.Parent().Join(".arduino-lint-fix-" + change.NewPath.Base())
+		if err := change.Path.Rename(temporaryPath); err != nil {
+			return err
+		}
+		return temporaryPath.Rename(change.NewPath)
+	}
+
+	return change.Path.WriteFile(change.NewContent)
+}
+
+// changeDiff returns the given change in the git unified diff format, with paths relative to the project.
+func changeDiff(change rulefunction.Change, projectPath *paths.Path) string {
+	oldName := diffPath(change.Path, projectPath)
+
+	if change.NewPath != nil {
+		newName := diffPath(change.NewPath, projectPath)
+		return fmt.Sprintf("diff --git a/%s b/%s\nrename from %s\nrename to %s\n", oldName, newName, oldName, newName)
+	}
+
+	oldContent, err := change.Path.ReadFile()
+	if err != nil {
+		panic(err)
+	}
+
+	return fmt.Sprintf("diff --git a/%s b/%s\n", oldName, oldName) + unifiedDiff(oldName, oldName, string(oldContent), string(change.NewContent))
+}
+
+// diffPath returns the path relative to the project folder, using forward slashes.
+func diffPath(path *paths.Path, projectPath *paths.Path) string {
+	if projectPath.IsNotDir() {
+		projectPath = projectPath.Parent()
+	}
+	relativePath, err := path.RelFrom(projectPath)
+	if err != nil {
+		return filepath.ToSlash(path.String())
+	}
+
+	return filepath.ToSlash(relativePath.String())
+}
+
+// diffLine is a line of the unified diff.
+type diffLine struct {
+	operation diffmatchpatch.Operation
+	text      string // Line content, including the line ending if present.
+}
+
+// unifiedDiff returns the line differences between the old and new content in the unified diff format.
+func unifiedDiff(oldName string, newName string, oldContent string, newContent string) string {
+	diffMatchPatch := diffmatchpatch.New()
+	oldChars, newChars, lineArray := diffMatchPatch.DiffLinesToChars(oldContent, newContent)
+	diffs := diffMatchPatch.DiffCharsToLines(diffMatchPatch.DiffMain(oldChars, newChars, false), lineArray)
+
+	lines := []diffLine{}
+	for _, diff := range diffs {
+		for _, text := range strings.SplitAfter(diff.Text, "\n") {
+			if text != "" {
+				lines = append(lines, diffLine{operation: diff.Type, text: text})
+			}
+		}
+	}
+
+	// The line numbers in the old and new content of each diff line.
+	oldLineNumbers := make([]int, len(lines)+1)
+	newLineNumbers := make([]int, len(lines)+1)
+	oldLineNumbers[0], newLineNumbers[0] = 1, 1
+	for index, line := range lines {
+		oldLineNumbers[index+1], newLineNumbers[index+1] = oldLineNumbers[index], newLineNumbers[index]
+		if line.operation != diffmatchpatch.DiffInsert {
+			oldLineNumbers[index+1]++
+		}
+		if line.operation != diffmatchpatch.DiffDelete {
+			newLineNumbers[index+1]++
+		}
+	}
+
+	var diffBuilder strings.Builder
+	fmt.Fprintf(&diffBuilder, "--- a/%s\n+++ b/%s\n", oldName, newName)
+
+	for hunkEnd := 0; ; {
+		// Find the next change.
+		changeStart := hunkEnd
+		for changeStart < len(lines) && lines[changeStart].operation == diffmatchpatch.DiffEqual {
+			changeStart++
+		}
+		if changeStart == len(lines) {
+			break
+		}
+
+		// Changes separated by less than twice the context are combined in a single hunk.
+		changeEnd := changeStart
+		for {
+			for changeEnd < len(lines) && lines[changeEnd].operation != diffmatchpatch.DiffEqual {
+				changeEnd++
+			}
+			nextChangeStart := changeEnd
+			for nextChangeStart < len(lines) && lines[nextChangeStart].operation == diffmatchpatch.DiffEqual {
+				nextChangeStart++
+			}
+			if nextChangeStart == len(lines) || nextChangeStart-changeEnd > 2*diffContextLines {
+				break
+			}
+			changeEnd = nextChangeStart
+		}
+
+		hunkStart := max(changeStart-diffContextLines, hunkEnd)
+		hunkEnd = min(changeEnd+diffContextLines, len(lines))
+
+		fmt.Fprintf(
+			&diffBuilder,
+			"@@ -%s +%s @@\n",
+			hunkRange(oldLineNumbers[hunkStart], oldLineNumbers[hunkEnd]-oldLineNumbers[hunkStart]),
+			hunkRange(newLineNumbers[hunkStart], newLineNumbers[hunkEnd]-newLineNumbers[hunkStart]),
+		)
+		for _, line := range lines[hunkStart:hunkEnd] {
+			switch line.operation {
+			case diffmatchpatch.DiffEqual:
+				diffBuilder.WriteString(" ")
+			case diffmatchpatch.DiffDelete:
+				diffBuilder.WriteString("-")
+			case diffmatchpatch.DiffInsert:
+				diffBuilder.WriteString("+")
+			}
+			diffBuilder.WriteString(line.text)
+			if !strings.HasSuffix(line.text, "\n") {
+				diffBuilder.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+	}
+
+	return diffBuilder.String()
+}
+
+// hunkRange returns the unified diff hunk range for the given start line and line count.
+func hunkRange(start int, count int) string {
+	if count == 0 {
+		// An empty range refers to the line before the change.
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
@@ -0,0 +1,163 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package rule
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/rulefunction"
+	"github.com/arduino/arduino-lint/internal/util/test"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_unifiedDiff(t *testing.T) {
+	testTables := []struct {
+		testName     string
+		oldContent   string
+		newContent   string
+		expectedDiff string
+	}{
+		{"No change", "a\nb\n", "a\nb\n", "--- a/foo\n+++ b/foo\n"},
+		{"Edit", "a\nb\nc\n", "a\nB\nc\n", "--- a/foo\n+++ b/foo\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n"},
+		{"Append", "a\n", "a\nb\n", "--- a/foo\n+++ b/foo\n@@ -1 +1,2 @@\n a\n+b\n"},
+		{"Empty file", "", "a\n", "--- a/foo\n+++ b/foo\n@@ -0,0 +1 @@\n+a\n"},
+		{"No newline at end of file", "a", "a\nb\n", "--- a/foo\n+++ b/foo\n@@ -1 +1,2 @@\n-a\n\\ No newline at end of file\n+a\n+b\n"},
+		{
+			"Separate hunks",
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+			"0\n2\n3\n4\n5\n6\n7\n8\n9\n0\n",
+			"--- a/foo\n+++ b/foo\n@@ -1,4 +1,4 @@\n-1\n+0\n 2\n 3\n 4\n@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-10\n+0\n",
+		},
+		{
+			"Combined hunk",
+			"1\n2\n3\n4\n5\n6\n7\n8\n",
+			"0\n2\n3\n4\n5\n6\n7\n0\n",
+			"--- a/foo\n+++ b/foo\n@@ -1,8 +1,8 @@\n-1\n+0\n 2\n 3\n 4\n 5\n 6\n 7\n-8\n+0\n",
+		},
+	}
+
+	for _, testTable := range testTables {
+		assert.Equal(t, testTable.expectedDiff, unifiedDiff("foo", "foo", testTable.oldContent, testTable.newContent), testTable.testName)
+	}
+}
+
+func Test_changeDiff(t *testing.T) {
+	projectPath, err := paths.MkTempDir("", "arduino-lint-rule-Test_changeDiff")
+	require.Nil(t, err)
+	defer projectPath.RemoveAll() // clean up
+	require.Nil(t, projectPath.Join("Foo.pde").WriteFile([]byte("a\n")))
+
+	assert.Equal(
+		t,
+		"diff --git a/Foo.pde b/Foo.ino\nrename from Foo.pde\nrename to Foo.ino\n",
+		changeDiff(rulefunction.Change{Path: projectPath.Join("Foo.pde"), NewPath: projectPath.Join("Foo.ino")}, projectPath),
+	)
+	assert.Equal(
+		t,
+		"diff --git a/Foo.pde b/Foo.pde\n--- a/Foo.pde\n+++ b/Foo.pde\n@@ -1 +1 @@\n-a\n+b\n",
+		changeDiff(rulefunction.Change{Path: projectPath.Join("Foo.pde"), NewContent: []byte("b\n")}, projectPath),
+	)
+}
+
+func Test_applyChange(t *testing.T) {
+	projectPath, err := paths.MkTempDir("", "arduino-lint-rule-Test_applyChange")
+	require.Nil(t, err)
+	defer projectPath.RemoveAll() // clean up
+	require.Nil(t, projectPath.Join("Src").Mkdir())
+	require.Nil(t, projectPath.Join("foo.h").WriteFile([]byte("a\n")))
+
+	require.Nil(t, applyChange(rulefunction.Change{Path: projectPath.Join("Src"), NewPath: projectPath.Join("src")}))
+	listing, err := projectPath.ReadDir()
+	require.Nil(t, err)
+	assert.ElementsMatch(t, paths.PathList{projectPath.Join("foo.h"), projectPath.Join("src")}, listing)
+
+	require.Nil(t, applyChange(rulefunction.Change{Path: projectPath.Join("foo.h"), NewContent: []byte("b\n")}))
+	content, err := projectPath.Join("foo.h").ReadFile()
+	require.Nil(t, err)
+	assert.Equal(t, "b\n", string(content))
+
+	assert.Error(t, applyChange(rulefunction.Change{Path: projectPath.Join("src"), NewPath: projectPath.Join("foo.h")}), "Destination exists")
+}
+
+func TestFixer(t *testing.T) {
+	projectPath, err := paths.MkTempDir("", "arduino-lint-rule-TestFixer")
+	require.Nil(t, err)
+	defer projectPath.RemoveAll() // clean up
+	sketchPath := projectPath.Join("Foo")
+	require.Nil(t, sketchPath.Join("Src").MkdirAll())
+	require.Nil(t, sketchPath.Join("Foo.pde").WriteFile([]byte("#include <arduino.h>\nvoid setup() {}\nvoid loop() {}\n")))
+
+	sketch := project.Type{
+		Path:             sketchPath,
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}
+
+	flags := test.ConfigurationFlags()
+	flags.Set("fix", "true")
+	flags.Set("dry-run", "true")
+	toolConfiguration, err := configuration.Initialize(flags, []string{sketchPath.String()})
+	require.Nil(t, err)
+	require.Nil(t, Fixer(sketch, toolConfiguration, &projectdata.LibraryManagerIndexType{}))
+	assert.True(t, sketchPath.Join("Foo.pde").Exist(), "Dry run doesn't change the project")
+
+	flags.Set("dry-run", "false")
+	toolConfiguration, err = configuration.Initialize(flags, []string{sketchPath.String()})
+	require.Nil(t, err)
+	require.Nil(t, Fixer(sketch, toolConfiguration, &projectdata.LibraryManagerIndexType{}))
+	listing, err := sketchPath.ReadDir()
+	require.Nil(t, err)
+	assert.ElementsMatch(t, paths.PathList{sketchPath.Join("Foo.ino"), sketchPath.Join("src")}, listing)
+	content, err := sketchPath.Join("Foo.ino").ReadFile()
+	require.Nil(t, err)
+	assert.Equal(t, "#include <Arduino.h>\nvoid setup() {}\nvoid loop() {}\n", string(content))
+}
+
+func TestFixerDependencies(t *testing.T) {
+	platformPath, err := paths.MkTempDir("", "arduino-lint-rule-TestFixerDependencies")
+	require.Nil(t, err)
+	defer platformPath.RemoveAll() // clean up
+	require.Nil(t, platformPath.Join("boards.txt").WriteFile([]byte("This makes the format invalid\n")))
+	require.Nil(t, platformPath.Join("platform.txt").WriteFile([]byte("name=Foo\nversion=1.0.0\n")))
+
+	platform := project.Type{
+		Path:             platformPath,
+		ProjectType:      projecttype.Platform,
+		SuperprojectType: projecttype.Platform,
+	}
+
+	flags := test.ConfigurationFlags()
+	flags.Set("fix", "true")
+	toolConfiguration, err := configuration.Initialize(flags, []string{platformPath.String()})
+	require.Nil(t, err)
+	require.Nil(t, Fixer(platform, toolConfiguration, &projectdata.LibraryManagerIndexType{}))
+	content, err := platformPath.Join("platform.txt").ReadFile()
+	require.Nil(t, err)
+	assert.Equal(t, "name=Foo\nversion=1.0.0\n", string(content), "Rules are not fixed when a rule they depend on fails")
+
+	require.Nil(t, platformPath.Join("boards.txt").WriteFile([]byte("uno.name=Arduino Uno\n")))
+	require.Nil(t, Fixer(platform, toolConfiguration, &projectdata.LibraryManagerIndexType{}))
+	content, err = platformPath.Join("platform.txt").ReadFile()
+	require.Nil(t, err)
+	assert.Contains(t, string(content), "compiler.c.extra_flags=\n")
+}
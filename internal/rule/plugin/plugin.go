@@ -0,0 +1,232 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package plugin runs the external rule executables configured by the user.
+//
+// A plugin is run once for each project, in the folder of the configuration file. It receives the project data as a JSON object on stdin:
+//
+//	{
+//	  "protocolVersion": 1,
+//	  "projectPath": "/path/to/project",
+//	  "projectType": "library",
+//	  "superprojectType": "library",
+//	  "metadata": {
+//	    "library.properties": {"name": "Foo", ...}
+//	  }
+//	}
+//
+// The metadata contains the properties of the library.properties, boards.txt, platform.txt, and programmers.txt files of
+// the project, when present.
+//
+// It must exit with status 0 and write its findings as a JSON object on stdout:
+//
+//	{
+//	  "findings": [
+//	    {
+//	      "ID": "HR001",
+//	      "level": "error",
+//	      "message": "maintainer field must contain the company email address",
+//	      "location": {"path": "library.properties", "line": 5}
+//	    }
+//	  ]
+//	}
+//
+// The level is one of error, warning, or info. The location is optional, and its path is relative to the project path.
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulefunction"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// protocolVersion is the version of the data format of the plugin input and output.
+const protocolVersion = 1
+
+// Category is the rule category of all plugin rules. The subcategory is the plugin name.
+const Category = "plugin"
+
+// Finding is a rule violation reported by a plugin.
+type Finding struct {
+	RuleConfiguration ruleconfiguration.Type // The configuration of the violated rule, with the level reported by the plugin.
+	Message           string
+	Locations         []rulefunction.Finding
+}
+
+// inputType is the type of the data passed to the plugin on stdin.
+type inputType struct {
+	ProtocolVersion  int                          `json:"protocolVersion"`
+	ProjectPath      *paths.Path                  `json:"projectPath"`
+	ProjectType      string                       `json:"projectType"`
+	SuperprojectType string                       `json:"superprojectType"`
+	Metadata         map[string]map[string]string `json:"metadata"`
+}
+
+// outputType is the type of the data returned by the plugin on stdout.
+type outputType struct {
+	Findings []struct {
+		ID       string `json:"ID"`
+		Level    string `json:"level"`
+		Message  string `json:"message"`
+		Location *struct {
+			Path   string `json:"path"`
+			Line   int    `json:"line"`
+			Column int    `json:"column"`
+		} `json:"location"`
+	} `json:"findings"`
+}
+
+// Configurations returns the configurations of the rules provided by the given plugin.
+// Plugin rules apply to all project types and are enabled by default. The level of a violation is reported by the plugin.
+func Configurations(plugin configuration.Plugin) []ruleconfiguration.Type {
+	var ruleConfigurations []ruleconfiguration.Type
+	for _, ruleID := range plugin.Rules {
+		ruleConfigurations = append(
+			ruleConfigurations,
+			ruleconfiguration.Type{
+				ProjectType:      projecttype.All,
+				SuperprojectType: projecttype.All,
+				Category:         Category,
+				Subcategory:      plugin.Name,
+				ID:               ruleID,
+				Brief:            fmt.Sprintf("%s plugin rule", plugin.Name),
+				Description:      fmt.Sprintf("Rule provided by the %s plugin.", plugin.Name),
+				MessageTemplate:  "{{.}}",
+				EnableModes:      []rulemode.Type{rulemode.Default},
+				ErrorModes:       []rulemode.Type{rulemode.Default},
+			},
+		)
+	}
+
+	return ruleConfigurations
+}
+
+// Run runs the given plugin on the given project and returns the rule violations it reported.
+// The plugin process is killed if the context is canceled before it exits.
+func Run(ctx context.Context, plugin configuration.Plugin, projectData *projectdata.Type) ([]Finding, error) {
+	input := inputType{
+		ProtocolVersion:  protocolVersion,
+		ProjectPath:      projectData.ProjectPath(),
+		ProjectType:      projectData.ProjectType().String(),
+		SuperprojectType: projectData.SuperProjectType().String(),
+		Metadata:         metadata(projectData),
+	}
+	inputData, err := json.Marshal(input)
+	if err != nil {
+		panic(err)
+	}
+
+	command := exec.CommandContext(ctx, plugin.Command[0], plugin.Command[1:]...)
+	if pluginsConfigurationFilePath := projectData.ToolConfiguration().PluginsConfigurationFilePath(); pluginsConfigurationFilePath != nil {
+		// Plugins are configured in the plugins configuration file, so relative paths in the command are relative to it.
+		command.Dir = pluginsConfigurationFilePath.Parent().String()
+	}
+	command.Stdin = bytes.NewReader(inputData)
+	var stdout, stderr bytes.Buffer
+	command.Stdout = &stdout
+	command.Stderr = &stderr
+	if err := command.Run(); err != nil {
+		if ctx.Err() != nil {
+			// The plugin was killed because the run was canceled.
+			return nil, ctx.Err()
+		}
+		if stderrText := strings.TrimSpace(stderr.String()); stderrText != "" {
+			return nil, fmt.Errorf("%v: %s", err, stderrText)
+		}
+		return nil, err
+	}
+
+	var output outputType
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return nil, fmt.Errorf("Invalid output: %v", err)
+	}
+
+	ruleConfigurations := Configurations(plugin)
+	var findings []Finding
+	for _, outputFinding := range output.Findings {
+		ruleIndex := slices.IndexFunc(ruleConfigurations, func(ruleConfiguration ruleconfiguration.Type) bool {
+			return strings.EqualFold(ruleConfiguration.ID, outputFinding.ID)
+		})
+		if ruleIndex == -1 {
+			return nil, fmt.Errorf("Finding for rule %s, which is not in the plugin's configured rules", outputFinding.ID)
+		}
+
+		ruleConfiguration := ruleConfigurations[ruleIndex]
+		ruleConfiguration.ErrorModes = nil
+		levelModes := []rulemode.Type{rulemode.Default}
+		switch strings.ToLower(outputFinding.Level) {
+		case "error":
+			ruleConfiguration.ErrorModes = levelModes
+		case "warning":
+			ruleConfiguration.WarningModes = levelModes
+		case "info":
+			ruleConfiguration.InfoModes = levelModes
+		default:
+			return nil, fmt.Errorf("Finding for rule %s has invalid level %s", outputFinding.ID, outputFinding.Level)
+		}
+
+		finding := Finding{RuleConfiguration: ruleConfiguration, Message: outputFinding.Message}
+		if outputFinding.Location != nil {
+			if outputFinding.Location.Path == "" {
+				return nil, fmt.Errorf("Finding for rule %s has a location without a path", outputFinding.ID)
+			}
+			locationPath := paths.New(outputFinding.Location.Path)
+			if !locationPath.IsAbs() {
+				locationPath = projectData.ProjectPath().JoinPath(locationPath)
+			}
+			finding.Locations = []rulefunction.Finding{
+				{Path: locationPath, Line: outputFinding.Location.Line, Column: outputFinding.Location.Column},
+			}
+		}
+		findings = append(findings, finding)
+	}
+
+	return findings, nil
+}
+
+// metadata returns the properties of the project's metadata files, mapped by file name.
+func metadata(projectData *projectdata.Type) map[string]map[string]string {
+	metadata := make(map[string]map[string]string)
+	addProperties := func(fileName string, properties *properties.Map) {
+		if properties != nil {
+			metadata[fileName] = properties.AsMap()
+		}
+	}
+
+	switch projectData.ProjectType() {
+	case projecttype.Library:
+		addProperties("library.properties", projectData.LibraryProperties())
+	case projecttype.Platform:
+		addProperties("boards.txt", projectData.BoardsTxt())
+		addProperties("platform.txt", projectData.PlatformTxt())
+		addProperties("programmers.txt", projectData.ProgrammersTxt())
+	}
+
+	return metadata
+}
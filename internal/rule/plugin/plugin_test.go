@@ -0,0 +1,146 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/rule/rulefunction"
+	"github.com/arduino/arduino-lint/internal/util/test"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPluginBehaviorEnvironmentVariable selects the behavior of the test binary when it is run as a plugin.
+const testPluginBehaviorEnvironmentVariable = "ARDUINO_LINT_TEST_PLUGIN_BEHAVIOR"
+
+func TestMain(m *testing.M) {
+	// The test binary is used as the plugin, so that the tests don't depend on any other executable.
+	if behavior, ok := os.LookupEnv(testPluginBehaviorEnvironmentVariable); ok {
+		os.Exit(testPlugin(behavior))
+	}
+
+	os.Exit(m.Run())
+}
+
+// testPlugin implements the plugin protocol with the given behavior and returns the exit status.
+func testPlugin(behavior string) int {
+	var input inputType
+	if err := json.NewDecoder(os.Stdin).Decode(&input); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	switch behavior {
+	case "findings":
+		fmt.Printf(
+			`{"findings": [{"ID": "hr001", "level": "warning", "message": "%s %s %s"}, {"ID": "HR002", "level": "error", "message": "bar", "location": {"path": "library.properties", "line": 2}}]}`,
+			input.ProjectType,
+			input.SuperprojectType,
+			input.Metadata["library.properties"]["maintainer"],
+		)
+	case "no findings":
+		fmt.Print(`{"findings": []}`)
+	case "exit status":
+		fmt.Fprintln(os.Stderr, "foo error")
+		return 1
+	case "invalid output":
+		fmt.Print("foo")
+	case "unknown rule":
+		fmt.Print(`{"findings": [{"ID": "HR003", "level": "error", "message": "foo"}]}`)
+	case "invalid level":
+		fmt.Print(`{"findings": [{"ID": "HR001", "level": "foo", "message": "foo"}]}`)
+	case "empty location path":
+		fmt.Print(`{"findings": [{"ID": "HR001", "level": "error", "message": "foo", "location": {"path": "", "line": 2}}]}`)
+	}
+
+	return 0
+}
+
+func TestConfigurations(t *testing.T) {
+	ruleConfigurations := Configurations(configuration.Plugin{Name: "house-rules", Command: []string{"foo"}, Rules: []string{"HR001", "HR002"}})
+	require.Len(t, ruleConfigurations, 2)
+	for index, ruleID := range []string{"HR001", "HR002"} {
+		assert.Equal(t, ruleID, ruleConfigurations[index].ID)
+		assert.Equal(t, Category, ruleConfigurations[index].Category)
+		assert.Equal(t, "house-rules", ruleConfigurations[index].Subcategory)
+		assert.Equal(t, projecttype.All, ruleConfigurations[index].ProjectType)
+	}
+}
+
+func TestRun(t *testing.T) {
+	projectPath, err := paths.MkTempDir("", "arduino-lint-plugin-TestRun")
+	require.Nil(t, err)
+	defer projectPath.RemoveAll()
+	projectPath = projectPath.Join("Foo")
+	require.Nil(t, projectPath.Mkdir())
+	require.Nil(t, projectPath.Join("library.properties").WriteFile([]byte("name=Foo\nmaintainer=Jane Doe <jane@example.com>\n")))
+	require.Nil(t, projectPath.Join("Foo.h").WriteFile([]byte{}))
+
+	toolConfiguration, err := configuration.Initialize(test.ConfigurationFlags(), []string{projectPath.String()})
+	require.Nil(t, err)
+	projectData := projectdata.Initialize(project.Type{Path: projectPath, ProjectType: projecttype.Library, SuperprojectType: projecttype.Library}, toolConfiguration, &projectdata.LibraryManagerIndexType{})
+
+	executablePath, err := os.Executable()
+	require.Nil(t, err)
+	plugin := configuration.Plugin{Name: "house-rules", Command: []string{executablePath}, Rules: []string{"HR001", "HR002"}}
+
+	t.Setenv(testPluginBehaviorEnvironmentVariable, "findings")
+	findings, err := Run(context.Background(), plugin, projectData)
+	require.Nil(t, err)
+	require.Len(t, findings, 2)
+	assert.Equal(t, "HR001", findings[0].RuleConfiguration.ID, "Rule IDs are case insensitive")
+	assert.Equal(t, "library library Jane Doe <jane@example.com>", findings[0].Message, "Project data is passed to plugin")
+	assert.Nil(t, findings[0].Locations)
+	assert.NotEmpty(t, findings[0].RuleConfiguration.WarningModes)
+	assert.Empty(t, findings[0].RuleConfiguration.ErrorModes)
+	assert.Equal(t, "HR002", findings[1].RuleConfiguration.ID)
+	assert.NotEmpty(t, findings[1].RuleConfiguration.ErrorModes)
+	assert.Equal(t, []rulefunction.Finding{{Path: projectPath.Join("library.properties"), Line: 2}}, findings[1].Locations, "Location is relative to project")
+
+	t.Setenv(testPluginBehaviorEnvironmentVariable, "no findings")
+	findings, err = Run(context.Background(), plugin, projectData)
+	assert.Nil(t, err)
+	assert.Empty(t, findings)
+
+	for _, behavior := range []string{"exit status", "invalid output", "unknown rule", "invalid level", "empty location path"} {
+		t.Setenv(testPluginBehaviorEnvironmentVariable, behavior)
+		_, err = Run(context.Background(), plugin, projectData)
+		assert.Error(t, err, behavior)
+	}
+	t.Setenv(testPluginBehaviorEnvironmentVariable, "exit status")
+	_, err = Run(context.Background(), plugin, projectData)
+	assert.ErrorContains(t, err, "foo error", "Plugin stderr is included in error")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	t.Setenv(testPluginBehaviorEnvironmentVariable, "findings")
+	_, err = Run(ctx, plugin, projectData)
+	assert.ErrorIs(t, err, context.Canceled, "Plugin isn't run when context is canceled")
+
+	_, err = Run(context.Background(), configuration.Plugin{Name: "foo", Command: []string{projectPath.Join("nonexistent").String()}, Rules: []string{"HR001"}}, projectData)
+	assert.Error(t, err, "Executable not found")
+}
@@ -18,7 +18,14 @@
 package rule
 
 import (
+	"context"
 	"fmt"
+	"path"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
@@ -26,49 +33,486 @@ import (
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/result"
 	"github.com/arduino/arduino-lint/internal/result/feedback"
+	"github.com/arduino/arduino-lint/internal/rule/customrule"
+	"github.com/arduino/arduino-lint/internal/rule/plugin"
 	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulefunction"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
 	"github.com/sirupsen/logrus"
 )
 
-// Runner runs all rules for the given project and outputs the results.
-func Runner(project project.Type) {
-	feedback.Printf("Linting %s in %s\n", project.ProjectType, project.Path)
+// Result is the result of running a rule on a project.
+type Result struct {
+	Configuration ruleconfiguration.Type
+	Result        ruleresult.Type
+	Output        string
+	Findings      []rulefunction.Finding
+	Violation     bool          // The result is one of the violations of the rule, which are reported separately.
+	RuleOutput    string        // Output of the rule function for all of its violations, when the result is a violation.
+	Duration      time.Duration // Time taken by the rule function.
+	panicked      string        // The panic that occurred while running the rule, which is repeated when the result is recorded.
+}
 
-	projectdata.Initialize(project)
+// ProjectRun provides the results of running the rules on a project.
+type ProjectRun struct {
+	Results                <-chan Result        // The rule results. Closed after the last result of the project.
+	InitializationDuration <-chan time.Duration // Time taken to gather the project data used by the rules.
+	toolConfiguration      *configuration.Type  // The tool configuration the rules were run under.
+}
 
-	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
-		runRule, err := shouldRun(ruleConfiguration, project)
+// Runner starts running the rules on the given projects under the given tool configuration, with up to the configured number
+// of jobs running at the same time. The Library Manager index is shared by the projects.
+// The returned runs provide the results for each project, in the order of the projects.
+// The results of each project are in the order of the rule configurations, so the output is the same regardless of the number of jobs.
+// Once the context is canceled, the rules that have not started are not run, and running plugins are killed.
+func Runner(ctx context.Context, projects []project.Type, toolConfiguration *configuration.Type, libraryManagerIndex *projectdata.LibraryManagerIndexType) []ProjectRun {
+	jobs := make(chan struct{}, toolConfiguration.Jobs())         // Limits the number of rules or project initializations running at the same time.
+	projectSlots := make(chan struct{}, toolConfiguration.Jobs()) // Limits the number of projects in progress at the same time.
+
+	ruleConfigurations := lintRuleConfigurations(toolConfiguration)
+	ruleCount := len(allRuleConfigurations(toolConfiguration))
+	projectRuns := make([]ProjectRun, len(projects))
+	projectsResultsSenders := make([]chan Result, len(projects))
+	initializationDurationSenders := make([]chan time.Duration, len(projects))
+	for index := range projects {
+		// The buffers allow the project to get ahead of the consumption of its results. A rule can have a result for each of
+		// its violations, so a project with more results than the buffer holds blocks on sending until they are consumed.
+		// This can't deadlock, since the projects are started in order and their results are consumed in the same order.
+		projectsResultsSenders[index] = make(chan Result, ruleCount)
+		initializationDurationSenders[index] = make(chan time.Duration, 1)
+		projectRuns[index] = ProjectRun{
+			Results:                projectsResultsSenders[index],
+			InitializationDuration: initializationDurationSenders[index],
+			toolConfiguration:      toolConfiguration,
+		}
+	}
+
+	go func() {
+		// The projects are started in order so that the results of the first projects are available first.
+		for index, project := range projects {
+			projectSlots <- struct{}{}
+			go func() {
+				defer func() {
+					if recovered := recover(); recovered != nil {
+						// A panic in a goroutine can't be handled, so it is passed on to the goroutine that records the results.
+						projectsResultsSenders[index] <- Result{panicked: panicText(recovered)}
+					}
+					close(projectsResultsSenders[index])
+					<-projectSlots
+				}()
+				projectData := initializeProject(project, toolConfiguration, libraryManagerIndex, ruleConfigurations, jobs, initializationDurationSenders[index])
+				runProject(ctx, projectData, project, ruleConfigurations, jobs, projectsResultsSenders[index])
+			}()
+		}
+	}()
+
+	return projectRuns
+}
+
+// initializeProject gathers the data of the given project used by the given rules, and sends the time it took.
+func initializeProject(project project.Type, toolConfiguration *configuration.Type, libraryManagerIndex *projectdata.LibraryManagerIndexType, ruleConfigurations []ruleconfiguration.Type, jobs chan struct{}, initializationDuration chan<- time.Duration) *projectdata.Type {
+	jobs <- struct{}{}
+	defer func() { <-jobs }()
+
+	initializationStart := time.Now()
+	projectData := projectdata.Initialize(project, toolConfiguration, libraryManagerIndex)
+	for _, ruleConfiguration := range ruleConfigurations {
+		if !ruleConfiguration.UsesLibraryIndex {
+			continue
+		}
+		runRule, err := shouldRun(ruleConfiguration, project, toolConfiguration)
+		if err != nil {
+			panic(err)
+		}
+		if runRule {
+			// The index is loaded here so that its download time is not attributed to the first rule that uses it.
+			projectData.LoadLibraryManagerIndex()
+			break
+		}
+	}
+	initializationDuration <- time.Since(initializationStart)
+
+	return projectData
+}
+
+// runProject runs the given rules and the plugins on the given project and sends the results as soon as they, and the results of all previous
+// rules, are available.
+func runProject(ctx context.Context, projectData *projectdata.Type, project project.Type, ruleConfigurations []ruleconfiguration.Type, jobs chan struct{}, projectResults chan<- Result) {
+	toolConfiguration := projectData.ToolConfiguration()
+	plugins := toolConfiguration.Plugins()
+	results := make([][]Result, len(ruleConfigurations)+len(plugins))
+	resultsDone := make([]chan struct{}, len(results)) // Closed when the rule or plugin has finished or was skipped.
+	for index := range resultsDone {
+		resultsDone[index] = make(chan struct{})
+	}
+	ruleOrder, err := DependencyOrder(ruleConfigurations)
+	if err != nil {
+		panic(err)
+	}
+	ruleIndexes := make(map[string]int, len(ruleConfigurations))
+	for index, ruleConfiguration := range ruleConfigurations {
+		ruleIndexes[ruleConfiguration.ID] = index
+	}
+	ran := make([]bool, len(ruleConfigurations)) // Whether the rule is run, so that its results are reported.
+	// The rules which others depend on, but which were not run, are still checked, without reporting them, since the dependent
+	// rules rely on the data being valid.
+	dependencyChecks := make([]func() []Result, len(ruleConfigurations))
+	for index, ruleConfiguration := range ruleConfigurations {
+		dependencyChecks[index] = sync.OnceValue(func() []Result { return checkDependency(ruleConfiguration, projectData) })
+	}
+	// The rules are started in dependency order so that the rules which others depend on are the first to get a job.
+	for _, index := range ruleOrder {
+		ruleConfiguration := ruleConfigurations[index]
+		runRule, err := shouldRun(ruleConfiguration, project, toolConfiguration)
 		if err != nil {
 			panic(err)
 		}
 
 		if !runRule {
 			logrus.Infof("Skipping rule: %s\n", ruleConfiguration.ID)
+			if appliesTo(ruleConfiguration, project) && disabledByUser(ruleConfiguration, toolConfiguration) {
+				// Record the rule so that the user can see the effect of their configuration in the verbose report.
+				results[index] = []Result{{Configuration: ruleConfiguration, Result: ruleresult.Skip, Output: disabledByUserOutput}}
+			}
+			close(resultsDone[index])
 			continue
 		}
 
-		// Output will be printed after all rules are finished when configured for "json" output format.
-		feedback.VerbosePrintf("Running rule %s (%s)...\n", ruleConfiguration.ID, ruleConfiguration.Brief)
+		ran[index] = true
+		go func() {
+			defer close(resultsDone[index])
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					results[index] = []Result{{Configuration: ruleConfiguration, panicked: panicText(recovered)}}
+				}
+			}()
+			for _, dependencyID := range ruleConfiguration.DependsOn {
+				dependencyIndex := ruleIndexes[dependencyID]
+				<-resultsDone[dependencyIndex]
+				dependencyResults := results[dependencyIndex]
+				if !ran[dependencyIndex] {
+					dependencyResults = dependencyChecks[dependencyIndex]()
+				}
+				if blockingResult, blockingOutput, blocked := dependencyBlocks(ruleConfigurations[dependencyIndex], dependencyResults); blocked {
+					logrus.Infof("Not running rule %s: %s\n", ruleConfiguration.ID, blockingOutput)
+					results[index] = []Result{{Configuration: ruleConfiguration, Result: blockingResult, Output: blockingOutput}}
+					return
+				}
+			}
+
+			jobs <- struct{}{}
+			defer func() { <-jobs }()
+
+			if err := ctx.Err(); err != nil {
+				results[index] = []Result{{Configuration: ruleConfiguration, Result: ruleresult.NotRun, Output: err.Error()}}
+				return
+			}
+
+			ruleStart := time.Now()
+			ruleResult, ruleOutput, ruleFindings := ruleConfiguration.RuleFunction(projectData)
+			ruleDuration := time.Since(ruleStart)
+			results[index] = ruleResults(ruleConfiguration, projectData, ruleResult, ruleOutput, ruleFindings, ruleDuration)
+		}()
+	}
+
+	// The plugin rules follow the built-in rules.
+	for pluginIndex, pluginConfiguration := range plugins {
+		index := len(ruleConfigurations) + pluginIndex
+		go func() {
+			defer close(resultsDone[index])
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					results[index] = []Result{{panicked: panicText(recovered)}}
+				}
+			}()
+			jobs <- struct{}{}
+			defer func() { <-jobs }()
 
-		ruleResult, ruleOutput := ruleConfiguration.RuleFunction()
-		reportText := result.Results.Record(project, ruleConfiguration, ruleResult, ruleOutput)
-		feedback.Print(reportText)
+			results[index] = runPlugin(ctx, pluginConfiguration, project, projectData)
+		}()
+	}
+
+	for index := range results {
+		<-resultsDone[index]
+		for _, ruleResult := range results[index] { // Rules that were skipped have no result.
+			projectResults <- ruleResult
+		}
 	}
 }
 
-// shouldRun returns whether a given rule should be run for the given project under the current tool configuration.
-func shouldRun(ruleConfiguration ruleconfiguration.Type, currentProject project.Type) (bool, error) {
-	configurationRuleModes := configuration.RuleModes(currentProject.SuperprojectType)
+// ruleResults returns the results of the given rule function return values.
+// A failed rule has a separate result for each subject of its findings, so that each violation is reported, counted,
+// baselined, and suppressed individually. Findings without a subject share a result, which has the rule output.
+func ruleResults(ruleConfiguration ruleconfiguration.Type, projectData *projectdata.Type, ruleResult ruleresult.Type, ruleOutput string, ruleFindings []rulefunction.Finding, ruleDuration time.Duration) []Result {
+	if ruleResult != ruleresult.Fail {
+		return []Result{{Configuration: ruleConfiguration, Result: ruleResult, Output: ruleOutput, Findings: ruleFindings, Duration: ruleDuration}}
+	}
+
+	subjects := []string{}
+	subjectFindings := make(map[string][]rulefunction.Finding)
+	for _, ruleFinding := range ruleFindings {
+		if _, ok := subjectFindings[ruleFinding.Subject]; !ok {
+			subjects = append(subjects, ruleFinding.Subject)
+		}
+		subjectFindings[ruleFinding.Subject] = append(subjectFindings[ruleFinding.Subject], ruleFinding)
+	}
+	if len(subjects) == 0 {
+		subjects = append(subjects, "")
+	}
+	violations := len(subjects) > 1 || subjects[0] != ""
 
-	if !(ruleConfiguration.ProjectType.Matches(currentProject.ProjectType) && ruleConfiguration.SuperprojectType.Matches(currentProject.SuperprojectType)) {
+	results := []Result{}
+	for _, subject := range subjects {
+		output := rulefunction.SubjectOutput(ruleOutput, subjects, subject)
+		if subject == "" {
+			output = ruleOutput
+		}
+		result := ruleresult.Fail
+		if isSuppressed(projectData, ruleConfiguration.ID, subjectFindings[subject]) {
+			result = ruleresult.Suppressed
+		}
+		results = append(results, Result{
+			Configuration: ruleConfiguration,
+			Result:        result,
+			Output:        output,
+			Findings:      subjectFindings[subject],
+			Violation:     violations,
+			RuleOutput:    ruleOutput,
+			Duration:      ruleDuration,
+		})
+	}
+
+	return results
+}
+
+// dependencyBlocks returns whether the given results of the rule another rule depends on prevent the dependent rule from
+// running, and the result and explanation for the report when they do.
+// The dependent rule is skipped along with the dependency, since a dependency is skipped when the project doesn't have the
+// data it checks.
+func dependencyBlocks(dependencyConfiguration ruleconfiguration.Type, dependencyResults []Result) (ruleresult.Type, string, bool) {
+	for _, dependencyResult := range dependencyResults {
+		switch dependencyResult.Result {
+		case ruleresult.Fail, ruleresult.Suppressed:
+			return ruleresult.NotRun, fmt.Sprintf("Not run because rule %s (%s) failed.", dependencyConfiguration.ID, dependencyConfiguration.Brief), true
+		case ruleresult.NotRun:
+			return ruleresult.NotRun, fmt.Sprintf("Not run because rule %s (%s) was unable to run.", dependencyConfiguration.ID, dependencyConfiguration.Brief), true
+		case ruleresult.Skip:
+			return ruleresult.Skip, fmt.Sprintf("Skipped because rule %s (%s) was skipped: %s", dependencyConfiguration.ID, dependencyConfiguration.Brief, dependencyResult.Output), true
+		}
+	}
+
+	return ruleresult.Pass, "", false
+}
+
+// checkDependency runs the given rule, which another rule depends on, without reporting it and returns its result.
+func checkDependency(dependencyConfiguration ruleconfiguration.Type, projectData *projectdata.Type) []Result {
+	dependencyResult, dependencyOutput, _ := dependencyConfiguration.RuleFunction(projectData)
+	return []Result{{Configuration: dependencyConfiguration, Result: dependencyResult, Output: dependencyOutput}}
+}
+
+// DependencyOrder returns the indexes of the given rule configurations in an order where each rule follows the rules it
+// depends on. Rules without a dependency relationship keep their relative order.
+func DependencyOrder(ruleConfigurations []ruleconfiguration.Type) ([]int, error) {
+	ruleIndexes := make(map[string]int, len(ruleConfigurations))
+	for index, ruleConfiguration := range ruleConfigurations {
+		ruleIndexes[ruleConfiguration.ID] = index
+	}
+
+	dependencyCounts := make([]int, len(ruleConfigurations))
+	dependents := make([][]int, len(ruleConfigurations))
+	for index, ruleConfiguration := range ruleConfigurations {
+		for _, dependencyID := range ruleConfiguration.DependsOn {
+			dependencyIndex, ok := ruleIndexes[dependencyID]
+			if !ok {
+				return nil, fmt.Errorf("Rule %s depends on unknown rule %s", ruleConfiguration.ID, dependencyID)
+			}
+			dependencyCounts[index]++
+			dependents[dependencyIndex] = append(dependents[dependencyIndex], index)
+		}
+	}
+
+	order := make([]int, 0, len(ruleConfigurations))
+	done := make([]bool, len(ruleConfigurations))
+	// Each pass adds the first rule, in configuration order, whose dependencies are all in the order.
+	for len(order) < len(ruleConfigurations) {
+		next := -1
+		for index := range ruleConfigurations {
+			if !done[index] && dependencyCounts[index] == 0 {
+				next = index
+				break
+			}
+		}
+		if next == -1 {
+			remainingIDs := []string{}
+			for index, ruleConfiguration := range ruleConfigurations {
+				if !done[index] {
+					remainingIDs = append(remainingIDs, ruleConfiguration.ID)
+				}
+			}
+			return nil, fmt.Errorf("Dependency cycle among rules %s", strings.Join(remainingIDs, ", "))
+		}
+
+		order = append(order, next)
+		done[next] = true
+		for _, dependentIndex := range dependents[next] {
+			dependencyCounts[dependentIndex]--
+		}
+	}
+
+	return order, nil
+}
+
+// runPlugin runs the given plugin on the given project and returns the results of its rules.
+// A rule of the plugin passes if the plugin reported no violations of it.
+func runPlugin(ctx context.Context, pluginConfiguration configuration.Plugin, project project.Type, projectData *projectdata.Type) []Result {
+	toolConfiguration := projectData.ToolConfiguration()
+	ruleConfigurations := plugin.Configurations(pluginConfiguration)
+	anyRuleEnabled := false
+	for _, ruleConfiguration := range ruleConfigurations {
+		runRule, err := shouldRun(ruleConfiguration, project, toolConfiguration)
+		if err != nil {
+			panic(err)
+		}
+		anyRuleEnabled = anyRuleEnabled || runRule
+	}
+
+	var findings []plugin.Finding
+	var pluginErr error
+	var pluginDuration time.Duration
+	if anyRuleEnabled {
+		pluginStart := time.Now()
+		findings, pluginErr = plugin.Run(ctx, pluginConfiguration, projectData)
+		pluginDuration = time.Since(pluginStart)
+		if pluginErr != nil {
+			// The error is reported via the results of the plugin's rules.
+			pluginErr = fmt.Errorf("Unable to run plugin %s: %v", pluginConfiguration.Name, pluginErr)
+			logrus.Errorf("%v on %s", pluginErr, project.Path)
+		}
+	}
+
+	var results []Result
+	for _, ruleConfiguration := range ruleConfigurations {
+		if disabledByUser(ruleConfiguration, toolConfiguration) {
+			logrus.Infof("Skipping rule: %s\n", ruleConfiguration.ID)
+			results = append(results, Result{Configuration: ruleConfiguration, Result: ruleresult.Skip, Output: disabledByUserOutput})
+			continue
+		}
+
+		if pluginErr != nil {
+			results = append(results, Result{Configuration: ruleConfiguration, Result: ruleresult.NotRun, Output: pluginErr.Error()})
+			continue
+		}
+
+		ruleResults := []Result{}
+		for _, finding := range findings {
+			if finding.RuleConfiguration.ID != ruleConfiguration.ID {
+				continue
+			}
+
+			ruleResult := ruleresult.Fail
+			if isSuppressed(projectData, ruleConfiguration.ID, finding.Locations) {
+				ruleResult = ruleresult.Suppressed
+			}
+			ruleResults = append(ruleResults, Result{
+				Configuration: finding.RuleConfiguration,
+				Result:        ruleResult,
+				Output:        finding.Message,
+				Findings:      finding.Locations,
+				Duration:      pluginDuration,
+			})
+		}
+		if len(ruleResults) == 0 {
+			ruleResults = append(ruleResults, Result{Configuration: ruleConfiguration, Result: ruleresult.Pass, Duration: pluginDuration})
+		}
+		results = append(results, ruleResults...)
+	}
+
+	return results
+}
+
+// Record records the results of the rules on the given project in the given results and outputs them as they are received.
+func Record(results *result.Type, project project.Type, projectRun ProjectRun) {
+	toolConfiguration := projectRun.toolConfiguration
+	feedback.Printf(toolConfiguration, "Linting %s in %s\n", project.ProjectType, project.Path)
+	feedback.Stream(toolConfiguration, results.JSONLProjectStart(project))
+
+	previousRuleID := ""
+	for ruleResult := range projectRun.Results {
+		if ruleResult.panicked != "" {
+			panic(ruleResult.panicked)
+		}
+
+		feedback.VerbosePrintf(toolConfiguration, "Running rule %s (%s)...\n", ruleResult.Configuration.ID, ruleResult.Configuration.Brief)
+
+		var reportText string
+		if ruleResult.Violation {
+			reportText = results.RecordViolation(project, ruleResult.Configuration, ruleResult.RuleOutput, ruleResult.Result, ruleResult.Output, ruleResult.Findings)
+		} else {
+			reportText = results.Record(project, ruleResult.Configuration, ruleResult.Result, ruleResult.Output, ruleResult.Findings)
+		}
+		feedback.Print(toolConfiguration, reportText)
+		feedback.Stream(toolConfiguration, results.JSONLRuleResult(project))
+		// The results of the individual violations of a rule share the duration of the rule.
+		if toolConfiguration.Timings() && ruleResult.Configuration.ID != previousRuleID {
+			results.RecordRuleDuration(project, ruleResult.Configuration, ruleResult.Duration)
+		}
+		previousRuleID = ruleResult.Configuration.ID
+	}
+
+	if toolConfiguration.Timings() {
+		results.RecordInitializationDuration(project, <-projectRun.InitializationDuration)
+	}
+}
+
+// panicText returns the text of the given recovered panic, with the stack trace of the goroutine where it occurred.
+func panicText(recovered interface{}) string {
+	return fmt.Sprintf("%v\n%s", recovered, debug.Stack())
+}
+
+// isSuppressed returns whether every location of the rule violation is covered by a suppression comment in the project files.
+func isSuppressed(projectData *projectdata.Type, ruleID string, ruleFindings []rulefunction.Finding) bool {
+	if len(ruleFindings) == 0 {
+		return false
+	}
+
+	for _, ruleFinding := range ruleFindings {
+		if ruleFinding.Path == nil || !projectData.PropertiesSuppressions(ruleFinding.Path).Suppressed(ruleID, ruleFinding.Line) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// disabledByUserOutput is the output of the rules that were not run due to the user's rule selection.
+const disabledByUserOutput = "skipped by user configuration"
+
+// shouldRun returns whether a given rule should be run for the given project under the given tool configuration.
+func shouldRun(ruleConfiguration ruleconfiguration.Type, currentProject project.Type, toolConfiguration *configuration.Type) (bool, error) {
+	configurationRuleModes := toolConfiguration.RuleModes(currentProject.SuperprojectType)
+
+	if !appliesTo(ruleConfiguration, currentProject) {
 		return false, nil
 	}
 
-	return IsEnabled(ruleConfiguration, configurationRuleModes)
+	return IsEnabled(ruleConfiguration, configurationRuleModes, toolConfiguration)
 }
 
-// IsEnabled returns whether a given rule is enabled under a given tool configuration.
-func IsEnabled(ruleConfiguration ruleconfiguration.Type, configurationRuleModes map[rulemode.Type]bool) (bool, error) {
+// appliesTo returns whether the given rule is applicable to the type of the given project.
+func appliesTo(ruleConfiguration ruleconfiguration.Type, currentProject project.Type) bool {
+	return ruleConfiguration.ProjectType.Matches(currentProject.ProjectType) && ruleConfiguration.SuperprojectType.Matches(currentProject.SuperprojectType)
+}
+
+// IsEnabled returns whether a given rule is enabled for the given rule modes under a given tool configuration.
+func IsEnabled(ruleConfiguration ruleconfiguration.Type, configurationRuleModes map[rulemode.Type]bool, toolConfiguration *configuration.Type) (bool, error) {
+	// The user's rule selection takes precedence over the rule modes.
+	if disabledByUser(ruleConfiguration, toolConfiguration) {
+		return false, nil
+	}
+	if MatchesAnySelector(ruleConfiguration, toolConfiguration.EnableRules()) {
+		return true, nil
+	}
+
 	for _, disableMode := range ruleConfiguration.DisableModes {
 		if configurationRuleModes[disableMode] {
 			return false, nil
@@ -96,3 +540,121 @@ func IsEnabled(ruleConfiguration ruleconfiguration.Type, configurationRuleModes
 
 	return false, fmt.Errorf("Rule %s is incorrectly configured", ruleConfiguration.ID)
 }
+
+// ValidateRuleSettings checks that the rule settings of the configuration file of the given tool configuration are for
+// existing rules, and that the rule selection flags match existing rules.
+func ValidateRuleSettings(toolConfiguration *configuration.Type) error {
+	ruleIDs := make(map[string]bool)
+	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
+		ruleIDs[ruleConfiguration.ID] = true
+	}
+	customRuleConfigurations, err := customrule.Configurations(toolConfiguration.CustomRules(), toolConfiguration.ConfigurationFilePath())
+	if err != nil {
+		return err
+	}
+	for _, ruleConfiguration := range customRuleConfigurations {
+		if ruleIDs[ruleConfiguration.ID] {
+			return fmt.Errorf("Configuration file %s custom rule ID %s is already in use", toolConfiguration.ConfigurationFilePath(), ruleConfiguration.ID)
+		}
+		ruleIDs[ruleConfiguration.ID] = true
+	}
+	for _, pluginConfiguration := range toolConfiguration.Plugins() {
+		for _, ruleConfiguration := range plugin.Configurations(pluginConfiguration) {
+			if ruleIDs[ruleConfiguration.ID] {
+				return fmt.Errorf("Configuration file %s plugin %s rule ID %s is already in use", toolConfiguration.PluginsConfigurationFilePath(), pluginConfiguration.Name, ruleConfiguration.ID)
+			}
+			ruleIDs[ruleConfiguration.ID] = true
+		}
+	}
+
+	for ruleID := range toolConfiguration.RuleSettings() {
+		if !ruleIDs[ruleID] {
+			return fmt.Errorf("Configuration file %s contains setting for unknown rule %s", toolConfiguration.ConfigurationFilePath(), ruleID)
+		}
+	}
+
+	for _, ruleSelection := range []struct {
+		flagName      string
+		ruleSelectors []string
+	}{
+		{"enable-rules", toolConfiguration.EnableRules()},
+		{"disable-rules", toolConfiguration.DisableRules()},
+		{"only", toolConfiguration.OnlyRules()},
+	} {
+		for _, ruleSelector := range ruleSelection.ruleSelectors {
+			if !matchesAnyRule(ruleSelector, toolConfiguration) {
+				// A selector that doesn't match is most likely a typo, which would otherwise silently change the rule selection.
+				return fmt.Errorf("--%s flag value %s doesn't match any rule", ruleSelection.flagName, ruleSelector)
+			}
+		}
+	}
+
+	return nil
+}
+
+// matchesAnyRule returns whether the given rule selector matches any rule of the given tool configuration.
+func matchesAnyRule(ruleSelector string, toolConfiguration *configuration.Type) bool {
+	for _, ruleConfiguration := range allRuleConfigurations(toolConfiguration) {
+		if MatchesAnySelector(ruleConfiguration, []string{ruleSelector}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lintRuleConfigurations returns the configurations of the built-in rules and the custom rules of the given tool configuration.
+func lintRuleConfigurations(toolConfiguration *configuration.Type) []ruleconfiguration.Type {
+	customRuleConfigurations, err := customrule.Configurations(toolConfiguration.CustomRules(), toolConfiguration.ConfigurationFilePath())
+	if err != nil {
+		// The custom rules have already been validated.
+		panic(err)
+	}
+
+	return slices.Concat(ruleconfiguration.Configurations(), customRuleConfigurations)
+}
+
+// allRuleConfigurations returns the configurations of the built-in rules, the custom rules, and the plugin rules of the given
+// tool configuration.
+func allRuleConfigurations(toolConfiguration *configuration.Type) []ruleconfiguration.Type {
+	ruleConfigurations := lintRuleConfigurations(toolConfiguration)
+	for _, pluginConfiguration := range toolConfiguration.Plugins() {
+		ruleConfigurations = append(ruleConfigurations, plugin.Configurations(pluginConfiguration)...)
+	}
+
+	return ruleConfigurations
+}
+
+// disabledByUser returns whether the given rule is disabled by the --disable-rules, --only, or configuration file settings of
+// the given tool configuration.
+func disabledByUser(ruleConfiguration ruleconfiguration.Type, toolConfiguration *configuration.Type) bool {
+	if MatchesAnySelector(ruleConfiguration, toolConfiguration.DisableRules()) {
+		return true
+	}
+
+	if len(toolConfiguration.OnlyRules()) > 0 && !MatchesAnySelector(ruleConfiguration, toolConfiguration.OnlyRules()) {
+		return true
+	}
+
+	if ruleSetting, ok := toolConfiguration.RuleSetting(ruleConfiguration.ID); ok && ruleSetting == configuration.RuleSettingOff {
+		// The flags take precedence over the configuration file setting.
+		return !MatchesAnySelector(ruleConfiguration, toolConfiguration.EnableRules())
+	}
+
+	return false
+}
+
+// MatchesAnySelector returns whether the given rule matches any of the given rule selectors.
+// A rule selector is a rule ID glob pattern, or the name of a rule category or subcategory. Matching is case insensitive.
+func MatchesAnySelector(ruleConfiguration ruleconfiguration.Type, ruleSelectors []string) bool {
+	for _, ruleSelector := range ruleSelectors {
+		ruleSelector = strings.ToLower(strings.TrimSpace(ruleSelector))
+		for _, ruleField := range []string{ruleConfiguration.ID, ruleConfiguration.Category, ruleConfiguration.Subcategory} {
+			if match, _ := path.Match(ruleSelector, strings.ToLower(ruleField)); match {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -17,15 +17,24 @@
 package rule
 
 import (
+	"context"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
 	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/arduino-lint/internal/result"
 	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
+	"github.com/arduino/arduino-lint/internal/rule/rulefunction"
+	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
 	"github.com/arduino/arduino-lint/internal/util/test"
+	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_shouldRun(t *testing.T) {
@@ -59,7 +68,8 @@ func Test_shouldRun(t *testing.T) {
 		flags.Set("library-manager", testTable.libraryManagerSetting)
 		flags.Set("compliance", testTable.complianceSetting)
 
-		configuration.Initialize(flags, []string{"/foo"})
+		toolConfiguration, err := configuration.Initialize(flags, []string{})
+		require.Nil(t, err, testTable.testName)
 
 		ruleConfiguration := ruleconfiguration.Type{
 			ProjectType:      testTable.ruleProjectType,
@@ -72,10 +82,523 @@ func Test_shouldRun(t *testing.T) {
 			ProjectType:      testTable.projectType,
 			SuperprojectType: testTable.superprojectType,
 		}
-		run, err := shouldRun(ruleConfiguration, project)
+		run, err := shouldRun(ruleConfiguration, project, toolConfiguration)
 		testTable.errorAssertion(t, err, testTable.testName)
 		if err == nil {
 			testTable.shouldRunAssertion(t, run, testTable.testName)
 		}
 	}
 }
+
+func TestIsEnabledRuleSetting(t *testing.T) {
+	projectPath, err := paths.MkTempDir("", "arduino-lint-rule-test")
+	require.Nil(t, err)
+	defer projectPath.RemoveAll()
+	require.Nil(t, projectPath.Join(".arduino-lint.yml").WriteFile([]byte("rules:\n  XX001: off\n  XX002: error\n")))
+
+	toolConfiguration, err := configuration.Initialize(test.ConfigurationFlags(), []string{projectPath.String()})
+	require.Nil(t, err)
+
+	configurationRuleModes := map[rulemode.Type]bool{rulemode.Specification: true}
+	for _, ruleID := range []string{"XX001", "XX002", "XX003"} {
+		ruleConfiguration := ruleconfiguration.Type{
+			ID:          ruleID,
+			EnableModes: []rulemode.Type{rulemode.Default},
+		}
+		enabled, err := IsEnabled(ruleConfiguration, configurationRuleModes, toolConfiguration)
+		require.Nil(t, err)
+		assert.Equal(t, ruleID != "XX001", enabled, ruleID)
+	}
+}
+
+func TestIsEnabledRuleSelection(t *testing.T) {
+	projectPath, err := paths.MkTempDir("", "arduino-lint-rule-test")
+	require.Nil(t, err)
+	defer projectPath.RemoveAll()
+	require.Nil(t, projectPath.Join(".arduino-lint.yml").WriteFile([]byte("rules:\n  XX002: off\n")))
+
+	testTables := []struct {
+		testName        string
+		enableRules     string
+		disableRules    string
+		only            string
+		enabledRuleIDs  []string
+		disabledRuleIDs []string
+	}{
+		{"Default", "", "", "", []string{"XX001", "XX003"}, []string{"XX002", "YY001"}},
+		{"Enable", "XX002,yy*", "", "", []string{"XX001", "XX002", "XX003", "YY001"}, []string{}},
+		{"Disable", "", "XX00[13]", "", []string{}, []string{"XX001", "XX002", "XX003", "YY001"}},
+		{"Disable category", "", "foo", "", []string{"XX003"}, []string{"XX001", "XX002", "YY001"}},
+		{"Only subcategory", "", "", "Bar", []string{"XX001"}, []string{"XX002", "XX003", "YY001"}},
+		{"Disable takes precedence", "XX001", "XX001", "", []string{"XX003"}, []string{"XX001", "XX002", "YY001"}},
+		{"Only takes precedence", "YY001", "", "XX00?", []string{"XX001", "XX003"}, []string{"XX002", "YY001"}},
+	}
+
+	configurationRuleModes := map[rulemode.Type]bool{rulemode.Specification: true}
+	for _, testTable := range testTables {
+		flags := test.ConfigurationFlags()
+		flags.Set("enable-rules", testTable.enableRules)
+		flags.Set("disable-rules", testTable.disableRules)
+		flags.Set("only", testTable.only)
+		toolConfiguration, err := configuration.Initialize(flags, []string{projectPath.String()})
+		require.Nil(t, err)
+
+		for _, ruleConfiguration := range []ruleconfiguration.Type{
+			{ID: "XX001", Category: "foo", Subcategory: "bar", EnableModes: []rulemode.Type{rulemode.Default}},
+			{ID: "XX002", Category: "foo", Subcategory: "baz", EnableModes: []rulemode.Type{rulemode.Default}},
+			{ID: "XX003", Category: "qux", Subcategory: "baz", EnableModes: []rulemode.Type{rulemode.Default}},
+			{ID: "YY001", Category: "qux", Subcategory: "baz", DisableModes: []rulemode.Type{rulemode.Default}},
+		} {
+			enabled, err := IsEnabled(ruleConfiguration, configurationRuleModes, toolConfiguration)
+			require.Nil(t, err)
+			if enabled {
+				assert.Contains(t, testTable.enabledRuleIDs, ruleConfiguration.ID, testTable.testName)
+			} else {
+				assert.Contains(t, testTable.disabledRuleIDs, ruleConfiguration.ID, testTable.testName)
+			}
+		}
+	}
+}
+
+func TestValidateRuleSettings(t *testing.T) {
+	testTables := []struct {
+		flagName       string
+		flagValue      string
+		errorAssertion assert.ErrorAssertionFunc
+	}{
+		{"only", "", assert.NoError},
+		{"only", "LP0*,boards.txt", assert.NoError},
+		{"only", "Library.Properties", assert.NoError},
+		{"enable-rules", "XX*", assert.Error},
+		{"disable-rules", "PF012,foo", assert.Error},
+		{"only", "foo", assert.Error},
+	}
+
+	for _, testTable := range testTables {
+		flags := test.ConfigurationFlags()
+		flags.Set(testTable.flagName, testTable.flagValue)
+		toolConfiguration, err := configuration.Initialize(flags, []string{})
+		require.Nil(t, err)
+		testTable.errorAssertion(t, ValidateRuleSettings(toolConfiguration), testTable.flagName+" "+testTable.flagValue)
+	}
+}
+
+func TestValidateRuleSettingsPlugins(t *testing.T) {
+	projectPath, err := paths.MkTempDir("", "arduino-lint-rule-TestValidateRuleSettingsPlugins")
+	require.Nil(t, err)
+	defer projectPath.RemoveAll()
+	configurationFilePath := projectPath.Join(".arduino-lint.yml")
+
+	require.Nil(t, configurationFilePath.WriteFile([]byte("plugins:\n  - name: house-rules\n    command: [foo]\n    rules: [HR001]\nrules:\n  HR001: warning\n")))
+	flags := test.ConfigurationFlags()
+	flags.Set("plugins-config", configurationFilePath.String())
+	flags.Set("only", "house-rules")
+	toolConfiguration, err := configuration.Initialize(flags, []string{projectPath.String()})
+	require.Nil(t, err)
+	assert.Nil(t, ValidateRuleSettings(toolConfiguration), "Plugin rules can be configured and selected")
+
+	require.Nil(t, configurationFilePath.WriteFile([]byte("plugins:\n  - name: house-rules\n    command: [foo]\n    rules: [LP012]\n")))
+	flags = test.ConfigurationFlags()
+	flags.Set("plugins-config", configurationFilePath.String())
+	toolConfiguration, err = configuration.Initialize(flags, []string{projectPath.String()})
+	require.Nil(t, err)
+	assert.Error(t, ValidateRuleSettings(toolConfiguration), "Plugin rule ID conflicts with built-in rule")
+}
+
+func TestValidateRuleSettingsCustomRules(t *testing.T) {
+	projectPath, err := paths.MkTempDir("", "arduino-lint-rule-TestValidateRuleSettingsCustomRules")
+	require.Nil(t, err)
+	defer projectPath.RemoveAll()
+	configurationFilePath := projectPath.Join(".arduino-lint.yml")
+
+	testTables := []struct {
+		testName       string
+		customRule     string
+		errorAssertion assert.ErrorAssertionFunc
+	}{
+		{"Valid", "id: CR001\n    regex: ^Foo", assert.NoError},
+		{"Built-in rule ID", "id: LP012\n    regex: ^Foo", assert.Error},
+		{"Invalid regex", "id: CR001\n    regex: (", assert.Error},
+		{"Invalid schema", "id: CR001\n    schema:\n      type: 42", assert.Error},
+	}
+
+	for _, testTable := range testTables {
+		require.Nil(t, configurationFilePath.WriteFile([]byte("custom-rules:\n  - file: library.properties\n    key: name\n    level: error\n    message: foo\n    "+testTable.customRule+"\n")))
+		flags := test.ConfigurationFlags()
+		flags.Set("only", "custom")
+		toolConfiguration, err := configuration.Initialize(flags, []string{projectPath.String()})
+		require.Nil(t, err, testTable.testName)
+		testTable.errorAssertion(t, ValidateRuleSettings(toolConfiguration), testTable.testName)
+	}
+}
+
+func TestMatchesAnySelector(t *testing.T) {
+	ruleConfiguration := ruleconfiguration.Type{ID: "PF012", Category: "configuration files", Subcategory: "boards.txt"}
+
+	testTables := []struct {
+		ruleSelectors  []string
+		matchAssertion assert.BoolAssertionFunc
+	}{
+		{[]string{}, assert.False},
+		{[]string{"PF012"}, assert.True},
+		{[]string{"pf012"}, assert.True},
+		{[]string{"PF01?"}, assert.True},
+		{[]string{"P*"}, assert.True},
+		{[]string{"PF02?"}, assert.False},
+		{[]string{"PF0"}, assert.False},
+		{[]string{"Configuration Files"}, assert.True},
+		{[]string{"boards.txt"}, assert.True},
+		{[]string{"platform.txt"}, assert.False},
+		{[]string{"LP*", "boards.txt"}, assert.True},
+	}
+
+	for _, testTable := range testTables {
+		testTable.matchAssertion(t, MatchesAnySelector(ruleConfiguration, testTable.ruleSelectors), testTable.ruleSelectors)
+	}
+}
+
+func Test_isSuppressed(t *testing.T) {
+	platformPath, err := paths.MkTempDir("", "arduino-lint-rule-test")
+	require.Nil(t, err)
+	defer platformPath.RemoveAll()
+	boardsTxtPath := platformPath.Join("boards.txt")
+	require.Nil(t, boardsTxtPath.WriteFile([]byte("# arduino-lint-disable XX001\nuno.name=Uno\n# arduino-lint-disable-next-line XX002\nuno.build.board=\n")))
+	platformTxtPath := platformPath.Join("platform.txt")
+	require.Nil(t, platformTxtPath.WriteFile([]byte("name=Foo\n")))
+
+	projectData := projectdata.Initialize(
+		project.Type{
+			Path:             platformPath,
+			ProjectType:      projecttype.Platform,
+			SuperprojectType: projecttype.Platform,
+		},
+		nil,
+		nil,
+	)
+
+	testTables := []struct {
+		testName            string
+		ruleID              string
+		ruleFindings        []rulefunction.Finding
+		suppressedAssertion assert.BoolAssertionFunc
+	}{
+		{"No findings", "XX001", nil, assert.False},
+		{"File suppression", "XX001", []rulefunction.Finding{{Path: boardsTxtPath}, {Path: boardsTxtPath, Line: 4}}, assert.True},
+		{"Line suppression", "XX002", []rulefunction.Finding{{Path: boardsTxtPath, Line: 4}}, assert.True},
+		{"Line suppression wrong line", "XX002", []rulefunction.Finding{{Path: boardsTxtPath, Line: 2}}, assert.False},
+		{"Partial suppression", "XX002", []rulefunction.Finding{{Path: boardsTxtPath, Line: 4}, {Path: boardsTxtPath, Line: 2}}, assert.False},
+		{"Other file", "XX001", []rulefunction.Finding{{Path: platformTxtPath, Line: 1}}, assert.False},
+	}
+
+	for _, testTable := range testTables {
+		testTable.suppressedAssertion(t, isSuppressed(projectData, testTable.ruleID, testTable.ruleFindings), testTable.testName)
+	}
+}
+
+func Test_ruleResults(t *testing.T) {
+	platformPath, err := paths.MkTempDir("", "arduino-lint-rule-test")
+	require.Nil(t, err)
+	defer platformPath.RemoveAll()
+	boardsTxtPath := platformPath.Join("boards.txt")
+	require.Nil(t, boardsTxtPath.WriteFile([]byte("uno.name=Uno\n# arduino-lint-disable-next-line XX001\nmega.name=Mega\n")))
+
+	projectData := projectdata.Initialize(
+		project.Type{
+			Path:             platformPath,
+			ProjectType:      projecttype.Platform,
+			SuperprojectType: projecttype.Platform,
+		},
+		nil,
+		nil,
+	)
+	ruleConfiguration := ruleconfiguration.Type{ID: "XX001"}
+
+	type comparableResult struct {
+		result   ruleresult.Type
+		output   string
+		findings []rulefunction.Finding
+	}
+	testTables := []struct {
+		testName        string
+		ruleResult      ruleresult.Type
+		ruleOutput      string
+		ruleFindings    []rulefunction.Finding
+		expectedResults []comparableResult
+	}{
+		{"Pass", ruleresult.Pass, "", nil, []comparableResult{{ruleresult.Pass, "", nil}}},
+		{"No findings", ruleresult.Fail, "uno, mega", nil, []comparableResult{{ruleresult.Fail, "uno, mega", nil}}},
+		{
+			"No subjects",
+			ruleresult.Fail,
+			"uno, mega",
+			[]rulefunction.Finding{{Path: boardsTxtPath, Line: 1}, {Path: boardsTxtPath, Line: 3}},
+			[]comparableResult{{ruleresult.Fail, "uno, mega", []rulefunction.Finding{{Path: boardsTxtPath, Line: 1}, {Path: boardsTxtPath, Line: 3}}}},
+		},
+		{
+			"Subjects",
+			ruleresult.Fail,
+			"uno, mega",
+			[]rulefunction.Finding{{Path: boardsTxtPath, Line: 1, Subject: "uno"}, {Path: boardsTxtPath, Line: 3, Subject: "mega"}, {Path: boardsTxtPath, Line: 4, Subject: "uno"}},
+			[]comparableResult{
+				{ruleresult.Fail, "uno", []rulefunction.Finding{{Path: boardsTxtPath, Line: 1, Subject: "uno"}, {Path: boardsTxtPath, Line: 4, Subject: "uno"}}},
+				{ruleresult.Suppressed, "mega", []rulefunction.Finding{{Path: boardsTxtPath, Line: 3, Subject: "mega"}}},
+			},
+		},
+		{
+			"List output",
+			ruleresult.Fail,
+			"  uno\n  mega",
+			[]rulefunction.Finding{{Path: boardsTxtPath, Subject: "uno"}, {Path: boardsTxtPath, Subject: "mega"}},
+			[]comparableResult{
+				{ruleresult.Fail, "  uno", []rulefunction.Finding{{Path: boardsTxtPath, Subject: "uno"}}},
+				{ruleresult.Fail, "  mega", []rulefunction.Finding{{Path: boardsTxtPath, Subject: "mega"}}},
+			},
+		},
+	}
+
+	for _, testTable := range testTables {
+		results := []comparableResult{}
+		for _, result := range ruleResults(ruleConfiguration, projectData, testTable.ruleResult, testTable.ruleOutput, testTable.ruleFindings, time.Second) {
+			assert.Equal(t, ruleConfiguration.ID, result.Configuration.ID, testTable.testName)
+			assert.Equal(t, time.Second, result.Duration, testTable.testName)
+			results = append(results, comparableResult{result.Result, result.Output, result.Findings})
+		}
+		assert.Equal(t, testTable.expectedResults, results, testTable.testName)
+	}
+}
+
+func TestRunner(t *testing.T) {
+	projectsPath, err := paths.MkTempDir("", "arduino-lint-rule-TestRunner")
+	require.Nil(t, err)
+	defer projectsPath.RemoveAll()
+
+	projects := []project.Type{}
+	for _, sketchName := range []string{"Foo", "Bar", "Baz"} {
+		sketchPath := projectsPath.Join(sketchName)
+		require.Nil(t, sketchPath.Mkdir())
+		require.Nil(t, sketchPath.Join(sketchName+".ino").WriteFile([]byte("#include <arduino.h>\nvoid setup() {}\nvoid loop() {}\n")))
+		projects = append(projects, project.Type{Path: sketchPath, ProjectType: projecttype.Sketch, SuperprojectType: projecttype.Sketch})
+	}
+	platformPath := projectsPath.Join("Platform")
+	require.Nil(t, platformPath.Mkdir())
+	require.Nil(t, platformPath.Join("boards.txt").WriteFile([]byte("uno.name=Uno\nuno.build.board=AVR_UNO\n")))
+	require.Nil(t, platformPath.Join("platform.txt").WriteFile([]byte("name=Foo\n")))
+	projects = append(projects, project.Type{Path: platformPath, ProjectType: projecttype.Platform, SuperprojectType: projecttype.Platform})
+
+	// The rule configurations contain functions, which can't be compared, so only the rule IDs are used.
+	type comparableResult struct {
+		ruleID   string
+		result   ruleresult.Type
+		output   string
+		findings []rulefunction.Finding
+	}
+	projectsResults := func(jobs string) [][]comparableResult {
+		flags := test.ConfigurationFlags()
+		flags.Set("jobs", jobs)
+		toolConfiguration, err := configuration.Initialize(flags, []string{projectsPath.String()})
+		require.Nil(t, err)
+
+		var libraryManagerIndex projectdata.LibraryManagerIndexType
+		projectsResults := [][]comparableResult{}
+		for _, projectRun := range Runner(context.Background(), projects, toolConfiguration, &libraryManagerIndex) {
+			projectResults := []comparableResult{}
+			assert.Positive(t, <-projectRun.InitializationDuration)
+			for ruleResult := range projectRun.Results {
+				projectResults = append(projectResults, comparableResult{ruleResult.Configuration.ID, ruleResult.Result, ruleResult.Output, ruleResult.Findings})
+			}
+			projectsResults = append(projectsResults, projectResults)
+		}
+		return projectsResults
+	}
+
+	sequentialResults := projectsResults("1")
+	require.Len(t, sequentialResults, len(projects))
+	for index, projectResults := range sequentialResults {
+		assert.NotEmpty(t, projectResults, projects[index].Path.String())
+	}
+	assert.Equal(t, sequentialResults, projectsResults("4"), "Results don't depend on the number of jobs")
+
+	flags := test.ConfigurationFlags()
+	flags.Set("only", "boards.txt")
+	toolConfiguration, err := configuration.Initialize(flags, []string{projectsPath.String()})
+	require.Nil(t, err)
+	platformRun := Runner(context.Background(), projects[len(projects)-1:], toolConfiguration, &projectdata.LibraryManagerIndexType{})[0]
+	runCount := 0
+	for ruleResult := range platformRun.Results {
+		assert.True(t, ruleResult.Configuration.ProjectType.Matches(projecttype.Platform), "Only rules that apply to the project are reported")
+		if ruleResult.Configuration.Subcategory == "boards.txt" {
+			assert.NotEqual(t, disabledByUserOutput, ruleResult.Output, ruleResult.Configuration.ID)
+			runCount++
+		} else {
+			assert.Equal(t, ruleresult.Skip, ruleResult.Result, ruleResult.Configuration.ID)
+			assert.Equal(t, disabledByUserOutput, ruleResult.Output, ruleResult.Configuration.ID)
+		}
+	}
+	assert.Positive(t, runCount)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	canceledRun := Runner(ctx, projects[:1], toolConfiguration, &projectdata.LibraryManagerIndexType{})[0]
+	for ruleResult := range canceledRun.Results {
+		if ruleResult.Result != ruleresult.Skip {
+			assert.Equal(t, ruleresult.NotRun, ruleResult.Result, "Rules are not run when context is canceled")
+			assert.Equal(t, context.Canceled.Error(), ruleResult.Output)
+		}
+	}
+}
+
+func TestRunnerDependencies(t *testing.T) {
+	libraryPath, err := paths.MkTempDir("", "arduino-lint-rule-TestRunnerDependencies")
+	require.Nil(t, err)
+	defer libraryPath.RemoveAll()
+	require.Nil(t, libraryPath.Join("Foo.h").WriteFile([]byte("")))
+	require.Nil(t, libraryPath.Join("library.properties").WriteFile([]byte("name=Foo\nnot a property\n")))
+	lintedProject := project.Type{Path: libraryPath, ProjectType: projecttype.Library, SuperprojectType: projecttype.Library}
+
+	flags := test.ConfigurationFlags()
+	flags.Set("offline", "true") // The Library Manager index is not needed.
+	runDependencies := func() map[string]Result {
+		toolConfiguration, err := configuration.Initialize(flags, []string{libraryPath.String()})
+		require.Nil(t, err)
+		ruleResults := make(map[string]Result)
+		for ruleResult := range Runner(context.Background(), []project.Type{lintedProject}, toolConfiguration, &projectdata.LibraryManagerIndexType{})[0].Results {
+			ruleResults[ruleResult.Configuration.ID] = ruleResult
+		}
+		return ruleResults
+	}
+	assertDependents := func(ruleResults map[string]Result, expectedRuleResult ruleresult.Type, message string) {
+		dependentCount := 0
+		for _, ruleResult := range ruleResults {
+			if !slices.Contains(ruleResult.Configuration.DependsOn, "LP005") {
+				continue
+			}
+			dependentCount++
+			assert.Equal(t, expectedRuleResult, ruleResult.Result, "%s: %s", message, ruleResult.Configuration.ID)
+			assert.Contains(t, ruleResult.Output, "LP005", "Report names the blocking rule")
+		}
+		assert.Positive(t, dependentCount)
+	}
+
+	ruleResults := runDependencies()
+	require.Equal(t, ruleresult.Fail, ruleResults["LP005"].Result, "Invalid library.properties")
+	assertDependents(ruleResults, ruleresult.NotRun, "Dependency failed")
+
+	flags.Set("disable-rules", "LP005")
+	ruleResults = runDependencies()
+	require.Equal(t, ruleresult.Skip, ruleResults["LP005"].Result)
+	assertDependents(ruleResults, ruleresult.NotRun, "Disabled dependency failed")
+
+	flags = test.ConfigurationFlags()
+	flags.Set("offline", "true")
+	require.Nil(t, libraryPath.Join("library.properties").Remove())
+	ruleResults = runDependencies()
+	require.Equal(t, ruleresult.Skip, ruleResults["LP005"].Result, "Legacy library")
+	require.NotEqual(t, disabledByUserOutput, ruleResults["LP005"].Output)
+	assertDependents(ruleResults, ruleresult.Skip, "Dependency skipped")
+}
+
+func TestRunnerLibraryManagerIndex(t *testing.T) {
+	libraryPath, err := paths.MkTempDir("", "arduino-lint-rule-TestRunnerLibraryManagerIndex")
+	require.Nil(t, err)
+	defer libraryPath.RemoveAll()
+	require.Nil(t, libraryPath.Join("Foo.h").WriteFile([]byte("")))
+	require.Nil(t, libraryPath.Join("library.properties").WriteFile([]byte("name=Foo\n")))
+	lintedProject := project.Type{Path: libraryPath, ProjectType: projecttype.Library, SuperprojectType: projecttype.Library}
+	libraryIndexFilePath := libraryPath.Join("library_index.json")
+	require.Nil(t, libraryIndexFilePath.WriteFile([]byte("foo"))) // Invalid, so that loading the index fails.
+
+	flags := test.ConfigurationFlags()
+	flags.Set("library-index", libraryIndexFilePath.String())
+	toolConfiguration, err := configuration.Initialize(flags, []string{libraryPath.String()})
+	require.Nil(t, err)
+	var libraryManagerIndex projectdata.LibraryManagerIndexType
+	projectRun := Runner(context.Background(), []project.Type{lintedProject}, toolConfiguration, &libraryManagerIndex)[0]
+	<-projectRun.InitializationDuration
+	assert.Error(t, libraryManagerIndex.Err(), "Index is loaded during the project initialization")
+	for range projectRun.Results {
+	}
+
+	flags.Set("only", "LS001")
+	toolConfiguration, err = configuration.Initialize(flags, []string{libraryPath.String()})
+	require.Nil(t, err)
+	libraryManagerIndex = projectdata.LibraryManagerIndexType{}
+	projectRun = Runner(context.Background(), []project.Type{lintedProject}, toolConfiguration, &libraryManagerIndex)[0]
+	for range projectRun.Results {
+	}
+	assert.NoError(t, libraryManagerIndex.Err(), "Index is not loaded when none of the rules that use it are run")
+}
+
+func TestDependencyOrder(t *testing.T) {
+	testTables := []struct {
+		testName       string
+		dependencies   map[string][]string
+		ruleIDs        []string
+		expectedOrder  []int
+		errorAssertion assert.ErrorAssertionFunc
+	}{
+		{"No dependencies", map[string][]string{}, []string{"XX001", "XX002", "XX003"}, []int{0, 1, 2}, assert.NoError},
+		{"Dependency later in configuration", map[string][]string{"XX001": {"XX003"}}, []string{"XX001", "XX002", "XX003"}, []int{1, 2, 0}, assert.NoError},
+		{"Chained dependencies", map[string][]string{"XX001": {"XX002"}, "XX002": {"XX003"}}, []string{"XX001", "XX002", "XX003"}, []int{2, 1, 0}, assert.NoError},
+		{"Unknown dependency", map[string][]string{"XX001": {"XX004"}}, []string{"XX001", "XX002", "XX003"}, nil, assert.Error},
+		{"Cycle", map[string][]string{"XX001": {"XX002"}, "XX002": {"XX001"}}, []string{"XX001", "XX002", "XX003"}, nil, assert.Error},
+	}
+
+	for _, testTable := range testTables {
+		ruleConfigurations := []ruleconfiguration.Type{}
+		for _, ruleID := range testTable.ruleIDs {
+			ruleConfigurations = append(ruleConfigurations, ruleconfiguration.Type{ID: ruleID, DependsOn: testTable.dependencies[ruleID]})
+		}
+
+		order, err := DependencyOrder(ruleConfigurations)
+		testTable.errorAssertion(t, err, testTable.testName)
+		assert.Equal(t, testTable.expectedOrder, order, testTable.testName)
+	}
+}
+
+func Test_runPlugin(t *testing.T) {
+	projectPath, err := paths.MkTempDir("", "arduino-lint-rule-Test_runPlugin")
+	require.Nil(t, err)
+	defer projectPath.RemoveAll()
+	require.Nil(t, projectPath.Join(projectPath.Base()+".ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	require.Nil(t, projectPath.Join(".arduino-lint.yml").WriteFile([]byte("rules:\n  HR002: off\n")))
+	lintedProject := project.Type{Path: projectPath, ProjectType: projecttype.Sketch, SuperprojectType: projecttype.Sketch}
+
+	toolConfiguration, err := configuration.Initialize(test.ConfigurationFlags(), []string{projectPath.String()})
+	require.Nil(t, err)
+	projectData := projectdata.Initialize(lintedProject, toolConfiguration, &projectdata.LibraryManagerIndexType{})
+	pluginConfiguration := configuration.Plugin{Name: "house-rules", Command: []string{projectPath.Join("nonexistent").String()}, Rules: []string{"HR001", "HR002"}}
+
+	results := runPlugin(context.Background(), pluginConfiguration, lintedProject, projectData)
+	require.Len(t, results, 2)
+	assert.Equal(t, "HR001", results[0].Configuration.ID)
+	assert.Equal(t, ruleresult.NotRun, results[0].Result, "Plugin that can't be run")
+	assert.Contains(t, results[0].Output, "Unable to run plugin house-rules", "Plugin error is reported in the result")
+	assert.Equal(t, "HR002", results[1].Configuration.ID)
+	assert.Equal(t, ruleresult.Skip, results[1].Result, "Plugin rule disabled by user")
+
+	flags := test.ConfigurationFlags()
+	flags.Set("disable-rules", "HR001")
+	toolConfiguration, err = configuration.Initialize(flags, []string{projectPath.String()})
+	require.Nil(t, err)
+	projectData = projectdata.Initialize(lintedProject, toolConfiguration, &projectdata.LibraryManagerIndexType{})
+	results = runPlugin(context.Background(), pluginConfiguration, lintedProject, projectData)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		assert.Equal(t, ruleresult.Skip, result.Result, "Plugin isn't run when all its rules are disabled")
+	}
+}
+
+func TestRecordPanicked(t *testing.T) {
+	toolConfiguration, err := configuration.Initialize(test.ConfigurationFlags(), []string{})
+	require.Nil(t, err)
+
+	var lintResults result.Type
+	lintResults.Initialize(toolConfiguration)
+	results := make(chan Result, 1)
+	results <- Result{panicked: "foo"}
+	close(results)
+	lintedProject := project.Type{Path: paths.New("/foo"), ProjectType: projecttype.Sketch, SuperprojectType: projecttype.Sketch}
+	assert.PanicsWithValue(t, "foo", func() {
+		Record(&lintResults, lintedProject, ProjectRun{Results: results, toolConfiguration: toolConfiguration})
+	}, "Panic from rule goroutine is repeated when recording")
+}
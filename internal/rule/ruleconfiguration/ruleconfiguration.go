@@ -46,10 +46,14 @@ type Type struct {
 	DisableModes []rulemode.Type // Rule is disabled when tool is in any of these modes.
 	EnableModes  []rulemode.Type // Rule is only enabled when tool is in one of these modes.
 	// The following fields define the rule level in each configuration mode:
-	InfoModes    []rulemode.Type   // Failure of the rule only results in an informational message.
-	WarningModes []rulemode.Type   // Failure of the rule is considered a warning.
-	ErrorModes   []rulemode.Type   // Failure of the rule is considered an error.
-	RuleFunction rulefunction.Type // The function that implements the rule.
+	InfoModes    []rulemode.Type      // Failure of the rule only results in an informational message.
+	WarningModes []rulemode.Type      // Failure of the rule is considered a warning.
+	ErrorModes   []rulemode.Type      // Failure of the rule is considered an error.
+	DependsOn    []string             // IDs of the rules that check the data used by the rule. The rule is not run if any of them failed or was unable to run, and is skipped if any of them was skipped.
+	RuleFunction rulefunction.Type    // The function that implements the rule.
+	FixFunction  rulefunction.FixType // The function that provides the changes to fix a violation of the rule. nil if the rule has no automatic fix.
+
+	UsesLibraryIndex bool // The rule function uses the Library Manager index, so it is loaded during the project initialization when the rule is run.
 }
 
 // Configurations returns the slice of rule configurations.
@@ -193,6 +197,7 @@ var configurations = []Type{
 		InfoModes:        []rulemode.Type{rulemode.Permissive},
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       nil,
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldHeaderMismatch,
 	},
 	{
@@ -211,6 +216,7 @@ var configurations = []Type{
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
 		RuleFunction:     rulefunction.IncorrectLibrarySrcFolderNameCase,
+		FixFunction:      rulefunction.FixFolderNameCase("src"),
 	},
 	{
 		ProjectType:      projecttype.Library,
@@ -262,6 +268,7 @@ var configurations = []Type{
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
 		RuleFunction:     rulefunction.IncorrectExtrasFolderNameCase,
+		FixFunction:      rulefunction.FixFolderNameCase("extras"),
 	},
 	{
 		ProjectType:      projecttype.Library,
@@ -363,6 +370,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesMisspelledOptionalField,
 	},
 	{
@@ -380,6 +388,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldMissing,
 	},
 	{
@@ -397,6 +406,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldLTMinLength,
 	},
 	{
@@ -414,6 +424,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldGTMaxLength,
 	},
 	{
@@ -431,6 +442,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldGTRecommendedLength,
 	},
 	{
@@ -448,6 +460,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldDisallowedCharacters,
 	},
 	{
@@ -465,6 +478,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.LibraryManagerSubmission, rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldStartsWithArduino,
 	},
 	{
@@ -482,6 +496,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldMissingOfficialPrefix,
 	},
 	{
@@ -499,6 +514,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldContainsArduino,
 	},
 	{
@@ -516,6 +532,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldHasSpaces,
 	},
 	{
@@ -533,6 +550,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldContainsLibrary,
 	},
 	{
@@ -550,7 +568,9 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldDuplicate,
+		UsesLibraryIndex: true,
 	},
 	{
 		ProjectType:      projecttype.Library,
@@ -567,7 +587,9 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesNameFieldNotInIndex,
+		UsesLibraryIndex: true,
 	},
 	{
 		ProjectType:      projecttype.Library,
@@ -584,6 +606,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesVersionFieldMissing,
 	},
 	{
@@ -601,6 +624,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesVersionFieldNonRelaxedSemver,
 	},
 	{
@@ -618,6 +642,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesVersionFieldNonSemver,
 	},
 	{
@@ -635,7 +660,9 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesVersionFieldBehindTag,
+		FixFunction:      rulefunction.FixLibraryPropertiesVersionFieldBehindTag,
 	},
 	{
 		ProjectType:      projecttype.Library,
@@ -652,6 +679,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesAuthorFieldMissing,
 	},
 	{
@@ -669,6 +697,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesAuthorFieldLTMinLength,
 	},
 	{
@@ -686,6 +715,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesMaintainerFieldMissing,
 	},
 	{
@@ -703,6 +733,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesMaintainerFieldLTMinLength,
 	},
 	{
@@ -720,6 +751,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesMaintainerFieldStartsWithArduino,
 	},
 	{
@@ -737,6 +769,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesMaintainerFieldContainsArduino,
 	},
 	{
@@ -754,6 +787,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesEmailFieldAsMaintainerAlias,
 	},
 	{
@@ -771,6 +805,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesEmailFieldLTMinLength,
 	},
 	{
@@ -788,6 +823,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesEmailFieldStartsWithArduino,
 	},
 	{
@@ -805,6 +841,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesSentenceFieldMissing,
 	},
 	{
@@ -822,6 +859,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesSentenceFieldLTMinLength,
 	},
 	{
@@ -856,6 +894,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesParagraphFieldMissing,
 	},
 	{
@@ -890,6 +929,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesParagraphFieldRepeatsSentence,
 	},
 	{
@@ -907,6 +947,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesCategoryFieldMissing,
 	},
 	{
@@ -924,6 +965,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesCategoryFieldInvalid,
 	},
 	{
@@ -941,6 +983,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesCategoryFieldUncategorized,
 	},
 	{
@@ -958,6 +1001,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesURLFieldMissing,
 	},
 	{
@@ -975,6 +1019,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesURLFieldLTMinLength,
 	},
 	{
@@ -992,6 +1037,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesURLFieldInvalid,
 	},
 	{
@@ -1009,6 +1055,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesURLFieldDeadLink,
 	},
 	{
@@ -1026,6 +1073,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesArchitecturesFieldMissing,
 	},
 	{
@@ -1043,6 +1091,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesArchitecturesFieldLTMinLength,
 	},
 	{
@@ -1060,6 +1109,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesArchitecturesFieldSoloAlias,
 	},
 	{
@@ -1077,6 +1127,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesArchitecturesFieldValueCase,
 	},
 	{
@@ -1094,6 +1145,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesDependsFieldInvalidFormat,
 	},
 	{
@@ -1111,7 +1163,9 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       nil,
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesDependsFieldNotInIndex,
+		UsesLibraryIndex: true,
 	},
 	{
 		ProjectType:      projecttype.Library,
@@ -1128,6 +1182,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesDependsFieldConstraintInvalid,
 	},
 	{
@@ -1145,6 +1200,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesDotALinkageFieldInvalid,
 	},
 	{
@@ -1179,6 +1235,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesIncludesFieldLTMinLength,
 	},
 	{
@@ -1196,6 +1253,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesIncludesFieldItemNotFound,
 	},
 	{
@@ -1213,6 +1271,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesPrecompiledFieldInvalid,
 	},
 	{
@@ -1230,6 +1289,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesPrecompiledFieldEnabledWithFlatLayout,
 	},
 	{
@@ -1247,6 +1307,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"LP005"},
 		RuleFunction:     rulefunction.LibraryPropertiesLdflagsFieldLTMinLength,
 	},
 	{
@@ -1265,6 +1326,7 @@ var configurations = []Type{
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
 		RuleFunction:     rulefunction.IncorrectArduinoDotHFileNameCase,
+		FixFunction:      rulefunction.FixArduinoDotHFileNameCase,
 	},
 	{
 		ProjectType:      projecttype.Library,
@@ -1367,6 +1429,7 @@ var configurations = []Type{
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
 		RuleFunction:     rulefunction.IncorrectExamplesFolderNameCase,
+		FixFunction:      rulefunction.FixFolderNameCase("examples"),
 	},
 	{
 		ProjectType:      projecttype.Sketch,
@@ -1435,6 +1498,7 @@ var configurations = []Type{
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
 		RuleFunction:     rulefunction.PdeSketchExtension,
+		FixFunction:      rulefunction.FixPdeSketchExtension,
 	},
 	{
 		ProjectType:      projecttype.Sketch,
@@ -1452,6 +1516,7 @@ var configurations = []Type{
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
 		RuleFunction:     rulefunction.IncorrectSketchSrcFolderNameCase,
+		FixFunction:      rulefunction.FixFolderNameCase("src"),
 	},
 	{
 		ProjectType:      projecttype.Sketch,
@@ -1469,6 +1534,7 @@ var configurations = []Type{
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
 		RuleFunction:     rulefunction.IncorrectArduinoDotHFileNameCase,
+		FixFunction:      rulefunction.FixArduinoDotHFileNameCase,
 	},
 	{
 		ProjectType:      projecttype.Sketch,
@@ -1587,6 +1653,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDNameMissing,
 	},
 	{
@@ -1604,6 +1671,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDNameLTMinLength,
 	},
 	{
@@ -1621,6 +1689,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDBuildBoardMissing,
 	},
 	{
@@ -1638,6 +1707,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDBuildBoardLTMinLength,
 	},
 	{
@@ -1655,6 +1725,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDBuildCoreMissing,
 	},
 	{
@@ -1672,6 +1743,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDBuildCoreLTMinLength,
 	},
 	{
@@ -1689,6 +1761,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtUserExtraFlagsUsage,
 	},
 	{
@@ -1706,6 +1779,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDHideInvalid,
 	},
 	{
@@ -1723,6 +1797,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtMenuMenuIDLTMinLength,
 	},
 	{
@@ -1740,6 +1815,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDSerialDisableDTRInvalid,
 	},
 	{
@@ -1757,6 +1833,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDSerialDisableRTSInvalid,
 	},
 	{
@@ -1774,6 +1851,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDUploadToolMissing,
 	},
 	{
@@ -1791,6 +1869,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDUploadToolLTMinLength,
 	},
 	{
@@ -1808,6 +1887,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDUploadMaximumSizeMissing,
 	},
 	{
@@ -1825,6 +1905,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDUploadMaximumSizeInvalid,
 	},
 	{
@@ -1842,6 +1923,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDUploadMaximumDataSizeMissing,
 	},
 	{
@@ -1859,6 +1941,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDUploadMaximumDataSizeInvalid,
 	},
 	{
@@ -1876,6 +1959,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDUploadUse1200bpsTouchInvalid,
 	},
 	{
@@ -1893,6 +1977,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDUploadWaitForUploadPortInvalid,
 	},
 	{
@@ -1910,6 +1995,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDVidNInvalid,
 	},
 	{
@@ -1927,6 +2013,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF002"},
 		RuleFunction:     rulefunction.BoardsTxtBoardIDPidNInvalid,
 	},
 	{
@@ -1961,6 +2048,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF026"},
 		RuleFunction:     rulefunction.ProgrammersTxtProgrammerIDNameMissing,
 	},
 	{
@@ -1978,6 +2066,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF026"},
 		RuleFunction:     rulefunction.ProgrammersTxtProgrammerIDNameLTMinLength,
 	},
 	{
@@ -1995,6 +2084,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF026"},
 		RuleFunction:     rulefunction.ProgrammersTxtProgrammerIDProgramToolMissing,
 	},
 	{
@@ -2012,6 +2102,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF026"},
 		RuleFunction:     rulefunction.ProgrammersTxtProgrammerIDProgramToolLTMinLength,
 	},
 	{
@@ -2046,6 +2137,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtNameMissing,
 	},
 	{
@@ -2063,6 +2155,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtNameLTMinLength,
 	},
 	{
@@ -2080,6 +2173,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtVersionMissing,
 	},
 	{
@@ -2097,6 +2191,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtVersionNonRelaxedSemver,
 	},
 	{
@@ -2114,6 +2209,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtVersionNonSemver,
 	},
 	{
@@ -2131,6 +2227,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerWarningFlagsNoneMissing,
 	},
 	{
@@ -2148,6 +2245,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerWarningFlagsDefaultMissing,
 	},
 	{
@@ -2165,6 +2263,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerWarningFlagsMoreMissing,
 	},
 	{
@@ -2182,6 +2281,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerWarningFlagsAllMissing,
 	},
 	{
@@ -2199,6 +2299,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerOptimizationFlagsDebugMissing,
 	},
 	{
@@ -2216,6 +2317,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerOptimizationFlagsReleaseMissing,
 	},
 	{
@@ -2233,7 +2335,9 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerCExtraFlagsMissing,
+		FixFunction:      rulefunction.FixPlatformTxtPropertyMissing("compiler.c.extra_flags"),
 	},
 	{
 		ProjectType:      projecttype.Platform,
@@ -2250,6 +2354,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerCExtraFlagsNotEmpty,
 	},
 	{
@@ -2267,7 +2372,9 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerCppExtraFlagsMissing,
+		FixFunction:      rulefunction.FixPlatformTxtPropertyMissing("compiler.cpp.extra_flags"),
 	},
 	{
 		ProjectType:      projecttype.Platform,
@@ -2284,6 +2391,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerCppExtraFlagsNotEmpty,
 	},
 	{
@@ -2301,7 +2409,9 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerSExtraFlagsMissing,
+		FixFunction:      rulefunction.FixPlatformTxtPropertyMissing("compiler.S.extra_flags"),
 	},
 	{
 		ProjectType:      projecttype.Platform,
@@ -2318,6 +2428,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerSExtraFlagsNotEmpty,
 	},
 	{
@@ -2335,7 +2446,9 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerArExtraFlagsMissing,
+		FixFunction:      rulefunction.FixPlatformTxtPropertyMissing("compiler.ar.extra_flags"),
 	},
 	{
 		ProjectType:      projecttype.Platform,
@@ -2352,6 +2465,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerArExtraFlagsNotEmpty,
 	},
 	{
@@ -2369,7 +2483,9 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerCElfExtraFlagsMissing,
+		FixFunction:      rulefunction.FixPlatformTxtPropertyMissing("compiler.c.elf.extra_flags"),
 	},
 	{
 		ProjectType:      projecttype.Platform,
@@ -2386,6 +2502,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtCompilerCElfExtraFlagsNotEmpty,
 	},
 	{
@@ -2403,6 +2520,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipePreprocMacrosLTMinLength,
 	},
 	{
@@ -2420,6 +2538,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipePreprocMacrosExtraFlagsSupport,
 	},
 	{
@@ -2437,6 +2556,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeCOPatternMissing,
 	},
 	{
@@ -2454,6 +2574,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeCOPatternLTMinLength,
 	},
 	{
@@ -2471,6 +2592,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeCOPatternExtraFlagsSupport,
 	},
 	{
@@ -2488,6 +2610,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeCppOPatternMissing,
 	},
 	{
@@ -2505,6 +2628,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeCppOPatternLTMinLength,
 	},
 	{
@@ -2522,6 +2646,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeCppOPatternExtraFlagsSupport,
 	},
 	{
@@ -2539,6 +2664,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeSOPatternMissing,
 	},
 	{
@@ -2556,6 +2682,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeSOPatternLTMinLength,
 	},
 	{
@@ -2573,6 +2700,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeSOPatternExtraFlagsSupport,
 	},
 	{
@@ -2590,6 +2718,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeArPatternMissing,
 	},
 	{
@@ -2607,6 +2736,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeArPatternLTMinLength,
 	},
 	{
@@ -2624,6 +2754,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeArPatternExtraFlagsSupport,
 	},
 	{
@@ -2641,6 +2772,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeCCombinePatternMissing,
 	},
 	{
@@ -2658,6 +2790,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeCCombinePatternLTMinLength,
 	},
 	{
@@ -2675,6 +2808,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeCCombinePatternExtraFlagsSupport,
 	},
 	{
@@ -2692,6 +2826,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeOutputTmpFileMissing,
 	},
 	{
@@ -2709,6 +2844,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeOutputTmpFileLTMinLength,
 	},
 	{
@@ -2726,6 +2862,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeOutputSaveFileMissing,
 	},
 	{
@@ -2743,6 +2880,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeOutputSaveFileLTMinLength,
 	},
 	{
@@ -2760,6 +2898,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeSizePatternMissing,
 	},
 	{
@@ -2777,6 +2916,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeSizePatternLTMinLength,
 	},
 	{
@@ -2794,6 +2934,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeSizeRegexMissing,
 	},
 	{
@@ -2811,6 +2952,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"PF031", "PF002"},
 		RuleFunction:     rulefunction.PlatformTxtRecipeSizeRegexDataMissing,
 	},
 	{
@@ -2828,6 +2970,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtPluggableDiscoveryRequiredInvalid,
 	},
 	{
@@ -2845,6 +2988,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtPluggableDiscoveryDiscoveryIDPatternMissing,
 	},
 	{
@@ -2862,6 +3006,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtPluggableMonitorPatternProtocolIDLTMinLength,
 	},
 	{
@@ -2879,6 +3024,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtPluggableMonitorRequiredProtocolIDInvalid,
 	},
 	{
@@ -2896,6 +3042,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtUploadFieldFieldNameGTMaxLength,
 	},
 	{
@@ -2913,6 +3060,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtUploadFieldFieldNameSecretInvalid,
 	},
 	{
@@ -2930,6 +3078,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtUploadPatternMissing,
 	},
 	{
@@ -2947,6 +3096,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtProgramParamsVerboseMissing,
 	},
 	{
@@ -2964,6 +3114,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtProgramParamsQuietMissing,
 	},
 	{
@@ -2981,6 +3132,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtProgramPatternMissing,
 	},
 	{
@@ -2998,6 +3150,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtEraseParamsVerboseMissing,
 	},
 	{
@@ -3015,6 +3168,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtEraseParamsQuietMissing,
 	},
 	{
@@ -3032,6 +3186,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtErasePatternMissing,
 	},
 	{
@@ -3049,6 +3204,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtBootloaderParamsVerboseMissing,
 	},
 	{
@@ -3066,6 +3222,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtBootloaderParamsQuietMissing,
 	},
 	{
@@ -3083,6 +3240,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"PF031"},
 		RuleFunction:     rulefunction.PlatformTxtBootloaderPatternMissing,
 	},
 	{
@@ -3101,6 +3259,7 @@ var configurations = []Type{
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
 		RuleFunction:     rulefunction.IncorrectArduinoDotHFileNameCase,
+		FixFunction:      rulefunction.FixArduinoDotHFileNameCase,
 	},
 	{
 		ProjectType:      projecttype.PackageIndex,
@@ -3160,7 +3319,7 @@ var configurations = []Type{
 		Subcategory:      "general",
 		ID:               "ID001",
 		Brief:            "JSON format",
-		Description:      "The package index is not a valid JSON document.",
+		Description:      "The package index is not a valid JSON document, or its top level is not an object.",
 		MessageTemplate:  "Invalid JSON format.",
 		Reference:        "",
 		DisableModes:     nil,
@@ -3202,6 +3361,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexAdditionalProperties,
 	},
 	{
@@ -3219,6 +3379,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesMissing,
 	},
 	{
@@ -3236,6 +3397,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesIncorrectType,
 	},
 	{
@@ -3253,6 +3415,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesAdditionalProperties,
 	},
 	{
@@ -3270,6 +3433,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesNameMissing,
 	},
 	{
@@ -3287,6 +3451,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesNameIncorrectType,
 	},
 	{
@@ -3304,6 +3469,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesNameLTMinLength,
 	},
 	{
@@ -3321,6 +3487,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesNameIsArduino,
 	},
 	{
@@ -3338,6 +3505,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesMaintainerMissing,
 	},
 	{
@@ -3355,6 +3523,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesMaintainerIncorrectType,
 	},
 	{
@@ -3372,6 +3541,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesMaintainerLTMinLength,
 	},
 	{
@@ -3389,6 +3559,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesMaintainerStartsWithArduino,
 	},
 	{
@@ -3406,6 +3577,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesWebsiteURLMissing,
 	},
 	{
@@ -3423,6 +3595,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesWebsiteURLIncorrectType,
 	},
 	{
@@ -3440,6 +3613,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesWebsiteURLInvalidFormat,
 	},
 	{
@@ -3457,6 +3631,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesWebsiteURLDeadLink,
 	},
 	{
@@ -3474,6 +3649,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesEmailMissing,
 	},
 	{
@@ -3491,6 +3667,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesEmailIncorrectType,
 	},
 	{
@@ -3508,6 +3685,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesHelpIncorrectType,
 	},
 	{
@@ -3525,6 +3703,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesHelpAdditionalProperties,
 	},
 	{
@@ -3542,6 +3721,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesHelpOnlineMissing,
 	},
 	{
@@ -3559,6 +3739,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesHelpOnlineIncorrectType,
 	},
 	{
@@ -3576,6 +3757,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesHelpOnlineInvalidFormat,
 	},
 	{
@@ -3593,6 +3775,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesHelpOnlineDeadLink,
 	},
 	{
@@ -3610,6 +3793,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsMissing,
 	},
 	{
@@ -3627,6 +3811,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsIncorrectType,
 	},
 	{
@@ -3644,6 +3829,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsAdditionalProperties,
 	},
 	{
@@ -3661,6 +3847,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsNameMissing,
 	},
 	{
@@ -3678,6 +3865,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsNameIncorrectType,
 	},
 	{
@@ -3695,6 +3883,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsNameLTMinLength,
 	},
 	{
@@ -3712,6 +3901,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsArchitectureMissing,
 	},
 	{
@@ -3729,6 +3919,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsArchitectureIncorrectType,
 	},
 	{
@@ -3746,6 +3937,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsArchitectureLTMinLength,
 	},
 	{
@@ -3763,6 +3955,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsVersionMissing,
 	},
 	{
@@ -3780,6 +3973,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsVersionIncorrectType,
 	},
 	{
@@ -3797,6 +3991,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsVersionNonRelaxedSemver,
 	},
 	{
@@ -3814,6 +4009,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsVersionNonSemver,
 	},
 	{
@@ -3831,6 +4027,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsDeprecatedIncorrectType,
 	},
 	{
@@ -3848,6 +4045,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsCategoryMissing,
 	},
 	{
@@ -3865,6 +4063,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsCategoryIncorrectType,
 	},
 	{
@@ -3882,6 +4081,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsCategoryThirdPartyInvalid,
 	},
 	{
@@ -3899,6 +4099,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsHelpMissing,
 	},
 	{
@@ -3916,6 +4117,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsHelpIncorrectType,
 	},
 	{
@@ -3933,6 +4135,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsHelpAdditionalProperties,
 	},
 	{
@@ -3950,6 +4153,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsHelpOnlineMissing,
 	},
 	{
@@ -3967,6 +4171,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsHelpOnlineIncorrectType,
 	},
 	{
@@ -3984,6 +4189,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsHelpOnlineInvalidFormat,
 	},
 	{
@@ -4001,6 +4207,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsHelpOnlineDeadLink,
 	},
 	{
@@ -4018,6 +4225,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsURLMissing,
 	},
 	{
@@ -4035,6 +4243,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsURLIncorrectType,
 	},
 	{
@@ -4052,6 +4261,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsURLInvalidFormat,
 	},
 	{
@@ -4069,6 +4279,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsURLDeadLink,
 	},
 	{
@@ -4086,6 +4297,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsArchiveFileNameMissing,
 	},
 	{
@@ -4103,6 +4315,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsArchiveFileNameIncorrectType,
 	},
 	{
@@ -4120,6 +4333,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsArchitectureLTMinLength,
 	},
 	{
@@ -4137,6 +4351,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsArchiveFileNameInvalid,
 	},
 	{
@@ -4154,6 +4369,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsChecksumMissing,
 	},
 	{
@@ -4171,6 +4387,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsChecksumIncorrectType,
 	},
 	{
@@ -4188,6 +4405,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsChecksumInvalid,
 	},
 	{
@@ -4205,6 +4423,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsChecksumDiscouragedAlgorithm,
 	},
 	{
@@ -4222,6 +4441,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsSizeMissing,
 	},
 	{
@@ -4239,6 +4459,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsSizeIncorrectType,
 	},
 	{
@@ -4256,6 +4477,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsSizeInvalid,
 	},
 	{
@@ -4273,6 +4495,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsBoardsMissing,
 	},
 	{
@@ -4290,6 +4513,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsBoardsIncorrectType,
 	},
 	{
@@ -4307,6 +4531,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsBoardsAdditionalProperties,
 	},
 	{
@@ -4324,6 +4549,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsBoardsNameMissing,
 	},
 	{
@@ -4341,6 +4567,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsBoardsNameIncorrectType,
 	},
 	{
@@ -4358,6 +4585,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsBoardsNameLTMinLength,
 	},
 	{
@@ -4375,6 +4603,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesMissing,
 	},
 	{
@@ -4392,6 +4621,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesIncorrectType,
 	},
 	{
@@ -4409,6 +4639,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesAdditionalProperties,
 	},
 	{
@@ -4426,6 +4657,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesPackagerMissing,
 	},
 	{
@@ -4443,6 +4675,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesPackagerIncorrectType,
 	},
 	{
@@ -4460,6 +4693,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesPackagerLTMinLength,
 	},
 	{
@@ -4477,6 +4711,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesNameMissing,
 	},
 	{
@@ -4494,6 +4729,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesNameIncorrectType,
 	},
 	{
@@ -4511,6 +4747,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesNameLTMinLength,
 	},
 	{
@@ -4528,6 +4765,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesVersionMissing,
 	},
 	{
@@ -4545,6 +4783,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesVersionIncorrectType,
 	},
 	{
@@ -4562,6 +4801,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesVersionNonRelaxedSemver,
 	},
 	{
@@ -4579,6 +4819,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsToolsDependenciesVersionNonSemver,
 	},
 	{
@@ -4596,6 +4837,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsDiscoveryDependenciesIncorrectType,
 	},
 	{
@@ -4613,6 +4855,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsDiscoveryDependenciesAdditionalProperties,
 	},
 	{
@@ -4630,6 +4873,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsDiscoveryDependenciesPackagerMissing,
 	},
 	{
@@ -4647,6 +4891,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsDiscoveryDependenciesPackagerIncorrectType,
 	},
 	{
@@ -4664,6 +4909,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsDiscoveryDependenciesPackagerLTMinLength,
 	},
 	{
@@ -4681,6 +4927,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsDiscoveryDependenciesNameMissing,
 	},
 	{
@@ -4698,6 +4945,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsDiscoveryDependenciesNameIncorrectType,
 	},
 	{
@@ -4715,6 +4963,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsDiscoveryDependenciesNameLTMinLength,
 	},
 	{
@@ -4732,6 +4981,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsMonitorDependenciesIncorrectType,
 	},
 	{
@@ -4749,6 +4999,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsMonitorDependenciesAdditionalProperties,
 	},
 	{
@@ -4766,6 +5017,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsMonitorDependenciesPackagerMissing,
 	},
 	{
@@ -4783,6 +5035,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsMonitorDependenciesPackagerIncorrectType,
 	},
 	{
@@ -4800,6 +5053,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsMonitorDependenciesPackagerLTMinLength,
 	},
 	{
@@ -4817,6 +5071,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsMonitorDependenciesNameMissing,
 	},
 	{
@@ -4834,6 +5089,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsMonitorDependenciesNameIncorrectType,
 	},
 	{
@@ -4851,6 +5107,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesPlatformsMonitorDependenciesNameLTMinLength,
 	},
 	{
@@ -4868,6 +5125,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsMissing,
 	},
 	{
@@ -4885,6 +5143,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsIncorrectType,
 	},
 	{
@@ -4902,6 +5161,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsAdditionalProperties,
 	},
 	{
@@ -4919,6 +5179,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsNameMissing,
 	},
 	{
@@ -4936,6 +5197,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsNameIncorrectType,
 	},
 	{
@@ -4953,6 +5215,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsNameLTMinLength,
 	},
 	{
@@ -4970,6 +5233,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsVersionMissing,
 	},
 	{
@@ -4987,6 +5251,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsVersionIncorrectType,
 	},
 	{
@@ -5004,6 +5269,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsVersionNonRelaxedSemver,
 	},
 	{
@@ -5021,6 +5287,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsVersionNonSemver,
 	},
 	{
@@ -5038,6 +5305,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsMissing,
 	},
 	{
@@ -5055,6 +5323,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsIncorrectType,
 	},
 	{
@@ -5072,6 +5341,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsAdditionalProperties,
 	},
 	{
@@ -5089,6 +5359,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsHostMissing,
 	},
 	{
@@ -5106,6 +5377,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsHostIncorrectType,
 	},
 	{
@@ -5123,6 +5395,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsHostInvalid,
 	},
 	{
@@ -5140,6 +5413,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsURLMissing,
 	},
 	{
@@ -5157,6 +5431,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsURLIncorrectType,
 	},
 	{
@@ -5174,6 +5449,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsURLInvalidFormat,
 	},
 	{
@@ -5191,6 +5467,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Permissive},
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsURLDeadLink,
 	},
 	{
@@ -5208,6 +5485,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsArchiveFileNameMissing,
 	},
 	{
@@ -5225,6 +5503,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsArchiveFileNameIncorrectType,
 	},
 	{
@@ -5242,6 +5521,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsArchiveFileNameLTMinLength,
 	},
 	{
@@ -5259,6 +5539,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsArchiveFileNameInvalid,
 	},
 	{
@@ -5276,6 +5557,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsChecksumMissing,
 	},
 	{
@@ -5293,6 +5575,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsChecksumIncorrectType,
 	},
 	{
@@ -5310,6 +5593,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsChecksumInvalid,
 	},
 	{
@@ -5327,6 +5611,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     []rulemode.Type{rulemode.Default},
 		ErrorModes:       []rulemode.Type{rulemode.Strict},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsChecksumDiscouragedAlgorithm,
 	},
 	{
@@ -5344,6 +5629,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsSizeMissing,
 	},
 	{
@@ -5361,6 +5647,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsSizeIncorrectType,
 	},
 	{
@@ -5378,6 +5665,7 @@ var configurations = []Type{
 		InfoModes:        nil,
 		WarningModes:     nil,
 		ErrorModes:       []rulemode.Type{rulemode.Default},
+		DependsOn:        []string{"ID001"},
 		RuleFunction:     rulefunction.PackageIndexPackagesToolsSystemsSizeInvalid,
 	},
 }
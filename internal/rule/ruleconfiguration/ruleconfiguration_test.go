@@ -20,22 +20,27 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/configuration/rulemode"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
 	"github.com/arduino/arduino-lint/internal/rule"
 	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
 	"github.com/arduino/arduino-lint/internal/rule/rulelevel"
+	"github.com/arduino/arduino-lint/internal/util/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestConfigurationResolution(t *testing.T) {
+	toolConfiguration, err := configuration.Initialize(test.ConfigurationFlags(), []string{})
+	require.Nil(t, err)
+
 	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
 		for ruleMode := range rulemode.Types {
-			enabled, err := rule.IsEnabled(ruleConfiguration, map[rulemode.Type]bool{ruleMode: true})
+			enabled, err := rule.IsEnabled(ruleConfiguration, map[rulemode.Type]bool{ruleMode: true}, toolConfiguration)
 			assert.NoError(t, err, fmt.Sprintf("Enable configuration of rule %s doesn't resolve for rule mode %s", ruleConfiguration.ID, ruleMode))
 			if err == nil && enabled {
-				_, err := rulelevel.FailRuleLevel(ruleConfiguration, map[rulemode.Type]bool{ruleMode: true})
+				_, err := rulelevel.FailRuleLevel(ruleConfiguration, map[rulemode.Type]bool{ruleMode: true}, toolConfiguration)
 				assert.Nil(t, err, fmt.Sprintf("Level configuration of rule %s doesn't resolve for rule mode %s", ruleConfiguration.ID, ruleMode))
 			}
 		}
@@ -106,3 +111,22 @@ func TestRequiredConfigField(t *testing.T) {
 		assert.NotEmptyf(t, ruleConfiguration.MessageTemplate, "No message template defined for rule %s", ruleConfiguration.ID)
 	}
 }
+
+func TestDependsOn(t *testing.T) {
+	ruleConfigurations := make(map[string]ruleconfiguration.Type)
+	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
+		ruleConfigurations[ruleConfiguration.ID] = ruleConfiguration
+	}
+
+	for _, ruleConfiguration := range ruleconfiguration.Configurations() {
+		for _, dependencyID := range ruleConfiguration.DependsOn {
+			dependencyConfiguration, ok := ruleConfigurations[dependencyID]
+			if assert.Truef(t, ok, "Rule %s depends on unknown rule %s", ruleConfiguration.ID, dependencyID) {
+				assert.Equalf(t, ruleConfiguration.ProjectType, dependencyConfiguration.ProjectType, "Rule %s depends on rule %s of a different project type", ruleConfiguration.ID, dependencyID)
+			}
+		}
+	}
+
+	_, err := rule.DependencyOrder(ruleconfiguration.Configurations())
+	assert.Nil(t, err, "Rule dependencies are acyclic")
+}
@@ -0,0 +1,143 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package rulefunction
+
+// The fix functions provide the changes to the project files that resolve the violation of a rule.
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/project/sketch"
+	"github.com/arduino/go-paths-helper"
+)
+
+// FixType is the function signature for the rule fix functions.
+// The `output` and `findings` arguments are the results of the rule function for the violation to fix.
+type FixType func(projectData *projectdata.Type, output string, findings []Finding) []Change
+
+// Change is a change to a project file or folder.
+type Change struct {
+	Path       *paths.Path // Path of the file or folder to change.
+	NewPath    *paths.Path // Path to move the file or folder to. nil if the change is not a rename.
+	NewContent []byte      // The new content of the file. nil if the change is not an edit.
+}
+
+// FixFolderNameCase renames the folders of the findings to the correct case.
+func FixFolderNameCase(correctBaseName string) FixType {
+	return func(projectData *projectdata.Type, output string, findings []Finding) []Change {
+		changes := []Change{}
+		for _, finding := range findings {
+			changes = append(changes, Change{Path: finding.Path, NewPath: finding.Path.Parent().Join(correctBaseName)})
+		}
+
+		return changes
+	}
+}
+
+// FixArduinoDotHFileNameCase corrects the file name case of Arduino.h in the #include directives of all project files.
+func FixArduinoDotHFileNameCase(projectData *projectdata.Type, output string, findings []Finding) []Change {
+	incorrectCaseRegexp := regexp.MustCompile(`(?m)^([ \t]*#[ \t]*include[ \t]*["<])(?:a(?i:rduino)|ARDUINO)\.[hH]([">])`)
+
+	directoryListing, err := projectData.ProjectPath().ReadDirRecursive()
+	if err != nil {
+		panic(err)
+	}
+	directoryListing.FilterOutDirs()
+
+	changes := []Change{}
+	for _, file := range directoryListing {
+		if !sketch.HasSupportedExtension(file) {
+			continue
+		}
+
+		content, err := file.ReadFile()
+		if err != nil {
+			panic(err)
+		}
+
+		if incorrectCaseRegexp.Match(content) {
+			changes = append(changes, Change{Path: file, NewContent: incorrectCaseRegexp.ReplaceAll(content, []byte("${1}Arduino.h${2}"))})
+		}
+	}
+
+	return changes
+}
+
+// FixPdeSketchExtension renames the .pde sketch files of the findings to use the .ino extension.
+func FixPdeSketchExtension(projectData *projectdata.Type, output string, findings []Finding) []Change {
+	changes := []Change{}
+	for _, finding := range findings {
+		newPath := finding.Path.Parent().Join(strings.TrimSuffix(finding.Path.Base(), finding.Path.Ext()) + ".ino")
+		changes = append(changes, Change{Path: finding.Path, NewPath: newPath})
+	}
+
+	return changes
+}
+
+// FixPlatformTxtPropertyMissing adds a definition of the given property with an empty value to platform.txt.
+func FixPlatformTxtPropertyMissing(key string) FixType {
+	return func(projectData *projectdata.Type, output string, findings []Finding) []Change {
+		platformTxtPath := projectData.ProjectPath().Join("platform.txt")
+		content, err := platformTxtPath.ReadFile()
+		if err != nil {
+			panic(err)
+		}
+
+		if len(content) > 0 && !strings.HasSuffix(string(content), "\n") {
+			content = append(content, '\n')
+		}
+		content = append(content, []byte(key+"=\n")...)
+
+		return []Change{{Path: platformTxtPath, NewContent: content}}
+	}
+}
+
+// FixLibraryPropertiesVersionFieldBehindTag sets the library.properties version field to the version of the tag.
+func FixLibraryPropertiesVersionFieldBehindTag(projectData *projectdata.Type, output string, findings []Finding) []Change {
+	// The rule output has the format "<tag version> vs <field value>".
+	tagVersion, _, found := strings.Cut(output, " vs ")
+	if !found || len(findings) == 0 {
+		return nil
+	}
+
+	return setPropertiesValue(findings[0], "version", tagVersion)
+}
+
+// setPropertiesValue returns the change that sets the value of the property definition at the given location.
+func setPropertiesValue(finding Finding, key string, value string) []Change {
+	content, err := finding.Path.ReadFile()
+	if err != nil {
+		panic(err)
+	}
+
+	lines := strings.Split(string(content), "\n")
+	if finding.Line < 1 || finding.Line > len(lines) {
+		return nil
+	}
+
+	propertyRegexp := regexp.MustCompile(fmt.Sprintf(`^(\s*%s\s*=\s*)[^\r]*(\r?)$`, regexp.QuoteMeta(key)))
+	submatches := propertyRegexp.FindStringSubmatch(lines[finding.Line-1])
+	if submatches == nil {
+		return nil
+	}
+	lines[finding.Line-1] = submatches[1] + value + submatches[2]
+
+	return []Change{{Path: finding.Path, NewContent: []byte(strings.Join(lines, "\n"))}}
+}
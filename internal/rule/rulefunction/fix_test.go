@@ -0,0 +1,112 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package rulefunction
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/project/projecttype"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixFolderNameCase(t *testing.T) {
+	findings := []Finding{{Path: paths.New("/foo", "Src")}}
+	assert.Equal(t, []Change{{Path: paths.New("/foo", "Src"), NewPath: paths.New("/foo", "src")}}, FixFolderNameCase("src")(nil, "", findings))
+}
+
+func TestFixPdeSketchExtension(t *testing.T) {
+	findings := []Finding{{Path: paths.New("/foo", "Foo.pde")}, {Path: paths.New("/foo", "Bar.pde")}}
+	expectedChanges := []Change{
+		{Path: paths.New("/foo", "Foo.pde"), NewPath: paths.New("/foo", "Foo.ino")},
+		{Path: paths.New("/foo", "Bar.pde"), NewPath: paths.New("/foo", "Bar.ino")},
+	}
+	assert.Equal(t, expectedChanges, FixPdeSketchExtension(nil, "", findings))
+}
+
+func TestFixArduinoDotHFileNameCase(t *testing.T) {
+	testTables := []struct {
+		projectFolderName string
+		expectedContent   string
+	}{
+		{"arduino.h-angle", "#include <Arduino.h>\n"},
+		{"arduino.h-quote", "#include \"Arduino.h\"\n"},
+	}
+
+	for _, testTable := range testTables {
+		projectData := projectdata.Initialize(project.Type{
+			Path:             testDataPath.Join(testTable.projectFolderName),
+			ProjectType:      projecttype.Sketch,
+			SuperprojectType: projecttype.Sketch,
+		}, testToolConfiguration, &testLibraryManagerIndex)
+
+		changes := FixArduinoDotHFileNameCase(projectData, "", nil)
+		require.Len(t, changes, 1, testTable.projectFolderName)
+		assert.Equal(t, testDataPath.Join(testTable.projectFolderName, "foo.h"), changes[0].Path, testTable.projectFolderName)
+		assert.Nil(t, changes[0].NewPath, testTable.projectFolderName)
+		assert.Equal(t, testTable.expectedContent, string(changes[0].NewContent), testTable.projectFolderName)
+	}
+}
+
+func TestFixPlatformTxtPropertyMissing(t *testing.T) {
+	platformPath, err := paths.MkTempDir("", "arduino-lint-rulefunction-TestFixPlatformTxtPropertyMissing")
+	require.Nil(t, err)
+	defer platformPath.RemoveAll() // clean up
+	platformTxtPath := platformPath.Join("platform.txt")
+
+	projectData := projectdata.Initialize(project.Type{
+		Path:             platformPath,
+		ProjectType:      projecttype.Sketch,
+		SuperprojectType: projecttype.Sketch,
+	}, testToolConfiguration, &testLibraryManagerIndex)
+
+	testTables := []struct {
+		testName        string
+		content         string
+		expectedContent string
+	}{
+		{"Trailing newline", "name=Foo\n", "name=Foo\ncompiler.c.extra_flags=\n"},
+		{"No trailing newline", "name=Foo", "name=Foo\ncompiler.c.extra_flags=\n"},
+		{"Empty", "", "compiler.c.extra_flags=\n"},
+	}
+
+	for _, testTable := range testTables {
+		require.Nil(t, platformTxtPath.WriteFile([]byte(testTable.content)))
+		changes := FixPlatformTxtPropertyMissing("compiler.c.extra_flags")(projectData, "", nil)
+		assert.Equal(t, []Change{{Path: platformTxtPath, NewContent: []byte(testTable.expectedContent)}}, changes, testTable.testName)
+	}
+}
+
+func TestFixLibraryPropertiesVersionFieldBehindTag(t *testing.T) {
+	libraryPath, err := paths.MkTempDir("", "arduino-lint-rulefunction-TestFixLibraryPropertiesVersionFieldBehindTag")
+	require.Nil(t, err)
+	defer libraryPath.RemoveAll() // clean up
+	libraryPropertiesPath := libraryPath.Join("library.properties")
+	require.Nil(t, libraryPropertiesPath.WriteFile([]byte("name=Foo\r\nversion = 1.0.0\r\nauthor=Bar\r\n")))
+
+	findings := []Finding{{Path: libraryPropertiesPath, Line: 2}}
+	assert.Equal(
+		t,
+		[]Change{{Path: libraryPropertiesPath, NewContent: []byte("name=Foo\r\nversion = 1.2.3\r\nauthor=Bar\r\n")}},
+		FixLibraryPropertiesVersionFieldBehindTag(nil, "1.2.3 vs 1.0.0", findings),
+	)
+	assert.Nil(t, FixLibraryPropertiesVersionFieldBehindTag(nil, "1.2.3 vs 1.0.0", []Finding{{Path: libraryPropertiesPath, Line: 1}}), "Line is not the version field")
+	assert.Nil(t, FixLibraryPropertiesVersionFieldBehindTag(nil, "", findings), "Unexpected output")
+}
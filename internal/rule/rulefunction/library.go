@@ -42,50 +42,50 @@ import (
 )
 
 // LibraryInvalid checks whether the provided path is a valid library.
-func LibraryInvalid() (result ruleresult.Type, output string) {
-	if projectdata.LoadedLibrary() != nil && library.ContainsHeaderFile(projectdata.LoadedLibrary().SourceDir) {
-		return ruleresult.Pass, ""
+func LibraryInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() != nil && library.ContainsHeaderFile(projectData.LoadedLibrary().SourceDir) {
+		return ruleresult.Pass, "", nil
 	}
 
-	return ruleresult.Fail, ""
+	return ruleresult.Fail, "", nil
 }
 
 // LibraryFolderNameGTMaxLength checks if the library folder name exceeds the maximum length.
-func LibraryFolderNameGTMaxLength() (result ruleresult.Type, output string) {
-	if len(projectdata.ProjectPath().Base()) > 63 {
-		return ruleresult.Fail, projectdata.ProjectPath().Base()
+func LibraryFolderNameGTMaxLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.ProjectPath().Base()) > 63 {
+		return ruleresult.Fail, projectData.ProjectPath().Base(), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // ProhibitedCharactersInLibraryFolderName checks for prohibited characters in the library folder name.
-func ProhibitedCharactersInLibraryFolderName() (result ruleresult.Type, output string) {
-	if !validProjectPathBaseName(projectdata.ProjectPath().Base()) {
-		return ruleresult.Fail, projectdata.ProjectPath().Base()
+func ProhibitedCharactersInLibraryFolderName(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !validProjectPathBaseName(projectData.ProjectPath().Base()) {
+		return ruleresult.Fail, projectData.ProjectPath().Base(), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryHasSubmodule checks whether the library contains a Git submodule.
-func LibraryHasSubmodule() (result ruleresult.Type, output string) {
-	dotGitmodulesPath := projectdata.ProjectPath().Join(".gitmodules")
+func LibraryHasSubmodule(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	dotGitmodulesPath := projectData.ProjectPath().Join(".gitmodules")
 	hasDotGitmodules, err := dotGitmodulesPath.ExistCheck()
 	if err != nil {
 		panic(err)
 	}
 
 	if hasDotGitmodules && dotGitmodulesPath.IsNotDir() {
-		return ruleresult.Fail, ""
+		return ruleresult.Fail, "", []Finding{{Path: dotGitmodulesPath}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryContainsSymlinks checks if the library folder contains symbolic links.
-func LibraryContainsSymlinks() (result ruleresult.Type, output string) {
-	projectPathListing, err := projectdata.ProjectPath().ReadDirRecursive()
+func LibraryContainsSymlinks(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	projectPathListing, err := projectData.ProjectPath().ReadDirRecursive()
 	if err != nil {
 		panic(err)
 	}
@@ -99,34 +99,35 @@ func LibraryContainsSymlinks() (result ruleresult.Type, output string) {
 
 		if projectPathItemStat.Mode()&os.ModeSymlink != 0 {
 			symlinkPaths = append(symlinkPaths, projectPathItem.String())
+			findings = append(findings, Finding{Path: projectPathItem, Subject: projectPathItem.String()})
 		}
 	}
 
 	if len(symlinkPaths) > 0 {
-		return ruleresult.Fail, brokenOutputList(symlinkPaths)
+		return ruleresult.Fail, brokenOutputList(symlinkPaths), findings
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryHasDotDevelopmentFile checks whether the library contains a .development flag file.
-func LibraryHasDotDevelopmentFile() (result ruleresult.Type, output string) {
-	dotDevelopmentPath := projectdata.ProjectPath().Join(".development")
+func LibraryHasDotDevelopmentFile(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	dotDevelopmentPath := projectData.ProjectPath().Join(".development")
 	hasDotDevelopment, err := dotDevelopmentPath.ExistCheck()
 	if err != nil {
 		panic(err)
 	}
 
 	if hasDotDevelopment && dotDevelopmentPath.IsNotDir() {
-		return ruleresult.Fail, ""
+		return ruleresult.Fail, "", []Finding{{Path: dotDevelopmentPath}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryHasExe checks whether the library contains files with .exe extension.
-func LibraryHasExe() (result ruleresult.Type, output string) {
-	projectPathListing, err := projectdata.ProjectPath().ReadDirRecursive()
+func LibraryHasExe(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	projectPathListing, err := projectData.ProjectPath().ReadDirRecursive()
 	if err != nil {
 		panic(err)
 	}
@@ -136,46 +137,43 @@ func LibraryHasExe() (result ruleresult.Type, output string) {
 	for _, projectPathItem := range projectPathListing {
 		if projectPathItem.Ext() == ".exe" {
 			exePaths = append(exePaths, projectPathItem.String())
+			findings = append(findings, Finding{Path: projectPathItem, Subject: projectPathItem.String()})
 		}
 	}
 
 	if len(exePaths) > 0 {
-		return ruleresult.Fail, brokenOutputList(exePaths)
+		return ruleresult.Fail, brokenOutputList(exePaths), findings
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesNameFieldHeaderMismatch checks whether the filename of one of the library's header files matches the Library Manager installation folder name.
-func LibraryPropertiesNameFieldHeaderMismatch() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+func LibraryPropertiesNameFieldHeaderMismatch(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
 	sanitizedName := utils.SanitizeName(name)
-	for _, header := range projectdata.SourceHeaders() {
+	for _, header := range projectData.SourceHeaders() {
 		if strings.TrimSuffix(header, filepath.Ext(header)) == sanitizedName {
-			return ruleresult.Pass, ""
+			return ruleresult.Pass, "", nil
 		}
 	}
 
-	return ruleresult.Fail, sanitizedName + ".h"
+	return ruleresult.Fail, sanitizedName + ".h", libraryPropertiesFieldFindings(projectData, "name")
 }
 
 // IncorrectLibrarySrcFolderNameCase checks for incorrect case of src subfolder name in recursive format libraries.
-func IncorrectLibrarySrcFolderNameCase() (result ruleresult.Type, output string) {
-	if library.ContainsMetadataFile(projectdata.ProjectPath()) && library.ContainsHeaderFile(projectdata.ProjectPath()) {
+func IncorrectLibrarySrcFolderNameCase(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if library.ContainsMetadataFile(projectData.ProjectPath()) && library.ContainsHeaderFile(projectData.ProjectPath()) {
 		// Flat layout, so no special treatment of src subfolder.
-		return ruleresult.Skip, "Not applicable due to layout type"
+		return ruleresult.Skip, "Not applicable due to layout type", nil
 	}
 
 	// The library is intended to have the recursive layout.
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -183,32 +181,32 @@ func IncorrectLibrarySrcFolderNameCase() (result ruleresult.Type, output string)
 
 	path, found := containsIncorrectPathBaseCase(directoryListing, "src")
 	if found {
-		return ruleresult.Fail, path.String()
+		return ruleresult.Fail, path.String(), []Finding{{Path: path}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // RecursiveLibraryWithUtilityFolder checks for presence of a `utility` subfolder in a recursive layout library.
-func RecursiveLibraryWithUtilityFolder() (result ruleresult.Type, output string) {
-	if projectdata.LoadedLibrary() == nil {
-		return ruleresult.NotRun, "Library not loaded"
+func RecursiveLibraryWithUtilityFolder(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() == nil {
+		return ruleresult.NotRun, "Library not loaded", nil
 	}
 
-	if projectdata.LoadedLibrary().Layout == libraries.FlatLayout {
-		return ruleresult.Skip, "Not applicable due to layout type"
+	if projectData.LoadedLibrary().Layout == libraries.FlatLayout {
+		return ruleresult.Skip, "Not applicable due to layout type", nil
 	}
 
-	if projectdata.ProjectPath().Join("utility").Exist() {
-		return ruleresult.Fail, ""
+	if projectData.ProjectPath().Join("utility").Exist() {
+		return ruleresult.Fail, "", nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // MisspelledExtrasFolderName checks for incorrectly spelled `extras` folder name.
-func MisspelledExtrasFolderName() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func MisspelledExtrasFolderName(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -216,15 +214,15 @@ func MisspelledExtrasFolderName() (result ruleresult.Type, output string) {
 
 	path, found := containsMisspelledPathBaseName(directoryListing, "extras", "(?i)^extra$")
 	if found {
-		return ruleresult.Fail, path.String()
+		return ruleresult.Fail, path.String(), []Finding{{Path: path}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // IncorrectExtrasFolderNameCase checks for incorrect `extras` folder name case.
-func IncorrectExtrasFolderNameCase() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func IncorrectExtrasFolderNameCase(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -232,28 +230,28 @@ func IncorrectExtrasFolderNameCase() (result ruleresult.Type, output string) {
 
 	path, found := containsIncorrectPathBaseCase(directoryListing, "extras")
 	if found {
-		return ruleresult.Fail, path.String()
+		return ruleresult.Fail, path.String(), []Finding{{Path: path}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesMissing checks for presence of library.properties.
-func LibraryPropertiesMissing() (result ruleresult.Type, output string) {
-	if projectdata.LoadedLibrary() == nil {
-		return ruleresult.NotRun, "Couldn't load library."
+func LibraryPropertiesMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() == nil {
+		return ruleresult.NotRun, "Couldn't load library.", nil
 	}
 
-	if projectdata.LoadedLibrary().IsLegacy {
-		return ruleresult.Fail, ""
+	if projectData.LoadedLibrary().IsLegacy {
+		return ruleresult.Fail, "", nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // MisspelledLibraryPropertiesFileName checks for incorrectly spelled library.properties file name.
-func MisspelledLibraryPropertiesFileName() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func MisspelledLibraryPropertiesFileName(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -261,15 +259,15 @@ func MisspelledLibraryPropertiesFileName() (result ruleresult.Type, output strin
 
 	path, found := containsMisspelledPathBaseName(directoryListing, "library.properties", "(?i)^librar((y)|(ie))s?[.-_]?propert((y)|(ie))s?$")
 	if found {
-		return ruleresult.Fail, path.String()
+		return ruleresult.Fail, path.String(), []Finding{{Path: path}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // IncorrectLibraryPropertiesFileNameCase checks for incorrect library.properties file name case.
-func IncorrectLibraryPropertiesFileNameCase() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func IncorrectLibraryPropertiesFileNameCase(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -277,319 +275,265 @@ func IncorrectLibraryPropertiesFileNameCase() (result ruleresult.Type, output st
 
 	path, found := containsIncorrectPathBaseCase(directoryListing, "library.properties")
 	if found {
-		return ruleresult.Fail, path.String()
+		return ruleresult.Fail, path.String(), []Finding{{Path: path}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // RedundantLibraryProperties checks for redundant copies of the library.properties file.
-func RedundantLibraryProperties() (result ruleresult.Type, output string) {
-	redundantLibraryPropertiesPath := projectdata.ProjectPath().Join("src", "library.properties")
+func RedundantLibraryProperties(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	redundantLibraryPropertiesPath := projectData.ProjectPath().Join("src", "library.properties")
 	if redundantLibraryPropertiesPath.Exist() {
-		return ruleresult.Fail, redundantLibraryPropertiesPath.String()
+		return ruleresult.Fail, redundantLibraryPropertiesPath.String(), []Finding{{Path: redundantLibraryPropertiesPath}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesFormat checks for invalid library.properties format.
-func LibraryPropertiesFormat() (result ruleresult.Type, output string) {
-	if projectdata.LoadedLibrary() != nil && projectdata.LoadedLibrary().IsLegacy {
-		return ruleresult.Skip, "Library has no library.properties"
+func LibraryPropertiesFormat(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() != nil && projectData.LoadedLibrary().IsLegacy {
+		return ruleresult.Skip, "Library has no library.properties", nil
 	}
 
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.Fail, projectdata.LibraryPropertiesLoadError().Error()
+	if projectData.LibraryPropertiesLoadError() != nil {
+		return ruleresult.Fail, projectData.LibraryPropertiesLoadError().Error(), []Finding{{Path: projectData.ProjectPath().Join("library.properties")}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesNameFieldMissing checks for missing library.properties "name" field.
-func LibraryPropertiesNameFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	if projectdata.LoadedLibrary() != nil && projectdata.LoadedLibrary().IsLegacy {
-		return ruleresult.Skip, "Library has legacy format"
+func LibraryPropertiesNameFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() != nil && projectData.LoadedLibrary().IsLegacy {
+		return ruleresult.Skip, "Library has legacy format", nil
 	}
 
-	if schema.RequiredPropertyMissing("name", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("name", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "name")
 	}
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesNameFieldLTMinLength checks if the library.properties "name" value is less than the minimum length.
-func LibraryPropertiesNameFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
+func LibraryPropertiesNameFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.LibraryProperties().ContainsKey("name") {
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("name") {
-		return ruleresult.NotRun, "Field not present"
+	if schema.PropertyLessThanMinLength("name", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "name")
 	}
 
-	if schema.PropertyLessThanMinLength("name", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesNameFieldGTMaxLength checks if the library.properties "name" value is greater than the maximum length.
-func LibraryPropertiesNameFieldGTMaxLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+func LibraryPropertiesNameFieldGTMaxLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.PropertyGreaterThanMaxLength("name", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, name
+	if schema.PropertyGreaterThanMaxLength("name", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, name, libraryPropertiesFieldFindings(projectData, "name")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesNameFieldGTRecommendedLength checks if the library.properties "name" value is greater than the recommended length.
-func LibraryPropertiesNameFieldGTRecommendedLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+func LibraryPropertiesNameFieldGTRecommendedLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.PropertyGreaterThanMaxLength("name", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, name
+	if schema.PropertyGreaterThanMaxLength("name", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, name, libraryPropertiesFieldFindings(projectData, "name")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesNameFieldDisallowedCharacters checks for disallowed characters in the library.properties "name" field.
-func LibraryPropertiesNameFieldDisallowedCharacters() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+func LibraryPropertiesNameFieldDisallowedCharacters(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/allowedCharacters", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, name
+	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/allowedCharacters", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, name, libraryPropertiesFieldFindings(projectData, "name")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesNameFieldStartsWithArduino checks if the library.properties "name" value starts with "Arduino".
-func LibraryPropertiesNameFieldStartsWithArduino() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+func LibraryPropertiesNameFieldStartsWithArduino(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notStartsWithArduino", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, name
+	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notStartsWithArduino", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, name, libraryPropertiesFieldFindings(projectData, "name")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesNameFieldMissingOfficialPrefix checks whether the library.properties `name` value uses the prefix required of all new official Arduino libraries.
-func LibraryPropertiesNameFieldMissingOfficialPrefix() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+func LibraryPropertiesNameFieldMissingOfficialPrefix(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
 	if strings.HasPrefix(name, "Arduino_") {
-		return ruleresult.Pass, ""
+		return ruleresult.Pass, "", nil
 	}
-	return ruleresult.Fail, name
+	return ruleresult.Fail, name, libraryPropertiesFieldFindings(projectData, "name")
 }
 
 // LibraryPropertiesNameFieldContainsArduino checks if the library.properties "name" value contains "Arduino".
-func LibraryPropertiesNameFieldContainsArduino() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+func LibraryPropertiesNameFieldContainsArduino(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notContainsArduino", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, name
+	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notContainsArduino", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, name, libraryPropertiesFieldFindings(projectData, "name")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesNameFieldHasSpaces checks if the library.properties "name" value contains spaces.
-func LibraryPropertiesNameFieldHasSpaces() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+func LibraryPropertiesNameFieldHasSpaces(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notContainsSpaces", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, name
+	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notContainsSpaces", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, name, libraryPropertiesFieldFindings(projectData, "name")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesNameFieldContainsLibrary checks if the library.properties "name" value contains "library".
-func LibraryPropertiesNameFieldContainsLibrary() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	name, ok := projectdata.LibraryProperties().GetOk("name")
+func LibraryPropertiesNameFieldContainsLibrary(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	name, ok := projectData.LibraryProperties().GetOk("name")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notContainsSuperfluousTerms", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, name
+	if schema.ValidationErrorMatch("^#/name$", "/patternObjects/notContainsSuperfluousTerms", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, name, libraryPropertiesFieldFindings(projectData, "name")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesNameFieldDuplicate checks whether there is an existing entry in the Library Manager index using the library.properties `name` value.
-func LibraryPropertiesNameFieldDuplicate() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
+func LibraryPropertiesNameFieldDuplicate(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	name, hasName := projectData.LibraryProperties().GetOk("name")
+	if !hasName {
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	name, hasName := projectdata.LibraryProperties().GetOk("name")
-	if !hasName {
-		return ruleresult.NotRun, "Field not present"
+	// There is no rule for the Library Manager index to depend on, since it is not part of the project.
+	if projectData.LibraryManagerIndexLoadError() != nil {
+		return ruleresult.NotRun, projectData.LibraryManagerIndexLoadError().Error(), nil
 	}
 
-	if nameInLibraryManagerIndex(name) {
-		return ruleresult.Fail, name
+	if nameInLibraryManagerIndex(projectData, name) {
+		return ruleresult.Fail, name, libraryPropertiesFieldFindings(projectData, "name")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesNameFieldNotInIndex checks whether there is no existing entry in the Library Manager index using the library.properties `name` value.
-func LibraryPropertiesNameFieldNotInIndex() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
+func LibraryPropertiesNameFieldNotInIndex(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	name, hasName := projectData.LibraryProperties().GetOk("name")
+	if !hasName {
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	name, hasName := projectdata.LibraryProperties().GetOk("name")
-	if !hasName {
-		return ruleresult.NotRun, "Field not present"
+	// There is no rule for the Library Manager index to depend on, since it is not part of the project.
+	if projectData.LibraryManagerIndexLoadError() != nil {
+		return ruleresult.NotRun, projectData.LibraryManagerIndexLoadError().Error(), nil
 	}
 
-	if nameInLibraryManagerIndex(name) {
-		return ruleresult.Pass, ""
+	if nameInLibraryManagerIndex(projectData, name) {
+		return ruleresult.Pass, "", nil
 	}
 
-	return ruleresult.Fail, name
+	return ruleresult.Fail, name, libraryPropertiesFieldFindings(projectData, "name")
 }
 
 // LibraryPropertiesVersionFieldMissing checks for missing library.properties "version" field.
-func LibraryPropertiesVersionFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
+func LibraryPropertiesVersionFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() != nil && projectData.LoadedLibrary().IsLegacy {
+		return ruleresult.Skip, "Library has legacy format", nil
 	}
 
-	if projectdata.LoadedLibrary() != nil && projectdata.LoadedLibrary().IsLegacy {
-		return ruleresult.Skip, "Library has legacy format"
+	if schema.RequiredPropertyMissing("version", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "version")
 	}
-
-	if schema.RequiredPropertyMissing("version", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesVersionFieldNonRelaxedSemver checks whether the library.properties "version" value is "relaxed semver" compliant.
-func LibraryPropertiesVersionFieldNonRelaxedSemver() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	version, ok := projectdata.LibraryProperties().GetOk("version")
+func LibraryPropertiesVersionFieldNonRelaxedSemver(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	version, ok := projectData.LibraryProperties().GetOk("version")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.PropertyPatternMismatch("version", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, version
+	if schema.PropertyPatternMismatch("version", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, version, libraryPropertiesFieldFindings(projectData, "version")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesVersionFieldNonSemver checks whether the library.properties "version" value is semver compliant.
-func LibraryPropertiesVersionFieldNonSemver() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	version, ok := projectdata.LibraryProperties().GetOk("version")
+func LibraryPropertiesVersionFieldNonSemver(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	version, ok := projectData.LibraryProperties().GetOk("version")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.PropertyPatternMismatch("version", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, version
+	if schema.PropertyPatternMismatch("version", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, version, libraryPropertiesFieldFindings(projectData, "version")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesVersionFieldBehindTag checks whether a release tag was made without first bumping the library.properties version value.
-func LibraryPropertiesVersionFieldBehindTag() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	versionString, ok := projectdata.LibraryProperties().GetOk("version")
+func LibraryPropertiesVersionFieldBehindTag(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	versionString, ok := projectData.LibraryProperties().GetOk("version")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
 	version, err := semver.Parse(versionString)
 	if err != nil {
-		return ruleresult.NotRun, "Can't parse version value"
+		return ruleresult.NotRun, "Can't parse version value", nil
 	}
 	logrus.Tracef("version value: %s", version)
 
-	repository, err := git.PlainOpen(projectdata.ProjectPath().String())
+	repository, err := git.PlainOpen(projectData.ProjectPath().String())
 	if err != nil {
-		return ruleresult.Skip, "Project path is not a repository"
+		return ruleresult.Skip, "Project path is not a repository", nil
 	}
 
 	headRef, err := repository.Head()
@@ -648,417 +592,329 @@ func LibraryPropertiesVersionFieldBehindTag() (result ruleresult.Type, output st
 						break
 					}
 
-					return ruleresult.Fail, fmt.Sprintf("%s vs %s", tagName, versionString)
+					return ruleresult.Fail, fmt.Sprintf("%s vs %s", tagName, versionString), libraryPropertiesFieldFindings(projectData, "version")
 				}
 
-				return ruleresult.Pass, "" // Tag is less than or equal to version field value, all is well.
+				return ruleresult.Pass, "", nil // Tag is less than or equal to version field value, all is well.
 			}
 		}
 	}
 
-	return ruleresult.Pass, "" // No problems were found.
+	return ruleresult.Pass, "", nil // No problems were found.
 }
 
 // LibraryPropertiesAuthorFieldMissing checks for missing library.properties "author" field.
-func LibraryPropertiesAuthorFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	if projectdata.LoadedLibrary() != nil && projectdata.LoadedLibrary().IsLegacy {
-		return ruleresult.Skip, "Library has legacy format"
+func LibraryPropertiesAuthorFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() != nil && projectData.LoadedLibrary().IsLegacy {
+		return ruleresult.Skip, "Library has legacy format", nil
 	}
 
-	if schema.RequiredPropertyMissing("author", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("author", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "author")
 	}
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesAuthorFieldLTMinLength checks if the library.properties "author" value is less than the minimum length.
-func LibraryPropertiesAuthorFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
+func LibraryPropertiesAuthorFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.LibraryProperties().ContainsKey("author") {
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("author") {
-		return ruleresult.NotRun, "Field not present"
+	if schema.PropertyLessThanMinLength("author", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "author")
 	}
 
-	if schema.PropertyLessThanMinLength("author", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesMaintainerFieldMissing checks for missing library.properties "maintainer" field.
-func LibraryPropertiesMaintainerFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	if projectdata.LoadedLibrary() != nil && projectdata.LoadedLibrary().IsLegacy {
-		return ruleresult.Skip, "Library has legacy format"
+func LibraryPropertiesMaintainerFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() != nil && projectData.LoadedLibrary().IsLegacy {
+		return ruleresult.Skip, "Library has legacy format", nil
 	}
 
-	if schema.RequiredPropertyMissing("maintainer", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("maintainer", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "maintainer")
 	}
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesMaintainerFieldLTMinLength checks if the library.properties "maintainer" value is less than the minimum length.
-func LibraryPropertiesMaintainerFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	if !projectdata.LibraryProperties().ContainsKey("maintainer") {
-		return ruleresult.NotRun, "Field not present"
+func LibraryPropertiesMaintainerFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.LibraryProperties().ContainsKey("maintainer") {
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.PropertyLessThanMinLength("maintainer", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyLessThanMinLength("maintainer", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "maintainer")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesMaintainerFieldStartsWithArduino checks if the library.properties "maintainer" value starts with "Arduino".
-func LibraryPropertiesMaintainerFieldStartsWithArduino() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	maintainer, ok := projectdata.LibraryProperties().GetOk("maintainer")
+func LibraryPropertiesMaintainerFieldStartsWithArduino(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	maintainer, ok := projectData.LibraryProperties().GetOk("maintainer")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.ValidationErrorMatch("^#/maintainer$", "/patternObjects/notStartsWithArduino", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, maintainer
+	if schema.ValidationErrorMatch("^#/maintainer$", "/patternObjects/notStartsWithArduino", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, maintainer, libraryPropertiesFieldFindings(projectData, "maintainer")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesMaintainerFieldContainsArduino checks if the library.properties "maintainer" value contains "Arduino".
-func LibraryPropertiesMaintainerFieldContainsArduino() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	maintainer, ok := projectdata.LibraryProperties().GetOk("maintainer")
+func LibraryPropertiesMaintainerFieldContainsArduino(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	maintainer, ok := projectData.LibraryProperties().GetOk("maintainer")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.ValidationErrorMatch("^#/maintainer$", "/patternObjects/notContainsArduino", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, maintainer
+	if schema.ValidationErrorMatch("^#/maintainer$", "/patternObjects/notContainsArduino", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, maintainer, libraryPropertiesFieldFindings(projectData, "maintainer")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesEmailFieldAsMaintainerAlias checks whether the library.properties "email" field is being used as an alias for the "maintainer" field.
-func LibraryPropertiesEmailFieldAsMaintainerAlias() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
+func LibraryPropertiesEmailFieldAsMaintainerAlias(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.LibraryProperties().ContainsKey("email") {
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("email") {
-		return ruleresult.Skip, "Field not present"
+	if !projectData.LibraryProperties().ContainsKey("maintainer") {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "email")
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("maintainer") {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesEmailFieldLTMinLength checks if the library.properties "email" value is less than the minimum length.
-func LibraryPropertiesEmailFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	if projectdata.LibraryProperties().ContainsKey("maintainer") || !projectdata.LibraryProperties().ContainsKey("email") {
-		return ruleresult.Skip, "Field not present"
+func LibraryPropertiesEmailFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LibraryProperties().ContainsKey("maintainer") || !projectData.LibraryProperties().ContainsKey("email") {
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	if schema.PropertyLessThanMinLength("email", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyLessThanMinLength("email", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "email")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesEmailFieldStartsWithArduino checks if the library.properties "email" value starts with "Arduino".
-func LibraryPropertiesEmailFieldStartsWithArduino() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	if projectdata.LibraryProperties().ContainsKey("maintainer") {
-		return ruleresult.Skip, "No email alias field"
+func LibraryPropertiesEmailFieldStartsWithArduino(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LibraryProperties().ContainsKey("maintainer") {
+		return ruleresult.Skip, "No email alias field", nil
 	}
 
-	email, ok := projectdata.LibraryProperties().GetOk("email")
+	email, ok := projectData.LibraryProperties().GetOk("email")
 	if !ok {
-		return ruleresult.Skip, "Field not present"
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	if schema.ValidationErrorMatch("^#/email$", "/patternObjects/notStartsWithArduino", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, email
+	if schema.ValidationErrorMatch("^#/email$", "/patternObjects/notStartsWithArduino", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, email, libraryPropertiesFieldFindings(projectData, "email")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesSentenceFieldMissing checks for missing library.properties "sentence" field.
-func LibraryPropertiesSentenceFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
+func LibraryPropertiesSentenceFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() != nil && projectData.LoadedLibrary().IsLegacy {
+		return ruleresult.Skip, "Library has legacy format", nil
 	}
 
-	if projectdata.LoadedLibrary() != nil && projectdata.LoadedLibrary().IsLegacy {
-		return ruleresult.Skip, "Library has legacy format"
+	if schema.RequiredPropertyMissing("sentence", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "sentence")
 	}
-
-	if schema.RequiredPropertyMissing("sentence", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesSentenceFieldLTMinLength checks if the library.properties "sentence" value is less than the minimum length.
-func LibraryPropertiesSentenceFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	if !projectdata.LibraryProperties().ContainsKey("sentence") {
-		return ruleresult.NotRun, "Field not present"
+func LibraryPropertiesSentenceFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.LibraryProperties().ContainsKey("sentence") {
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.PropertyLessThanMinLength("sentence", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyLessThanMinLength("sentence", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "sentence")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesSentenceFieldSpellCheck checks for commonly misspelled words in the library.properties `sentence` field value.
-func LibraryPropertiesSentenceFieldSpellCheck() (result ruleresult.Type, output string) {
-	return spellCheckLibraryPropertiesFieldValue("sentence")
+func LibraryPropertiesSentenceFieldSpellCheck(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	return spellCheckLibraryPropertiesFieldValue(projectData, "sentence")
 }
 
 // LibraryPropertiesParagraphFieldMissing checks for missing library.properties "paragraph" field.
-func LibraryPropertiesParagraphFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
+func LibraryPropertiesParagraphFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() != nil && projectData.LoadedLibrary().IsLegacy {
+		return ruleresult.Skip, "Library has legacy format", nil
 	}
 
-	if projectdata.LoadedLibrary() != nil && projectdata.LoadedLibrary().IsLegacy {
-		return ruleresult.Skip, "Library has legacy format"
+	if schema.RequiredPropertyMissing("paragraph", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "paragraph")
 	}
-
-	if schema.RequiredPropertyMissing("paragraph", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesParagraphFieldSpellCheck checks for commonly misspelled words in the library.properties `paragraph` field value.
-func LibraryPropertiesParagraphFieldSpellCheck() (result ruleresult.Type, output string) {
-	return spellCheckLibraryPropertiesFieldValue("paragraph")
+func LibraryPropertiesParagraphFieldSpellCheck(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	return spellCheckLibraryPropertiesFieldValue(projectData, "paragraph")
 }
 
 // LibraryPropertiesParagraphFieldRepeatsSentence checks whether the library.properties `paragraph` value repeats the `sentence` value.
-func LibraryPropertiesParagraphFieldRepeatsSentence() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	sentence, hasSentence := projectdata.LibraryProperties().GetOk("sentence")
-	paragraph, hasParagraph := projectdata.LibraryProperties().GetOk("paragraph")
+func LibraryPropertiesParagraphFieldRepeatsSentence(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	sentence, hasSentence := projectData.LibraryProperties().GetOk("sentence")
+	paragraph, hasParagraph := projectData.LibraryProperties().GetOk("paragraph")
 
 	if !hasSentence || !hasParagraph {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
 	if strings.HasPrefix(paragraph, sentence) {
-		return ruleresult.Fail, ""
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "paragraph")
 	}
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesCategoryFieldMissing checks for missing library.properties "category" field.
-func LibraryPropertiesCategoryFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	if projectdata.LoadedLibrary() != nil && projectdata.LoadedLibrary().IsLegacy {
-		return ruleresult.Skip, "Library has legacy format"
+func LibraryPropertiesCategoryFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() != nil && projectData.LoadedLibrary().IsLegacy {
+		return ruleresult.Skip, "Library has legacy format", nil
 	}
 
-	if schema.RequiredPropertyMissing("category", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("category", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "category")
 	}
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesCategoryFieldInvalid checks for invalid category in the library.properties "category" field.
-func LibraryPropertiesCategoryFieldInvalid() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	category, ok := projectdata.LibraryProperties().GetOk("category")
+func LibraryPropertiesCategoryFieldInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	category, ok := projectData.LibraryProperties().GetOk("category")
 	if !ok {
-		return ruleresult.Skip, "Field not present"
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	if schema.PropertyEnumMismatch("category", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, category
+	if schema.PropertyEnumMismatch("category", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, category, libraryPropertiesFieldFindings(projectData, "category")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesCategoryFieldUncategorized checks whether the library.properties "category" value is "Uncategorized".
-func LibraryPropertiesCategoryFieldUncategorized() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	category, ok := projectdata.LibraryProperties().GetOk("category")
+func LibraryPropertiesCategoryFieldUncategorized(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	category, ok := projectData.LibraryProperties().GetOk("category")
 	if !ok {
-		return ruleresult.Skip, "Field not present"
+		return ruleresult.Skip, "Field not present", nil
 	}
 
 	if category == "Uncategorized" {
-		return ruleresult.Fail, ""
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "category")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesURLFieldMissing checks for missing library.properties "url" field.
-func LibraryPropertiesURLFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	if projectdata.LoadedLibrary() != nil && projectdata.LoadedLibrary().IsLegacy {
-		return ruleresult.Skip, "Library has legacy format"
+func LibraryPropertiesURLFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() != nil && projectData.LoadedLibrary().IsLegacy {
+		return ruleresult.Skip, "Library has legacy format", nil
 	}
 
-	if schema.RequiredPropertyMissing("url", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("url", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "url")
 	}
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesURLFieldLTMinLength checks if the library.properties "url" value is less than the minimum length.
-func LibraryPropertiesURLFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
+func LibraryPropertiesURLFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.LibraryProperties().ContainsKey("url") {
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("url") {
-		return ruleresult.NotRun, "Field not present"
+	if schema.PropertyLessThanMinLength("url", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Permissive]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "url")
 	}
 
-	if schema.PropertyLessThanMinLength("url", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Permissive]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesURLFieldInvalid checks whether the library.properties "url" value has a valid URL format.
-func LibraryPropertiesURLFieldInvalid() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	url, ok := projectdata.LibraryProperties().GetOk("url")
+func LibraryPropertiesURLFieldInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	url, ok := projectData.LibraryProperties().GetOk("url")
 	if !ok {
-		return ruleresult.NotRun, "Field not present"
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	if schema.ValidationErrorMatch("^#/url$", "/format$", "", "", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, url
+	if schema.ValidationErrorMatch("^#/url$", "/format$", "", "", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, url, libraryPropertiesFieldFindings(projectData, "url")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesURLFieldDeadLink checks whether the URL in the library.properties `url` field can be loaded.
-func LibraryPropertiesURLFieldDeadLink() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
+func LibraryPropertiesURLFieldDeadLink(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	url, ok := projectData.LibraryProperties().GetOk("url")
+	if !ok {
+		return ruleresult.NotRun, "Field not present", nil
 	}
 
-	url, ok := projectdata.LibraryProperties().GetOk("url")
-	if !ok {
-		return ruleresult.NotRun, "Field not present"
+	if projectData.ToolConfiguration().Offline() {
+		return ruleresult.NotRun, offlineOutput, nil
 	}
 
 	err := checkURL(url)
 	if err != nil {
-		return ruleresult.Fail, err.Error()
+		return ruleresult.Fail, err.Error(), libraryPropertiesFieldFindings(projectData, "url")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesArchitecturesFieldMissing checks for missing library.properties "architectures" field.
-func LibraryPropertiesArchitecturesFieldMissing() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	if projectdata.LoadedLibrary() != nil && projectdata.LoadedLibrary().IsLegacy {
-		return ruleresult.Skip, "Library has legacy format"
+func LibraryPropertiesArchitecturesFieldMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() != nil && projectData.LoadedLibrary().IsLegacy {
+		return ruleresult.Skip, "Library has legacy format", nil
 	}
 
-	if schema.RequiredPropertyMissing("architectures", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("architectures", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "architectures")
 	}
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesArchitecturesFieldLTMinLength checks if the library.properties "architectures" value is less than the minimum length.
-func LibraryPropertiesArchitecturesFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	if !projectdata.LibraryProperties().ContainsKey("architectures") {
-		return ruleresult.Skip, "Field not present"
+func LibraryPropertiesArchitecturesFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.LibraryProperties().ContainsKey("architectures") {
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	if schema.PropertyLessThanMinLength("architectures", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyLessThanMinLength("architectures", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "architectures")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesArchitecturesFieldSoloAlias checks whether an alias architecture name is present, but not its true Arduino architecture name.
-func LibraryPropertiesArchitecturesFieldSoloAlias() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	architectures, ok := projectdata.LibraryProperties().GetOk("architectures")
+func LibraryPropertiesArchitecturesFieldSoloAlias(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	architectures, ok := projectData.LibraryProperties().GetOk("architectures")
 	if !ok {
-		return ruleresult.Skip, "Field not present"
+		return ruleresult.Skip, "Field not present", nil
 	}
 
 	architecturesList := commaSeparatedToList(strings.ToLower(architectures))
@@ -1096,21 +952,17 @@ func LibraryPropertiesArchitecturesFieldSoloAlias() (result ruleresult.Type, out
 	}
 
 	if len(soloAliases) > 0 {
-		return ruleresult.Fail, strings.Join(soloAliases, ", ")
+		return ruleresult.Fail, strings.Join(soloAliases, ", "), libraryPropertiesFieldFindings(projectData, "architectures")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesArchitecturesFieldValueCase checks for incorrect case of common architectures.
-func LibraryPropertiesArchitecturesFieldValueCase() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	architectures, ok := projectdata.LibraryProperties().GetOk("architectures")
+func LibraryPropertiesArchitecturesFieldValueCase(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	architectures, ok := projectData.LibraryProperties().GetOk("architectures")
 	if !ok {
-		return ruleresult.Skip, "Field not present"
+		return ruleresult.Skip, "Field not present", nil
 	}
 
 	architecturesList := commaSeparatedToList(architectures)
@@ -1166,39 +1018,36 @@ func LibraryPropertiesArchitecturesFieldValueCase() (result ruleresult.Type, out
 	}
 
 	if len(miscasedArchitectures) > 0 {
-		return ruleresult.Fail, strings.Join(miscasedArchitectures, ", ")
+		return ruleresult.Fail, strings.Join(miscasedArchitectures, ", "), libraryPropertiesFieldFindings(projectData, "architectures")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesDependsFieldInvalidFormat checks for the library.properties "depends" field having an invalid format.
-func LibraryPropertiesDependsFieldInvalidFormat() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	depends, ok := projectdata.LibraryProperties().GetOk("depends")
+func LibraryPropertiesDependsFieldInvalidFormat(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	depends, ok := projectData.LibraryProperties().GetOk("depends")
 	if !ok {
-		return ruleresult.Skip, "Field not present"
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	if schema.PropertyPatternMismatch("depends", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, depends
+	if schema.PropertyPatternMismatch("depends", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, depends, libraryPropertiesFieldFindings(projectData, "depends")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesDependsFieldNotInIndex checks whether the libraries listed in the library.properties `depends` field are in the Library Manager index.
-func LibraryPropertiesDependsFieldNotInIndex() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
+func LibraryPropertiesDependsFieldNotInIndex(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	depends, hasDepends := projectData.LibraryProperties().GetOk("depends")
+	if !hasDepends {
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	depends, hasDepends := projectdata.LibraryProperties().GetOk("depends")
-	if !hasDepends {
-		return ruleresult.Skip, "Field not present"
+	// There is no rule for the Library Manager index to depend on, since it is not part of the project.
+	if projectData.LibraryManagerIndexLoadError() != nil {
+		return ruleresult.NotRun, projectData.LibraryManagerIndexLoadError().Error(), nil
 	}
 
 	dependencies := libDependencies(depends)
@@ -1212,7 +1061,7 @@ func LibraryPropertiesDependsFieldNotInIndex() (result ruleresult.Type, output s
 
 		logrus.Tracef("Checking if dependency %s is in index.", dependency.depend)
 		// Get all releases of the dependency
-		library := projectdata.LibraryManagerIndex().Index.FindIndexedLibrary(&libraries.Library{Name: dependency.data.GetName()})
+		library := projectData.LibraryManagerIndex().Index.FindIndexedLibrary(&libraries.Library{Name: dependency.data.GetName()})
 		if library == nil {
 			logrus.Tracef("Dependency is not in the index.")
 			dependsNotInIndex = append(dependsNotInIndex, dependency.depend)
@@ -1233,22 +1082,18 @@ func LibraryPropertiesDependsFieldNotInIndex() (result ruleresult.Type, output s
 	}
 
 	if len(dependsNotInIndex) > 0 {
-		return ruleresult.Fail, strings.Join(dependsNotInIndex, ", ")
+		return ruleresult.Fail, strings.Join(dependsNotInIndex, ", "), libraryPropertiesFieldFindings(projectData, "depends")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesDependsFieldConstraintInvalid checks whether the syntax of the version constraints in the
 // library.properties `depends` field is valid.
-func LibraryPropertiesDependsFieldConstraintInvalid() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	depends, hasDepends := projectdata.LibraryProperties().GetOk("depends")
+func LibraryPropertiesDependsFieldConstraintInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	depends, hasDepends := projectData.LibraryProperties().GetOk("depends")
 	if !hasDepends {
-		return ruleresult.Skip, "Field not present"
+		return ruleresult.Skip, "Field not present", nil
 	}
 
 	dependencies := libDependencies(depends)
@@ -1261,73 +1106,61 @@ func LibraryPropertiesDependsFieldConstraintInvalid() (result ruleresult.Type, o
 	}
 
 	if len(nonCompliant) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliant, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliant, ", "), libraryPropertiesFieldFindings(projectData, "depends")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesDotALinkageFieldInvalid checks for invalid value in the library.properties "dot_a_linkage" field.
-func LibraryPropertiesDotALinkageFieldInvalid() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load library.properties"
-	}
-
-	dotALinkage, ok := projectdata.LibraryProperties().GetOk("dot_a_linkage")
+func LibraryPropertiesDotALinkageFieldInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	dotALinkage, ok := projectData.LibraryProperties().GetOk("dot_a_linkage")
 	if !ok {
-		return ruleresult.Skip, "Field not present"
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	if schema.PropertyEnumMismatch("dot_a_linkage", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, dotALinkage
+	if schema.PropertyEnumMismatch("dot_a_linkage", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, dotALinkage, libraryPropertiesFieldFindings(projectData, "dot_a_linkage")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesDotALinkageFieldTrueWithFlatLayout checks whether a library using the "dot_a_linkage" feature has the required recursive layout type.
-func LibraryPropertiesDotALinkageFieldTrueWithFlatLayout() (result ruleresult.Type, output string) {
-	if projectdata.LoadedLibrary() == nil {
-		return ruleresult.NotRun, "Library not loaded"
+func LibraryPropertiesDotALinkageFieldTrueWithFlatLayout(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() == nil {
+		return ruleresult.NotRun, "Library not loaded", nil
 	}
 
-	if !projectdata.LibraryProperties().ContainsKey("dot_a_linkage") {
-		return ruleresult.Skip, "Field not present"
+	if !projectData.LibraryProperties().ContainsKey("dot_a_linkage") {
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	if projectdata.LoadedLibrary().DotALinkage && projectdata.LoadedLibrary().Layout == libraries.FlatLayout {
-		return ruleresult.Fail, ""
+	if projectData.LoadedLibrary().DotALinkage && projectData.LoadedLibrary().Layout == libraries.FlatLayout {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "dot_a_linkage")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesIncludesFieldLTMinLength checks if the library.properties "includes" value is less than the minimum length.
-func LibraryPropertiesIncludesFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Library not loaded"
-	}
-
-	if !projectdata.LibraryProperties().ContainsKey("includes") {
-		return ruleresult.Skip, "Field not present"
+func LibraryPropertiesIncludesFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.LibraryProperties().ContainsKey("includes") {
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	if schema.PropertyLessThanMinLength("includes", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyLessThanMinLength("includes", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "includes")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesIncludesFieldItemNotFound checks whether the header files specified in the library.properties `includes` field are in the library.
-func LibraryPropertiesIncludesFieldItemNotFound() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Library not loaded"
-	}
-
-	includes, ok := projectdata.LibraryProperties().GetOk("includes")
+func LibraryPropertiesIncludesFieldItemNotFound(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	includes, ok := projectData.LibraryProperties().GetOk("includes")
 	if !ok {
-		return ruleresult.Skip, "Field not present"
+		return ruleresult.Skip, "Field not present", nil
 	}
 
 	includesList := commaSeparatedToList(includes)
@@ -1336,7 +1169,7 @@ func LibraryPropertiesIncludesFieldItemNotFound() (result ruleresult.Type, outpu
 		if include == "" {
 			return true
 		}
-		for _, header := range projectdata.SourceHeaders() {
+		for _, header := range projectData.SourceHeaders() {
 			logrus.Tracef("Comparing include %s with header file %s", include, header)
 			if include == header {
 				logrus.Tracef("match!")
@@ -1354,87 +1187,76 @@ func LibraryPropertiesIncludesFieldItemNotFound() (result ruleresult.Type, outpu
 	}
 
 	if len(includesNotInLibrary) > 0 {
-		return ruleresult.Fail, strings.Join(includesNotInLibrary, ", ")
+		return ruleresult.Fail, strings.Join(includesNotInLibrary, ", "), libraryPropertiesFieldFindings(projectData, "includes")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesPrecompiledFieldInvalid checks for invalid value in the library.properties "precompiled" field.
-func LibraryPropertiesPrecompiledFieldInvalid() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Library not loaded"
-	}
-
-	precompiled, ok := projectdata.LibraryProperties().GetOk("precompiled")
+func LibraryPropertiesPrecompiledFieldInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	precompiled, ok := projectData.LibraryProperties().GetOk("precompiled")
 	if !ok {
-		return ruleresult.Skip, "Field not present"
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	if schema.PropertyEnumMismatch("precompiled", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, precompiled
+	if schema.PropertyEnumMismatch("precompiled", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, precompiled, libraryPropertiesFieldFindings(projectData, "precompiled")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesPrecompiledFieldEnabledWithFlatLayout checks whether a precompiled library has the required recursive layout type.
-func LibraryPropertiesPrecompiledFieldEnabledWithFlatLayout() (result ruleresult.Type, output string) {
-	if projectdata.LoadedLibrary() == nil || projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Library not loaded"
+func LibraryPropertiesPrecompiledFieldEnabledWithFlatLayout(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.LoadedLibrary() == nil {
+		return ruleresult.NotRun, "Library not loaded", nil
 	}
 
-	precompiled, ok := projectdata.LibraryProperties().GetOk("precompiled")
+	precompiled, ok := projectData.LibraryProperties().GetOk("precompiled")
 	if !ok {
-		return ruleresult.Skip, "Field not present"
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	if projectdata.LoadedLibrary().Precompiled && projectdata.LoadedLibrary().Layout == libraries.FlatLayout {
-		return ruleresult.Fail, precompiled
+	if projectData.LoadedLibrary().Precompiled && projectData.LoadedLibrary().Layout == libraries.FlatLayout {
+		return ruleresult.Fail, precompiled, libraryPropertiesFieldFindings(projectData, "precompiled")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesLdflagsFieldLTMinLength checks if the library.properties "ldflags" value is less than the minimum length.
-func LibraryPropertiesLdflagsFieldLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Library not loaded"
-	}
-
-	if !projectdata.LibraryProperties().ContainsKey("ldflags") {
-		return ruleresult.Skip, "Field not present"
+func LibraryPropertiesLdflagsFieldLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.LibraryProperties().ContainsKey("ldflags") {
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	if schema.PropertyLessThanMinLength("ldflags", projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyLessThanMinLength("ldflags", projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "ldflags")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryPropertiesMisspelledOptionalField checks if library.properties contains common misspellings of optional fields.
-func LibraryPropertiesMisspelledOptionalField() (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Library not loaded"
+func LibraryPropertiesMisspelledOptionalField(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if schema.MisspelledOptionalPropertyFound(projectData.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", libraryPropertiesFieldFindings(projectData, "misspelledoptional")
 	}
 
-	if schema.MisspelledOptionalPropertyFound(projectdata.LibraryPropertiesSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // LibraryHasStraySketches checks for sketches outside the `examples` and `extras` folders.
-func LibraryHasStraySketches() (result ruleresult.Type, output string) {
+func LibraryHasStraySketches(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	straySketchPaths := []string{}
-	if sketch.ContainsMainSketchFile(projectdata.ProjectPath()) { // Check library root.
-		straySketchPaths = append(straySketchPaths, projectdata.ProjectPath().String())
+	if sketch.ContainsMainSketchFile(projectData.ProjectPath()) { // Check library root.
+		straySketchPaths = append(straySketchPaths, projectData.ProjectPath().String())
+		findings = append(findings, Finding{Path: projectData.ProjectPath()})
 	}
 
 	// Check subfolders.
-	projectPathListing, err := projectdata.ProjectPath().ReadDir()
+	projectPathListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -1454,21 +1276,22 @@ func LibraryHasStraySketches() (result ruleresult.Type, output string) {
 		for _, subfolder := range topLevelSubfolderRecursiveListing {
 			if sketch.ContainsMainSketchFile(subfolder) {
 				straySketchPaths = append(straySketchPaths, subfolder.String())
+				findings = append(findings, Finding{Path: subfolder, Subject: subfolder.String()})
 			}
 		}
 	}
 
 	if len(straySketchPaths) > 0 {
-		return ruleresult.Fail, brokenOutputList(straySketchPaths)
+		return ruleresult.Fail, brokenOutputList(straySketchPaths), findings
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // MissingExamples checks whether the library is missing examples.
-func MissingExamples() (result ruleresult.Type, output string) {
+func MissingExamples(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	for _, examplesFolderName := range library.ExamplesFolderSupportedNames() {
-		examplesPath := projectdata.ProjectPath().Join(examplesFolderName)
+		examplesPath := projectData.ProjectPath().Join(examplesFolderName)
 
 		exists, err := examplesPath.ExistCheck()
 		if err != nil {
@@ -1490,17 +1313,17 @@ func MissingExamples() (result ruleresult.Type, output string) {
 		directoryListing.FilterDirs()
 		for _, potentialExamplePath := range directoryListing {
 			if sketch.ContainsMainSketchFile(potentialExamplePath) {
-				return ruleresult.Pass, ""
+				return ruleresult.Pass, "", nil
 			}
 		}
 	}
 
-	return ruleresult.Fail, ""
+	return ruleresult.Fail, "", nil
 }
 
 // MisspelledExamplesFolderName checks for incorrectly spelled `examples` folder name.
-func MisspelledExamplesFolderName() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func MisspelledExamplesFolderName(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -1508,15 +1331,15 @@ func MisspelledExamplesFolderName() (result ruleresult.Type, output string) {
 
 	path, found := containsMisspelledPathBaseName(directoryListing, "examples", "(?i)^e((x)|(xs)|(s))((am)|(ma))p((le)|(el))s?$")
 	if found {
-		return ruleresult.Fail, path.String()
+		return ruleresult.Fail, path.String(), []Finding{{Path: path}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // IncorrectExamplesFolderNameCase checks for incorrect `examples` folder name case.
-func IncorrectExamplesFolderNameCase() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func IncorrectExamplesFolderNameCase(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -1524,35 +1347,36 @@ func IncorrectExamplesFolderNameCase() (result ruleresult.Type, output string) {
 
 	path, found := containsIncorrectPathBaseCase(directoryListing, "examples")
 	if found {
-		return ruleresult.Fail, path.String()
+		return ruleresult.Fail, path.String(), []Finding{{Path: path}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // nameInLibraryManagerIndex returns whether there is a library in Library Manager index using the given name.
-func nameInLibraryManagerIndex(name string) bool {
-	library := projectdata.LibraryManagerIndex().Index.FindIndexedLibrary(&libraries.Library{Name: name})
+func nameInLibraryManagerIndex(projectData *projectdata.Type, name string) bool {
+	library := projectData.LibraryManagerIndex().Index.FindIndexedLibrary(&libraries.Library{Name: name})
 	return library != nil
 }
 
 // spellCheckLibraryPropertiesFieldValue returns the value of the provided library.properties field with commonly misspelled words corrected.
-func spellCheckLibraryPropertiesFieldValue(fieldName string) (result ruleresult.Type, output string) {
-	if projectdata.LibraryPropertiesLoadError() != nil {
-		return ruleresult.NotRun, "Library not loaded"
-	}
-
-	fieldValue, ok := projectdata.LibraryProperties().GetOk(fieldName)
+func spellCheckLibraryPropertiesFieldValue(projectData *projectdata.Type, fieldName string) (result ruleresult.Type, output string, findings []Finding) {
+	fieldValue, ok := projectData.LibraryProperties().GetOk(fieldName)
 	if !ok {
-		return ruleresult.Skip, "Field not present"
+		return ruleresult.Skip, "Field not present", nil
 	}
 
-	replaced, diff := projectdata.MisspelledWordsReplacer().Replace(fieldValue)
+	replaced, diff := projectData.MisspelledWordsReplacer().Replace(fieldValue)
 	if len(diff) > 0 {
-		return ruleresult.Fail, replaced
+		return ruleresult.Fail, replaced, libraryPropertiesFieldFindings(projectData, fieldName)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
+}
+
+// libraryPropertiesFieldFindings returns the location of the given field in library.properties.
+func libraryPropertiesFieldFindings(projectData *projectdata.Type, fieldName string) []Finding {
+	return propertiesKeyFindings(projectData.ProjectPath().Join("library.properties"), fieldName)
 }
 
 // commaSeparatedToList returns the list equivalent of a comma-separated string.
@@ -62,9 +62,9 @@ func checkLibraryRuleFunction(ruleFunction Type, testTables []libraryRuleFunctio
 			SuperprojectType: projecttype.Library,
 		}
 
-		projectdata.Initialize(testProject)
+		projectData := projectdata.Initialize(testProject, testToolConfiguration, &testLibraryManagerIndex)
 
-		result, output := ruleFunction()
+		result, output, _ := ruleFunction(projectData)
 		assert.Equal(t, testTable.expectedRuleResult, result, testTable.testName)
 		assert.True(t, expectedOutputRegexp.MatchString(output), fmt.Sprintf("%s (output: %s, assertion regex: %s)", testTable.testName, output, testTable.expectedOutputQuery))
 	}
@@ -179,8 +179,6 @@ func TestLibraryHasExe(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldHeaderMismatch(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Mismatch", "NameHeaderMismatch", ruleresult.Fail, "^NameHeaderMismatch.h$"},
 		{"Match", "Recursive", ruleresult.Pass, ""},
 	}
@@ -279,7 +277,6 @@ func TestLibraryPropertiesFormat(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldMissing(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Field missing", "MissingFields", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
@@ -290,8 +287,6 @@ func TestLibraryPropertiesNameFieldMissing(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldLTMinLength(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Name field too short", "NameLTMinLength", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -301,8 +296,6 @@ func TestLibraryPropertiesNameFieldLTMinLength(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldGTMaxLength(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Name field too long", "NameGTMaxLength", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -312,8 +305,6 @@ func TestLibraryPropertiesNameFieldGTMaxLength(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldGTRecommendedLength(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Name field longer than recommended", "NameIsBiggerThanRecommendedLength", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -323,8 +314,6 @@ func TestLibraryPropertiesNameFieldGTRecommendedLength(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldDisallowedCharacters(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Name field has disallowed characters", "NameHasBadChars", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -334,8 +323,6 @@ func TestLibraryPropertiesNameFieldDisallowedCharacters(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldStartsWithArduino(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Name field starts with Arduino", "Arduino_Official", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -345,7 +332,6 @@ func TestLibraryPropertiesNameFieldStartsWithArduino(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldMissingOfficialPrefix(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Not defined", "MissingFields", ruleresult.NotRun, ""},
 		{"Correct prefix", "Arduino_Official", ruleresult.Pass, ""},
 		{"Incorrect prefix", "Recursive", ruleresult.Fail, "^Recursive$"},
@@ -356,8 +342,6 @@ func TestLibraryPropertiesNameFieldMissingOfficialPrefix(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldContainsArduino(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Name field contains Arduino", "NameContainsArduino", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -367,8 +351,6 @@ func TestLibraryPropertiesNameFieldContainsArduino(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldHasSpaces(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Name field contains spaces", "NameHasSpaces", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -378,8 +360,6 @@ func TestLibraryPropertiesNameFieldHasSpaces(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldContainsLibrary(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Name field contains library", "NameHasLibrary", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -389,8 +369,6 @@ func TestLibraryPropertiesNameFieldContainsLibrary(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldDuplicate(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Duplicate", "Indexed", ruleresult.Fail, "^Servo$"},
 		{"Not duplicate", "NotIndexed", ruleresult.Pass, ""},
 	}
@@ -400,8 +378,6 @@ func TestLibraryPropertiesNameFieldDuplicate(t *testing.T) {
 
 func TestLibraryPropertiesNameFieldNotInIndex(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"In index", "Indexed", ruleresult.Pass, ""},
 		{"Not in index", "NotIndexed", ruleresult.Fail, "^NotIndexed$"},
 	}
@@ -411,7 +387,6 @@ func TestLibraryPropertiesNameFieldNotInIndex(t *testing.T) {
 
 func TestLibraryPropertiesVersionFieldMissing(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Version field missing", "MissingFields", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
@@ -422,8 +397,6 @@ func TestLibraryPropertiesVersionFieldMissing(t *testing.T) {
 
 func TestLibraryPropertiesVersionFieldNonRelaxedSemver(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Version not relaxed semver compliant", "VersionNotRelaxedSemver", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -433,8 +406,6 @@ func TestLibraryPropertiesVersionFieldNonRelaxedSemver(t *testing.T) {
 
 func TestLibraryPropertiesVersionFieldNonSemver(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Version not semver compliant", "VersionNotSemver", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -527,8 +498,6 @@ func TestLibraryPropertiesVersionFieldBehindTag(t *testing.T) {
 	}
 
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Unparsable version", "VersionFormatInvalid", ruleresult.NotRun, ""},
 		{"Not repo", "Recursive", ruleresult.Skip, ""},
 		{"Tag name not a version", gitInitAndTag(t, TagNotVersionPath, "foo", true), ruleresult.Pass, ""},
@@ -547,7 +516,6 @@ func TestLibraryPropertiesVersionFieldBehindTag(t *testing.T) {
 
 func TestLibraryPropertiesAuthorFieldMissing(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Field missing", "MissingFields", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
@@ -558,8 +526,6 @@ func TestLibraryPropertiesAuthorFieldMissing(t *testing.T) {
 
 func TestLibraryPropertiesAuthorFieldLTMinLength(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Author field too short", "AuthorLTMinLength", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -569,7 +535,6 @@ func TestLibraryPropertiesAuthorFieldLTMinLength(t *testing.T) {
 
 func TestLibraryPropertiesMaintainerFieldMissing(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Field missing", "MissingFields", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
@@ -580,8 +545,6 @@ func TestLibraryPropertiesMaintainerFieldMissing(t *testing.T) {
 
 func TestLibraryPropertiesMaintainerFieldLTMinLength(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Maintainer field too short", "MaintainerLTMinLength", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -591,8 +554,6 @@ func TestLibraryPropertiesMaintainerFieldLTMinLength(t *testing.T) {
 
 func TestLibraryPropertiesMaintainerFieldStartsWithArduino(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Maintainer field starts w/ Arduino", "MaintainerStartsWithArduino", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -602,8 +563,6 @@ func TestLibraryPropertiesMaintainerFieldStartsWithArduino(t *testing.T) {
 
 func TestLibraryPropertiesMaintainerFieldContainsArduino(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Maintainer field contains Arduino", "MaintainerContainsArduino", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -613,7 +572,6 @@ func TestLibraryPropertiesMaintainerFieldContainsArduino(t *testing.T) {
 
 func TestLibraryPropertiesEmailFieldAsMaintainerAlias(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"No email field", "MissingFields", ruleresult.Skip, ""},
 		{"email in place of maintainer", "EmailOnly", ruleresult.Fail, ""},
 		{"email and maintainer", "EmailAndMaintainer", ruleresult.Pass, ""},
@@ -624,7 +582,6 @@ func TestLibraryPropertiesEmailFieldAsMaintainerAlias(t *testing.T) {
 
 func TestLibraryPropertiesEmailFieldLTMinLength(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Email field too short", "EmailLTMinLength", ruleresult.Fail, ""},
 		{"Valid", "EmailOnly", ruleresult.Pass, ""},
@@ -635,7 +592,6 @@ func TestLibraryPropertiesEmailFieldLTMinLength(t *testing.T) {
 
 func TestLibraryPropertiesEmailFieldStartsWithArduino(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Not an alias", "EmailAndMaintainer", ruleresult.Skip, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Email field starts w/ Arduino", "EmailStartsWithArduino", ruleresult.Fail, ""},
@@ -647,7 +603,6 @@ func TestLibraryPropertiesEmailFieldStartsWithArduino(t *testing.T) {
 
 func TestLibraryPropertiesSentenceFieldMissing(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Field missing", "MissingFields", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
@@ -658,8 +613,6 @@ func TestLibraryPropertiesSentenceFieldMissing(t *testing.T) {
 
 func TestLibraryPropertiesSentenceFieldLTMinLength(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Sentence field too short", "SentenceLTMinLength", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -669,7 +622,6 @@ func TestLibraryPropertiesSentenceFieldLTMinLength(t *testing.T) {
 
 func TestLibraryPropertiesSentenceFieldSpellCheck(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Not defined", "MissingFields", ruleresult.Skip, ""},
 		{"Misspelled word", "MisspelledSentenceParagraphValue", ruleresult.Fail, "^grill broccoli now$"},
 		{"Non-nil diff but no typos", "SpuriousMisspelledSentenceParagraphValue", ruleresult.Pass, ""},
@@ -681,7 +633,6 @@ func TestLibraryPropertiesSentenceFieldSpellCheck(t *testing.T) {
 
 func TestLibraryPropertiesParagraphFieldMissing(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Field missing", "MissingFields", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
@@ -692,7 +643,6 @@ func TestLibraryPropertiesParagraphFieldMissing(t *testing.T) {
 
 func TestLibraryPropertiesParagraphFieldSpellCheck(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Not defined", "MissingFields", ruleresult.Skip, ""},
 		{"Misspelled word", "MisspelledSentenceParagraphValue", ruleresult.Fail, "^There is a zebra$"},
 		{"Non-nil diff but no typos", "SpuriousMisspelledSentenceParagraphValue", ruleresult.Pass, ""},
@@ -704,8 +654,6 @@ func TestLibraryPropertiesParagraphFieldSpellCheck(t *testing.T) {
 
 func TestLibraryPropertiesParagraphFieldRepeatsSentence(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Repeat", "ParagraphRepeatsSentence", ruleresult.Fail, ""},
 		{"No repeat", "Recursive", ruleresult.Pass, ""},
 	}
@@ -715,7 +663,6 @@ func TestLibraryPropertiesParagraphFieldRepeatsSentence(t *testing.T) {
 
 func TestLibraryPropertiesCategoryFieldMissing(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Field missing", "MissingFields", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
@@ -726,7 +673,6 @@ func TestLibraryPropertiesCategoryFieldMissing(t *testing.T) {
 
 func TestLibraryPropertiesCategoryFieldInvalid(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Unsupported category name", "CategoryInvalid", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
@@ -737,7 +683,6 @@ func TestLibraryPropertiesCategoryFieldInvalid(t *testing.T) {
 
 func TestLibraryPropertiesCategoryFieldUncategorized(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"No category field", "MissingFields", ruleresult.Skip, ""},
 		{"Uncategorized category", "UncategorizedCategoryValue", ruleresult.Fail, ""},
 		{"Valid category value", "Recursive", ruleresult.Pass, ""},
@@ -748,7 +693,6 @@ func TestLibraryPropertiesCategoryFieldUncategorized(t *testing.T) {
 
 func TestLibraryPropertiesUrlFieldMissing(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Field missing", "MissingFields", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
@@ -759,8 +703,6 @@ func TestLibraryPropertiesUrlFieldMissing(t *testing.T) {
 
 func TestLibraryPropertiesUrlFieldLTMinLength(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"url field too short", "UrlLTMinLength", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -770,8 +712,6 @@ func TestLibraryPropertiesUrlFieldLTMinLength(t *testing.T) {
 
 func TestLibraryPropertiesUrlFieldInvalid(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
-		{"Legacy", "Legacy", ruleresult.NotRun, ""},
 		{"Invalid URL format", "UrlFormatInvalid", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
 	}
@@ -781,7 +721,6 @@ func TestLibraryPropertiesUrlFieldInvalid(t *testing.T) {
 
 func TestLibraryPropertiesUrlFieldDeadLink(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Not defined", "MissingFields", ruleresult.NotRun, ""},
 		{"Bad URL", "BadURL", ruleresult.Fail, "^Head \"http://invalid/\": dial tcp: lookup invalid"},
 	}
@@ -842,9 +781,9 @@ func TestLibraryPropertiesUrlFieldDeadLink(t *testing.T) {
 			ProjectType:      projecttype.Library,
 			SuperprojectType: projecttype.Library,
 		}
-		projectdata.Initialize(testProject)
+		projectData := projectdata.Initialize(testProject, testToolConfiguration, &testLibraryManagerIndex)
 
-		result, output := LibraryPropertiesURLFieldDeadLink()
+		result, output, _ := LibraryPropertiesURLFieldDeadLink(projectData)
 		assert.Equal(t, testTable.expectedRuleResult, result, testTable.testName)
 		expectedOutputRegexp := regexp.MustCompile(testTable.expectedOutputQuery)
 		assert.True(
@@ -857,7 +796,6 @@ func TestLibraryPropertiesUrlFieldDeadLink(t *testing.T) {
 
 func TestLibraryPropertiesArchitecturesFieldMissing(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Field missing", "MissingFields", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
@@ -868,7 +806,6 @@ func TestLibraryPropertiesArchitecturesFieldMissing(t *testing.T) {
 
 func TestLibraryPropertiesArchitecturesFieldLTMinLength(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Architectures field too short", "ArchitecturesLTMinLength", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
@@ -879,7 +816,6 @@ func TestLibraryPropertiesArchitecturesFieldLTMinLength(t *testing.T) {
 
 func TestLibraryPropertiesArchitecturesFieldSoloAlias(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Not defined", "MissingFields", ruleresult.Skip, ""},
 		{"Solo alias", "ArchitectureAliasSolo", ruleresult.Fail, ""},
 		{"Alias w/ true", "ArchitectureAliasWithTrue", ruleresult.Pass, ""},
@@ -891,7 +827,6 @@ func TestLibraryPropertiesArchitecturesFieldSoloAlias(t *testing.T) {
 
 func TestLibraryPropertiesArchitecturesFieldValueCase(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Not defined", "MissingFields", ruleresult.Skip, ""},
 		{"Miscased", "ArchitectureMiscased", ruleresult.Fail, ""},
 		{"Miscased w/ correct case", "ArchitectureMiscasedWithCorrect", ruleresult.Pass, ""},
@@ -903,7 +838,6 @@ func TestLibraryPropertiesArchitecturesFieldValueCase(t *testing.T) {
 
 func TestLibraryPropertiesDependsFieldInvalidFormat(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Depends field has disallowed characters", "DependsHasBadChars", ruleresult.Fail, ""},
 		{"Valid", "DependsValid", ruleresult.Pass, ""},
@@ -914,7 +848,6 @@ func TestLibraryPropertiesDependsFieldInvalidFormat(t *testing.T) {
 
 func TestLibraryPropertiesDependsFieldNotInIndex(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"No depends field", "MissingFields", ruleresult.Skip, ""},
 		{"Dependency not in index", "DependsNotIndexed", ruleresult.Fail, "^NotIndexed$"},
@@ -929,7 +862,6 @@ func TestLibraryPropertiesDependsFieldNotInIndex(t *testing.T) {
 
 func TestLibraryPropertiesDependsFieldConstraintInvalid(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"No depends field", "NoDepends", ruleresult.Skip, ""},
 		{"Depends field empty", "DependsEmpty", ruleresult.Pass, ""},
@@ -943,7 +875,6 @@ func TestLibraryPropertiesDependsFieldConstraintInvalid(t *testing.T) {
 
 func TestLibraryPropertiesDotALinkageFieldInvalid(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"dot_a_linkage field invalid value", "DotALinkageInvalid", ruleresult.Fail, ""},
 		{"Valid", "DotALinkage", ruleresult.Pass, ""},
@@ -965,7 +896,6 @@ func TestLibraryPropertiesDotALinkageFieldTrueWithFlatLayout(t *testing.T) {
 
 func TestLibraryPropertiesIncludesFieldLTMinLength(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Includes field too short", "IncludesLTMinLength", ruleresult.Fail, ""},
 		{"Valid", "Recursive", ruleresult.Pass, ""},
@@ -976,7 +906,6 @@ func TestLibraryPropertiesIncludesFieldLTMinLength(t *testing.T) {
 
 func TestLibraryPropertiesIncludesFieldItemNotFound(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Not defined", "MissingFields", ruleresult.Skip, ""},
 		{"Missing includes", "MissingIncludes", ruleresult.Fail, "^Nonexistent.h$"},
 		{"Double comma in includes list", "IncludesListSkip", ruleresult.Pass, ""},
@@ -988,7 +917,6 @@ func TestLibraryPropertiesIncludesFieldItemNotFound(t *testing.T) {
 
 func TestLibraryPropertiesPrecompiledFieldInvalid(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"Precompiled field invalid value", "PrecompiledInvalid", ruleresult.Fail, ""},
 		{"Valid", "Precompiled", ruleresult.Pass, ""},
@@ -999,7 +927,6 @@ func TestLibraryPropertiesPrecompiledFieldInvalid(t *testing.T) {
 
 func TestLibraryPropertiesPrecompiledFieldEnabledWithFlatLayout(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Unable to load", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Not defined", "MissingFields", ruleresult.Skip, ""},
 		{"Flat layout", "PrecompiledFlat", ruleresult.Fail, "^true$"},
 		{"Recursive layout", "Precompiled", ruleresult.Pass, ""},
@@ -1012,7 +939,6 @@ func TestLibraryPropertiesPrecompiledFieldEnabledWithFlatLayout(t *testing.T) {
 
 func TestLibraryPropertiesLdflagsFieldLTMinLength(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Legacy", "Legacy", ruleresult.Skip, ""},
 		{"ldflags field too short", "LdflagsLTMinLength", ruleresult.Fail, ""},
 		{"Valid", "LdflagsValid", ruleresult.Pass, ""},
@@ -1023,7 +949,6 @@ func TestLibraryPropertiesLdflagsFieldLTMinLength(t *testing.T) {
 
 func TestLibraryPropertiesMisspelledOptionalField(t *testing.T) {
 	testTables := []libraryRuleFunctionTestTable{
-		{"Invalid", "InvalidLibraryProperties", ruleresult.NotRun, ""},
 		{"Misspelled depends field name", "DependsFieldMisspelled", ruleresult.Fail, ""},
 		{"Misspelled dot_a_linkage field name", "DotALinkageFieldMisspelled", ruleresult.Fail, ""},
 		{"Misspelled includes field name", "IncludesFieldMisspelled", ruleresult.Fail, ""},
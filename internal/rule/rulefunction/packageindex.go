@@ -29,199 +29,167 @@ import (
 // The rule functions for package indexes.
 
 // PackageIndexMissing checks whether a file resembling a package index was found in the specified project folder.
-func PackageIndexMissing() (result ruleresult.Type, output string) {
-	if projectdata.ProjectPath() == nil {
-		return ruleresult.Fail, ""
+func PackageIndexMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.Fail, "", nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexFilenameInvalid checks whether the package index's filename is valid for 3rd party projects.
-func PackageIndexFilenameInvalid() (result ruleresult.Type, output string) {
-	if projectdata.ProjectPath() == nil {
-		return ruleresult.NotRun, "Package index not found"
+func PackageIndexFilenameInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found", nil
 	}
 
-	if packageindex.HasValidFilename(projectdata.ProjectPath(), false) {
-		return ruleresult.Pass, ""
+	if packageindex.HasValidFilename(projectData.ProjectPath(), false) {
+		return ruleresult.Pass, "", nil
 	}
 
-	return ruleresult.Fail, projectdata.ProjectPath().Base()
+	return ruleresult.Fail, projectData.ProjectPath().Base(), nil
 }
 
 // PackageIndexOfficialFilenameInvalid checks whether the package index's filename is valid for official projects.
-func PackageIndexOfficialFilenameInvalid() (result ruleresult.Type, output string) {
-	if projectdata.ProjectPath() == nil {
-		return ruleresult.NotRun, "Package index not found"
+func PackageIndexOfficialFilenameInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found", nil
 	}
 
-	if packageindex.HasValidFilename(projectdata.ProjectPath(), true) {
-		return ruleresult.Pass, ""
+	if packageindex.HasValidFilename(projectData.ProjectPath(), true) {
+		return ruleresult.Pass, "", nil
 	}
 
-	return ruleresult.Fail, projectdata.ProjectPath().Base()
+	return ruleresult.Fail, projectData.ProjectPath().Base(), nil
 }
 
 // PackageIndexJSONFormat checks whether the package index file is a valid JSON document.
-func PackageIndexJSONFormat() (result ruleresult.Type, output string) {
-	if projectdata.ProjectPath() == nil {
-		return ruleresult.NotRun, "Package index not found"
+func PackageIndexJSONFormat(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found", nil
 	}
 
-	if isValidJSON(projectdata.ProjectPath()) {
-		return ruleresult.Pass, ""
+	if projectData.PackageIndexLoadError() == nil {
+		return ruleresult.Pass, "", nil
 	}
 
-	return ruleresult.Fail, ""
+	return ruleresult.Fail, "", nil
 }
 
 // PackageIndexFormat checks for invalid package index data format.
-func PackageIndexFormat() (result ruleresult.Type, output string) {
-	if projectdata.ProjectPath() == nil {
-		return ruleresult.NotRun, "Package index not found"
+func PackageIndexFormat(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.ProjectPath() == nil {
+		return ruleresult.NotRun, "Package index not found", nil
 	}
 
-	if projectdata.PackageIndexCLILoadError() != nil {
-		return ruleresult.Fail, projectdata.PackageIndexCLILoadError().Error()
+	if projectData.PackageIndexCLILoadError() != nil {
+		return ruleresult.Fail, projectData.PackageIndexCLILoadError().Error(), []Finding{{Path: projectData.ProjectPath()}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexAdditionalProperties checks for additional properties in the package index root.
-func PackageIndexAdditionalProperties() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
+func PackageIndexAdditionalProperties(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if schema.ProhibitedAdditionalProperties("", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", nil
 	}
 
-	if schema.ProhibitedAdditionalProperties("", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesMissing checks for missing packages property.
-func PackageIndexPackagesMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
-	if schema.RequiredPropertyMissing("/packages", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+func PackageIndexPackagesMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if schema.RequiredPropertyMissing("/packages", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesIncorrectType checks for incorrect type of packages[].
-func PackageIndexPackagesIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
+func PackageIndexPackagesIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if schema.PropertyTypeMismatch("/packages", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", nil
 	}
 
-	if schema.PropertyTypeMismatch("/packages", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesAdditionalProperties checks for additional properties in packages[].
-func PackageIndexPackagesAdditionalProperties() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesAdditionalProperties(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.ProhibitedAdditionalProperties(packageData.JSONPointer, projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.ProhibitedAdditionalProperties(packageData.JSONPointer, projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesNameMissing checks for missing packages[].name property.
-func PackageIndexPackagesNameMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesNameIncorrectType checks for incorrect type of the packages[].name property.
-func PackageIndexPackagesNameIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesNameIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesNameLTMinLength checks for packages[].name property less than the minimum length.
-func PackageIndexPackagesNameLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.PropertyLessThanMinLength(packageData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.PropertyLessThanMinLength(packageData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesNameIsArduino checks for packages[].name being "arduino".
-func PackageIndexPackagesNameIsArduino() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesNameIsArduino(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
+	for _, packageData := range projectData.PackageIndexPackages() {
 		if schema.ValidationErrorMatch(
 			"^#"+packageData.JSONPointer+"/name$",
 			"/patternObjects/notArduino",
 			"",
 			"",
-			projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification],
+			projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification],
 		) {
 			// Since the package name is implicit in the rule itself, it makes most sense to use the JSON pointer to identify.
 			nonCompliantIDs = append(nonCompliantIDs, packageData.JSONPointer)
@@ -229,86 +197,70 @@ func PackageIndexPackagesNameIsArduino() (result ruleresult.Type, output string)
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesMaintainerMissing checks for missing packages[].maintainer property.
-func PackageIndexPackagesMaintainerMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesMaintainerMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/maintainer", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/maintainer", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesMaintainerIncorrectType checks for incorrect type of the packages[].maintainer property.
-func PackageIndexPackagesMaintainerIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesMaintainerIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/maintainer", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/maintainer", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesMaintainerLTMinLength checks for packages[].maintainer property less than the minimum length.
-func PackageIndexPackagesMaintainerLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesMaintainerLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.PropertyLessThanMinLength(packageData.JSONPointer+"/maintainer", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.PropertyLessThanMinLength(packageData.JSONPointer+"/maintainer", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesMaintainerStartsWithArduino checks for packages[].maintainer starting with "arduino".
-func PackageIndexPackagesMaintainerStartsWithArduino() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesMaintainerStartsWithArduino(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
+	for _, packageData := range projectData.PackageIndexPackages() {
 		if schema.ValidationErrorMatch(
 			"^#"+packageData.JSONPointer+"/maintainer$",
 			"/patternObjects/notStartsWithArduino",
 			"",
 			"",
-			projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Strict],
+			projectData.PackageIndexSchemaValidationResult()[compliancelevel.Strict],
 		) {
 			// Since the package name is implicit in the rule itself, it makes most sense to use the JSON pointer to identify.
 			nonCompliantIDs = append(nonCompliantIDs, packageData.JSONPointer)
@@ -316,80 +268,68 @@ func PackageIndexPackagesMaintainerStartsWithArduino() (result ruleresult.Type,
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesWebsiteURLMissing checks for missing packages[].websiteURL property.
-func PackageIndexPackagesWebsiteURLMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesWebsiteURLMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/websiteURL", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/websiteURL", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesWebsiteURLIncorrectType checks for incorrect type of the packages[].websiteURL property.
-func PackageIndexPackagesWebsiteURLIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesWebsiteURLIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/websiteURL", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/websiteURL", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesWebsiteURLInvalidFormat checks for incorrect format of the packages[].websiteURL property.
-func PackageIndexPackagesWebsiteURLInvalidFormat() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesWebsiteURLInvalidFormat(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.PropertyFormatMismatch(packageData.JSONPointer+"/websiteURL", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.PropertyFormatMismatch(packageData.JSONPointer+"/websiteURL", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesWebsiteURLDeadLink checks for dead links in packages[].websiteURL.
-func PackageIndexPackagesWebsiteURLDeadLink() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
+func PackageIndexPackagesWebsiteURLDeadLink(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.ToolConfiguration().Offline() {
+		return ruleresult.NotRun, offlineOutput, nil
 	}
 
 	nonCompliantIDs := []string{}
-	for _, data := range projectdata.PackageIndexPackages() {
+	for _, data := range projectData.PackageIndexPackages() {
 		url, ok := data.Object["websiteURL"].(string)
 		if !ok {
 			continue
@@ -407,160 +347,132 @@ func PackageIndexPackagesWebsiteURLDeadLink() (result ruleresult.Type, output st
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesEmailMissing checks for missing packages[].email property.
-func PackageIndexPackagesEmailMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesEmailMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/email", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/email", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesEmailIncorrectType checks for incorrect type of the packages[].email property.
-func PackageIndexPackagesEmailIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesEmailIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/email", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/email", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesHelpIncorrectType checks for incorrect type of the packages[].help property.
-func PackageIndexPackagesHelpIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesHelpIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/help", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/help", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesHelpAdditionalProperties checks for additional properties in packages[].help.
-func PackageIndexPackagesHelpAdditionalProperties() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesHelpAdditionalProperties(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.ProhibitedAdditionalProperties(packageData.JSONPointer+"/help", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.ProhibitedAdditionalProperties(packageData.JSONPointer+"/help", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesHelpOnlineMissing checks for missing packages[].help.online property.
-func PackageIndexPackagesHelpOnlineMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesHelpOnlineMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/help/online", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/help/online", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesHelpOnlineIncorrectType checks for incorrect type of the packages[].help.online property.
-func PackageIndexPackagesHelpOnlineIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesHelpOnlineIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/help/online", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/help/online", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesHelpOnlineInvalidFormat checks for incorrect format of the packages[].help.online property.
-func PackageIndexPackagesHelpOnlineInvalidFormat() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesHelpOnlineInvalidFormat(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.PropertyFormatMismatch(packageData.JSONPointer+"/help/online", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.PropertyFormatMismatch(packageData.JSONPointer+"/help/online", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesHelpOnlineDeadLink checks for dead links in packages[].help.online.
-func PackageIndexPackagesHelpOnlineDeadLink() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
+func PackageIndexPackagesHelpOnlineDeadLink(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.ToolConfiguration().Offline() {
+		return ruleresult.NotRun, offlineOutput, nil
 	}
 
 	nonCompliantIDs := []string{}
-	for _, data := range projectdata.PackageIndexPackages() {
+	for _, data := range projectData.PackageIndexPackages() {
 		help, ok := data.Object["help"].(map[string]interface{})
 		if !ok {
 			continue
@@ -583,480 +495,388 @@ func PackageIndexPackagesHelpOnlineDeadLink() (result ruleresult.Type, output st
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsMissing checks for missing packages[].platforms[] property.
-func PackageIndexPackagesPlatformsMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/platforms", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/platforms", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsIncorrectType checks for incorrect type of packages[].platforms.
-func PackageIndexPackagesPlatformsIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/platforms", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/platforms", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsAdditionalProperties checks for additional properties in packages[].platforms[].
-func PackageIndexPackagesPlatformsAdditionalProperties() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsAdditionalProperties(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.ProhibitedAdditionalProperties(platformData.JSONPointer, projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.ProhibitedAdditionalProperties(platformData.JSONPointer, projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsNameMissing checks for missing packages[].platforms[].name property.
-func PackageIndexPackagesPlatformsNameMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsNameIncorrectType checks for incorrect type of the packages[].platforms[].name property.
-func PackageIndexPackagesPlatformsNameIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsNameIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsNameLTMinLength checks for packages[].platforms[].name property less than the minimum length.
-func PackageIndexPackagesPlatformsNameLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyLessThanMinLength(platformData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyLessThanMinLength(platformData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsArchitectureMissing checks for missing packages[].platforms[].architecture property.
-func PackageIndexPackagesPlatformsArchitectureMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsArchitectureMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/architecture", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/architecture", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsArchitectureIncorrectType checks for incorrect type of the packages[].platforms[].architecture property.
-func PackageIndexPackagesPlatformsArchitectureIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsArchitectureIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/architecture", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/architecture", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsArchitectureLTMinLength checks for packages[].platforms[].architecture property less than the minimum length.
-func PackageIndexPackagesPlatformsArchitectureLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsArchitectureLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyLessThanMinLength(platformData.JSONPointer+"/architecture", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyLessThanMinLength(platformData.JSONPointer+"/architecture", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsVersionMissing checks for missing packages[].platforms[].version property.
-func PackageIndexPackagesPlatformsVersionMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsVersionMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/version", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/version", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsVersionIncorrectType checks for incorrect type of the packages[].platforms[].version property.
-func PackageIndexPackagesPlatformsVersionIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsVersionIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/version", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/version", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsVersionNonRelaxedSemver checks whether the packages[].platforms[].version property is "relaxed semver" compliant.
-func PackageIndexPackagesPlatformsVersionNonRelaxedSemver() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsVersionNonRelaxedSemver(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyPatternMismatch(platformData.JSONPointer+"/version", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyPatternMismatch(platformData.JSONPointer+"/version", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsVersionNonSemver checks whether the packages[].platforms[].version property is semver compliant.
-func PackageIndexPackagesPlatformsVersionNonSemver() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsVersionNonSemver(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyPatternMismatch(platformData.JSONPointer+"/version", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Strict]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyPatternMismatch(platformData.JSONPointer+"/version", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Strict]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsDeprecatedIncorrectType checks for incorrect type of the packages[].platforms[].deprecated property.
-func PackageIndexPackagesPlatformsDeprecatedIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsDeprecatedIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/deprecated", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/deprecated", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsCategoryMissing checks for missing packages[].platforms[].category property.
-func PackageIndexPackagesPlatformsCategoryMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsCategoryMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/category", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/category", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsCategoryIncorrectType checks for incorrect type of the packages[].platforms[].category property.
-func PackageIndexPackagesPlatformsCategoryIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsCategoryIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/category", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/category", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsCategoryThirdPartyInvalid checks for invalid value of the packages[].platforms[].category property for 3rd party platforms.
-func PackageIndexPackagesPlatformsCategoryThirdPartyInvalid() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsCategoryThirdPartyInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyEnumMismatch(platformData.JSONPointer+"/category", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyEnumMismatch(platformData.JSONPointer+"/category", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsHelpMissing checks for missing packages[].platforms[].help property.
-func PackageIndexPackagesPlatformsHelpMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsHelpMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/help", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/help", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsHelpIncorrectType checks for incorrect type of the packages[].platforms[].help property.
-func PackageIndexPackagesPlatformsHelpIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsHelpIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/help", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/help", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsHelpAdditionalProperties checks for additional properties in packages[].help.
-func PackageIndexPackagesPlatformsHelpAdditionalProperties() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsHelpAdditionalProperties(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.ProhibitedAdditionalProperties(platformData.JSONPointer+"/help", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.ProhibitedAdditionalProperties(platformData.JSONPointer+"/help", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsHelpOnlineMissing checks for missing packages[].platforms[].help.online property.
-func PackageIndexPackagesPlatformsHelpOnlineMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsHelpOnlineMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/help/online", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/help/online", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsHelpOnlineIncorrectType checks for incorrect type of the packages[].platforms[].help.online property.
-func PackageIndexPackagesPlatformsHelpOnlineIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsHelpOnlineIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/help/online", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/help/online", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsHelpOnlineInvalidFormat checks for incorrect format of the packages[].platforms[].help.online property.
-func PackageIndexPackagesPlatformsHelpOnlineInvalidFormat() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsHelpOnlineInvalidFormat(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyFormatMismatch(platformData.JSONPointer+"/help/online", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyFormatMismatch(platformData.JSONPointer+"/help/online", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsHelpOnlineDeadLink checks for dead links in packages[].platforms[].help.online.
-func PackageIndexPackagesPlatformsHelpOnlineDeadLink() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
+func PackageIndexPackagesPlatformsHelpOnlineDeadLink(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.ToolConfiguration().Offline() {
+		return ruleresult.NotRun, offlineOutput, nil
 	}
 
 	nonCompliantIDs := []string{}
-	for _, data := range projectdata.PackageIndexPlatforms() {
+	for _, data := range projectData.PackageIndexPlatforms() {
 		help, ok := data.Object["help"].(map[string]interface{})
 		if !ok {
 			continue
@@ -1079,80 +899,68 @@ func PackageIndexPackagesPlatformsHelpOnlineDeadLink() (result ruleresult.Type,
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsURLMissing checks for missing packages[].platforms[].url property.
-func PackageIndexPackagesPlatformsURLMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsURLMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/url", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/url", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsURLIncorrectType checks for incorrect type of the packages[].platforms[].url property.
-func PackageIndexPackagesPlatformsURLIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsURLIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/url", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/url", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsURLInvalidFormat checks for incorrect format of the packages[].platforms[].url property.
-func PackageIndexPackagesPlatformsURLInvalidFormat() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsURLInvalidFormat(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyFormatMismatch(platformData.JSONPointer+"/url", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyFormatMismatch(platformData.JSONPointer+"/url", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsURLDeadLink checks for dead links in packages[].platforms[].url.
-func PackageIndexPackagesPlatformsURLDeadLink() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
+func PackageIndexPackagesPlatformsURLDeadLink(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.ToolConfiguration().Offline() {
+		return ruleresult.NotRun, offlineOutput, nil
 	}
 
 	nonCompliantIDs := []string{}
-	for _, data := range projectdata.PackageIndexPlatforms() {
+	for _, data := range projectData.PackageIndexPlatforms() {
 		url, ok := data.Object["url"].(string)
 		if !ok {
 			continue
@@ -1170,1320 +978,1065 @@ func PackageIndexPackagesPlatformsURLDeadLink() (result ruleresult.Type, output
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsArchiveFileNameMissing checks for missing packages[].platforms[].archiveFileName property.
-func PackageIndexPackagesPlatformsArchiveFileNameMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsArchiveFileNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/archiveFileName", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/archiveFileName", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsArchiveFileNameIncorrectType checks for incorrect type of the packages[].platforms[].archiveFileName property.
-func PackageIndexPackagesPlatformsArchiveFileNameIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsArchiveFileNameIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/archiveFileName", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/archiveFileName", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsArchiveFileNameLTMinLength checks for packages[].platforms[].archiveFileName property less than the minimum length.
-func PackageIndexPackagesPlatformsArchiveFileNameLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
+func PackageIndexPackagesPlatformsArchiveFileNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	// The function is not used by a rule, so it can't depend on rule ID001.
+	if projectData.PackageIndexLoadError() != nil {
+		return ruleresult.NotRun, "Error loading package index", nil
 	}
 
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyLessThanMinLength(platformData.JSONPointer+"/archiveFileName", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyLessThanMinLength(platformData.JSONPointer+"/archiveFileName", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsArchiveFileNameInvalid checks for invalid format of packages[].platforms[].archiveFileName property.
-func PackageIndexPackagesPlatformsArchiveFileNameInvalid() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsArchiveFileNameInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyPatternMismatch(platformData.JSONPointer+"/archiveFileName", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyPatternMismatch(platformData.JSONPointer+"/archiveFileName", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsChecksumMissing checks for missing packages[].platforms[].checksum property.
-func PackageIndexPackagesPlatformsChecksumMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsChecksumMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/checksum", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/checksum", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsChecksumIncorrectType checks for incorrect type of the packages[].platforms[].checksum property.
-func PackageIndexPackagesPlatformsChecksumIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsChecksumIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/checksum", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/checksum", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsChecksumInvalid checks for invalid format of packages[].platforms[].checksum property.
-func PackageIndexPackagesPlatformsChecksumInvalid() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsChecksumInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyPatternMismatch(platformData.JSONPointer+"/checksum", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyPatternMismatch(platformData.JSONPointer+"/checksum", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsChecksumDiscouragedAlgorithm checks for use of discouraged hash algorithm in packages[].platforms[].checksum property.
-func PackageIndexPackagesPlatformsChecksumDiscouragedAlgorithm() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsChecksumDiscouragedAlgorithm(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.ValidationErrorMatch("^#"+platformData.JSONPointer+"/checksum$", "/patternObjects/usesSHA256", "", "", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Strict]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.ValidationErrorMatch("^#"+platformData.JSONPointer+"/checksum$", "/patternObjects/usesSHA256", "", "", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Strict]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsSizeMissing checks for missing packages[].platforms[].size property.
-func PackageIndexPackagesPlatformsSizeMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsSizeMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/size", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/size", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsSizeIncorrectType checks for incorrect type of the packages[].platforms[].size property.
-func PackageIndexPackagesPlatformsSizeIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsSizeIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/size", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/size", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsSizeInvalid checks for invalid format of packages[].platforms[].size property.
-func PackageIndexPackagesPlatformsSizeInvalid() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsSizeInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyPatternMismatch(platformData.JSONPointer+"/size", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyPatternMismatch(platformData.JSONPointer+"/size", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsBoardsMissing checks for missing packages[].platforms[].boards[] property.
-func PackageIndexPackagesPlatformsBoardsMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsBoardsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/boards", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/boards", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsBoardsIncorrectType checks for incorrect type of the packages[].platforms[].boards property.
-func PackageIndexPackagesPlatformsBoardsIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsBoardsIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/boards", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/boards", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsBoardsAdditionalProperties checks for additional properties in packages[].platforms[].boards[].
-func PackageIndexPackagesPlatformsBoardsAdditionalProperties() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsBoardsAdditionalProperties(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, boardData := range projectdata.PackageIndexBoards() {
-		if schema.ProhibitedAdditionalProperties(boardData.JSONPointer, projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, boardData := range projectData.PackageIndexBoards() {
+		if schema.ProhibitedAdditionalProperties(boardData.JSONPointer, projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, boardData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsBoardsNameMissing checks for missing packages[].platforms[].boards[].name property.
-func PackageIndexPackagesPlatformsBoardsNameMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsBoardsNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, boardData := range projectdata.PackageIndexBoards() {
-		if schema.RequiredPropertyMissing(boardData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, boardData := range projectData.PackageIndexBoards() {
+		if schema.RequiredPropertyMissing(boardData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, boardData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsBoardsNameIncorrectType checks for incorrect type of the packages[].platforms[].boards[].name property.
-func PackageIndexPackagesPlatformsBoardsNameIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsBoardsNameIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, boardData := range projectdata.PackageIndexBoards() {
-		if schema.PropertyTypeMismatch(boardData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, boardData := range projectData.PackageIndexBoards() {
+		if schema.PropertyTypeMismatch(boardData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, boardData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsBoardsNameLTMinLength checks for packages[].platforms[].board[].name property less than the minimum length.
-func PackageIndexPackagesPlatformsBoardsNameLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsBoardsNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, boardData := range projectdata.PackageIndexBoards() {
-		if schema.PropertyLessThanMinLength(boardData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, boardData := range projectData.PackageIndexBoards() {
+		if schema.PropertyLessThanMinLength(boardData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, boardData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesMissing checks for missing packages[].platforms[].toolsDependencies[] property.
-func PackageIndexPackagesPlatformsToolsDependenciesMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/toolsDependencies", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.RequiredPropertyMissing(platformData.JSONPointer+"/toolsDependencies", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesIncorrectType checks for incorrect type of the packages[].platforms[].toolsDependencies property.
-func PackageIndexPackagesPlatformsToolsDependenciesIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/toolsDependencies", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/toolsDependencies", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesAdditionalProperties checks for additional properties in packages[].platforms[].toolsDependencies[].
-func PackageIndexPackagesPlatformsToolsDependenciesAdditionalProperties() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesAdditionalProperties(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexToolsDependencies() {
-		if schema.ProhibitedAdditionalProperties(dependencyData.JSONPointer, projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexToolsDependencies() {
+		if schema.ProhibitedAdditionalProperties(dependencyData.JSONPointer, projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesPackagerMissing checks for missing packages[].platforms[].toolsDependencies[].packager property.
-func PackageIndexPackagesPlatformsToolsDependenciesPackagerMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesPackagerMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexToolsDependencies() {
-		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/packager", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexToolsDependencies() {
+		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/packager", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesPackagerIncorrectType checks for incorrect type of the packages[].platforms[].toolsDependencies[].packager property.
-func PackageIndexPackagesPlatformsToolsDependenciesPackagerIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesPackagerIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexToolsDependencies() {
-		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/packager", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexToolsDependencies() {
+		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/packager", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesPackagerLTMinLength checks for packages[].platforms[].toolsDependencies[].packager property less than the minimum length.
-func PackageIndexPackagesPlatformsToolsDependenciesPackagerLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesPackagerLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexToolsDependencies() {
-		if schema.PropertyLessThanMinLength(dependencyData.JSONPointer+"/packager", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexToolsDependencies() {
+		if schema.PropertyLessThanMinLength(dependencyData.JSONPointer+"/packager", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesNameMissing checks for missing packages[].platforms[].toolsDependencies[].name property.
-func PackageIndexPackagesPlatformsToolsDependenciesNameMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexToolsDependencies() {
-		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexToolsDependencies() {
+		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesNameIncorrectType checks for incorrect type of the packages[].platforms[].toolsDependencies[].name property.
-func PackageIndexPackagesPlatformsToolsDependenciesNameIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesNameIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexToolsDependencies() {
-		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexToolsDependencies() {
+		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesNameLTMinLength checks for packages[].platforms[].toolsDependencies[].name property less than the minimum length.
-func PackageIndexPackagesPlatformsToolsDependenciesNameLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexToolsDependencies() {
-		if schema.PropertyLessThanMinLength(dependencyData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexToolsDependencies() {
+		if schema.PropertyLessThanMinLength(dependencyData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesVersionMissing checks for missing packages[].platforms[].toolsDependencies[].version property.
-func PackageIndexPackagesPlatformsToolsDependenciesVersionMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesVersionMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexToolsDependencies() {
-		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/version", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexToolsDependencies() {
+		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/version", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesVersionIncorrectType checks for incorrect type of the packages[].platforms[].toolsDependencies[].packager property.
-func PackageIndexPackagesPlatformsToolsDependenciesVersionIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesVersionIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexToolsDependencies() {
-		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/version", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexToolsDependencies() {
+		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/version", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesVersionNonRelaxedSemver checks whether the packages[].platforms[].toolsDependencies[].version property is "relaxed semver" compliant.
-func PackageIndexPackagesPlatformsToolsDependenciesVersionNonRelaxedSemver() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesVersionNonRelaxedSemver(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexToolsDependencies() {
-		if schema.PropertyPatternMismatch(dependencyData.JSONPointer+"/version", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexToolsDependencies() {
+		if schema.PropertyPatternMismatch(dependencyData.JSONPointer+"/version", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsToolsDependenciesVersionNonSemver checks whether the packages[].platforms[].toolsDependencies[].version property is semver compliant.
-func PackageIndexPackagesPlatformsToolsDependenciesVersionNonSemver() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsToolsDependenciesVersionNonSemver(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexToolsDependencies() {
-		if schema.PropertyPatternMismatch(dependencyData.JSONPointer+"/version", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Strict]) {
+	for _, dependencyData := range projectData.PackageIndexToolsDependencies() {
+		if schema.PropertyPatternMismatch(dependencyData.JSONPointer+"/version", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Strict]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsDiscoveryDependenciesIncorrectType checks for incorrect type of the packages[].platforms[].discoveryDependencies property.
-func PackageIndexPackagesPlatformsDiscoveryDependenciesIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsDiscoveryDependenciesIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/discoveryDependencies", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/discoveryDependencies", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsDiscoveryDependenciesAdditionalProperties checks for additional properties in packages[].platforms[].discoveryDependencies[].
-func PackageIndexPackagesPlatformsDiscoveryDependenciesAdditionalProperties() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsDiscoveryDependenciesAdditionalProperties(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexDiscoveryDependencies() {
-		if schema.ProhibitedAdditionalProperties(dependencyData.JSONPointer, projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexDiscoveryDependencies() {
+		if schema.ProhibitedAdditionalProperties(dependencyData.JSONPointer, projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsDiscoveryDependenciesPackagerMissing checks for missing packages[].platforms[].discoveryDependencies[].packager property.
-func PackageIndexPackagesPlatformsDiscoveryDependenciesPackagerMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsDiscoveryDependenciesPackagerMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexDiscoveryDependencies() {
-		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/packager", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexDiscoveryDependencies() {
+		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/packager", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsDiscoveryDependenciesPackagerIncorrectType checks for incorrect type of the packages[].platforms[].discoveryDependencies[].packager property.
-func PackageIndexPackagesPlatformsDiscoveryDependenciesPackagerIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsDiscoveryDependenciesPackagerIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexDiscoveryDependencies() {
-		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/packager", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexDiscoveryDependencies() {
+		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/packager", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsDiscoveryDependenciesPackagerLTMinLength checks for packages[].platforms[].discoveryDependencies[].packager property less than the minimum length.
-func PackageIndexPackagesPlatformsDiscoveryDependenciesPackagerLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsDiscoveryDependenciesPackagerLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexDiscoveryDependencies() {
-		if schema.PropertyLessThanMinLength(dependencyData.JSONPointer+"/packager", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexDiscoveryDependencies() {
+		if schema.PropertyLessThanMinLength(dependencyData.JSONPointer+"/packager", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsDiscoveryDependenciesNameMissing checks for missing packages[].platforms[].discoveryDependencies[].name property.
-func PackageIndexPackagesPlatformsDiscoveryDependenciesNameMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsDiscoveryDependenciesNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexDiscoveryDependencies() {
-		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexDiscoveryDependencies() {
+		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsDiscoveryDependenciesNameIncorrectType checks for incorrect type of the packages[].platforms[].discoveryDependencies[].name property.
-func PackageIndexPackagesPlatformsDiscoveryDependenciesNameIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsDiscoveryDependenciesNameIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexDiscoveryDependencies() {
-		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexDiscoveryDependencies() {
+		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsDiscoveryDependenciesNameLTMinLength checks for packages[].platforms[].discoveryDependencies[].name property less than the minimum length.
-func PackageIndexPackagesPlatformsDiscoveryDependenciesNameLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsDiscoveryDependenciesNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexDiscoveryDependencies() {
-		if schema.PropertyLessThanMinLength(dependencyData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexDiscoveryDependencies() {
+		if schema.PropertyLessThanMinLength(dependencyData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsMonitorDependenciesIncorrectType checks for incorrect type of the packages[].platforms[].monitorDependencies property.
-func PackageIndexPackagesPlatformsMonitorDependenciesIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsMonitorDependenciesIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, platformData := range projectdata.PackageIndexPlatforms() {
-		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/monitorDependencies", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, platformData := range projectData.PackageIndexPlatforms() {
+		if schema.PropertyTypeMismatch(platformData.JSONPointer+"/monitorDependencies", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, platformData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsMonitorDependenciesAdditionalProperties checks for additional properties in packages[].platforms[].monitorDependencies[].
-func PackageIndexPackagesPlatformsMonitorDependenciesAdditionalProperties() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsMonitorDependenciesAdditionalProperties(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexMonitorDependencies() {
-		if schema.ProhibitedAdditionalProperties(dependencyData.JSONPointer, projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexMonitorDependencies() {
+		if schema.ProhibitedAdditionalProperties(dependencyData.JSONPointer, projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsMonitorDependenciesPackagerMissing checks for missing packages[].platforms[].monitorDependencies[].packager property.
-func PackageIndexPackagesPlatformsMonitorDependenciesPackagerMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsMonitorDependenciesPackagerMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexMonitorDependencies() {
-		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/packager", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexMonitorDependencies() {
+		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/packager", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsMonitorDependenciesPackagerIncorrectType checks for incorrect type of the packages[].platforms[].monitorDependencies[].packager property.
-func PackageIndexPackagesPlatformsMonitorDependenciesPackagerIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsMonitorDependenciesPackagerIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexMonitorDependencies() {
-		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/packager", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexMonitorDependencies() {
+		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/packager", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsMonitorDependenciesPackagerLTMinLength checks for packages[].platforms[].monitorDependencies[].packager property less than the minimum length.
-func PackageIndexPackagesPlatformsMonitorDependenciesPackagerLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsMonitorDependenciesPackagerLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexMonitorDependencies() {
-		if schema.PropertyLessThanMinLength(dependencyData.JSONPointer+"/packager", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexMonitorDependencies() {
+		if schema.PropertyLessThanMinLength(dependencyData.JSONPointer+"/packager", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsMonitorDependenciesNameMissing checks for missing packages[].platforms[].monitorDependencies[].name property.
-func PackageIndexPackagesPlatformsMonitorDependenciesNameMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsMonitorDependenciesNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexMonitorDependencies() {
-		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexMonitorDependencies() {
+		if schema.RequiredPropertyMissing(dependencyData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsMonitorDependenciesNameIncorrectType checks for incorrect type of the packages[].platforms[].monitorDependencies[].name property.
-func PackageIndexPackagesPlatformsMonitorDependenciesNameIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsMonitorDependenciesNameIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexMonitorDependencies() {
-		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexMonitorDependencies() {
+		if schema.PropertyTypeMismatch(dependencyData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesPlatformsMonitorDependenciesNameLTMinLength checks for packages[].platforms[].monitorDependencies[].name property less than the minimum length.
-func PackageIndexPackagesPlatformsMonitorDependenciesNameLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesPlatformsMonitorDependenciesNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, dependencyData := range projectdata.PackageIndexMonitorDependencies() {
-		if schema.PropertyLessThanMinLength(dependencyData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, dependencyData := range projectData.PackageIndexMonitorDependencies() {
+		if schema.PropertyLessThanMinLength(dependencyData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, dependencyData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsMissing checks for missing packages[].tools property.
-func PackageIndexPackagesToolsMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/tools", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.RequiredPropertyMissing(packageData.JSONPointer+"/tools", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsIncorrectType checks for incorrect type of packages[].tools.
-func PackageIndexPackagesToolsIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, packageData := range projectdata.PackageIndexPackages() {
-		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/tools", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, packageData := range projectData.PackageIndexPackages() {
+		if schema.PropertyTypeMismatch(packageData.JSONPointer+"/tools", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, packageData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsAdditionalProperties checks for additional properties in packages[].tools[].
-func PackageIndexPackagesToolsAdditionalProperties() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsAdditionalProperties(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, toolData := range projectdata.PackageIndexTools() {
-		if schema.ProhibitedAdditionalProperties(toolData.JSONPointer, projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, toolData := range projectData.PackageIndexTools() {
+		if schema.ProhibitedAdditionalProperties(toolData.JSONPointer, projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, toolData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsNameMissing checks for missing packages[].tools[].name property.
-func PackageIndexPackagesToolsNameMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, toolData := range projectdata.PackageIndexTools() {
-		if schema.RequiredPropertyMissing(toolData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, toolData := range projectData.PackageIndexTools() {
+		if schema.RequiredPropertyMissing(toolData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, toolData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsNameIncorrectType checks for incorrect type of the packages[].tools[].name property.
-func PackageIndexPackagesToolsNameIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsNameIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, toolData := range projectdata.PackageIndexTools() {
-		if schema.PropertyTypeMismatch(toolData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, toolData := range projectData.PackageIndexTools() {
+		if schema.PropertyTypeMismatch(toolData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, toolData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsNameLTMinLength checks for packages[].tools[].name property less than the minimum length.
-func PackageIndexPackagesToolsNameLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, toolData := range projectdata.PackageIndexTools() {
-		if schema.PropertyLessThanMinLength(toolData.JSONPointer+"/name", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, toolData := range projectData.PackageIndexTools() {
+		if schema.PropertyLessThanMinLength(toolData.JSONPointer+"/name", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, toolData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsVersionMissing checks for missing packages[].tools[].version property.
-func PackageIndexPackagesToolsVersionMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsVersionMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, toolData := range projectdata.PackageIndexTools() {
-		if schema.RequiredPropertyMissing(toolData.JSONPointer+"/version", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, toolData := range projectData.PackageIndexTools() {
+		if schema.RequiredPropertyMissing(toolData.JSONPointer+"/version", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, toolData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsVersionIncorrectType checks for incorrect type of the packages[].tools[].version property.
-func PackageIndexPackagesToolsVersionIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsVersionIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, toolData := range projectdata.PackageIndexTools() {
-		if schema.PropertyTypeMismatch(toolData.JSONPointer+"/version", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, toolData := range projectData.PackageIndexTools() {
+		if schema.PropertyTypeMismatch(toolData.JSONPointer+"/version", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, toolData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsVersionNonRelaxedSemver checks whether the packages[].tools[].version property is "relaxed semver" compliant.
-func PackageIndexPackagesToolsVersionNonRelaxedSemver() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsVersionNonRelaxedSemver(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, toolData := range projectdata.PackageIndexTools() {
-		if schema.PropertyPatternMismatch(toolData.JSONPointer+"/version", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, toolData := range projectData.PackageIndexTools() {
+		if schema.PropertyPatternMismatch(toolData.JSONPointer+"/version", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, toolData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsVersionNonSemver checks whether the packages[].tools[].version property is semver compliant.
-func PackageIndexPackagesToolsVersionNonSemver() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsVersionNonSemver(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, toolData := range projectdata.PackageIndexTools() {
-		if schema.PropertyPatternMismatch(toolData.JSONPointer+"/version", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Strict]) {
+	for _, toolData := range projectData.PackageIndexTools() {
+		if schema.PropertyPatternMismatch(toolData.JSONPointer+"/version", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Strict]) {
 			nonCompliantIDs = append(nonCompliantIDs, toolData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsMissing checks for missing packages[].tools[].systems[] property.
-func PackageIndexPackagesToolsSystemsMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, toolData := range projectdata.PackageIndexTools() {
-		if schema.RequiredPropertyMissing(toolData.JSONPointer+"/systems", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, toolData := range projectData.PackageIndexTools() {
+		if schema.RequiredPropertyMissing(toolData.JSONPointer+"/systems", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, toolData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsIncorrectType checks for incorrect type of the packages[].tools[].systems property.
-func PackageIndexPackagesToolsSystemsIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, toolData := range projectdata.PackageIndexTools() {
-		if schema.PropertyTypeMismatch(toolData.JSONPointer+"/systems", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, toolData := range projectData.PackageIndexTools() {
+		if schema.PropertyTypeMismatch(toolData.JSONPointer+"/systems", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, toolData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsAdditionalProperties checks for additional properties in packages[].tools[].systems[].
-func PackageIndexPackagesToolsSystemsAdditionalProperties() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsAdditionalProperties(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.ProhibitedAdditionalProperties(systemData.JSONPointer, projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.ProhibitedAdditionalProperties(systemData.JSONPointer, projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsHostMissing checks for missing packages[].tools[].systems[].host property.
-func PackageIndexPackagesToolsSystemsHostMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsHostMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.RequiredPropertyMissing(systemData.JSONPointer+"/host", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.RequiredPropertyMissing(systemData.JSONPointer+"/host", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsHostIncorrectType checks for incorrect type of the packages[].tools[].systems[].host property.
-func PackageIndexPackagesToolsSystemsHostIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsHostIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.PropertyTypeMismatch(systemData.JSONPointer+"/host", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.PropertyTypeMismatch(systemData.JSONPointer+"/host", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsHostInvalid checks for invalid format of whether the packages[].tools[].systems[].host property.
-func PackageIndexPackagesToolsSystemsHostInvalid() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsHostInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.PropertyPatternMismatch(systemData.JSONPointer+"/host", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.PropertyPatternMismatch(systemData.JSONPointer+"/host", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsURLMissing checks for missing packages[].tools[].systems[].url property.
-func PackageIndexPackagesToolsSystemsURLMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsURLMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.RequiredPropertyMissing(systemData.JSONPointer+"/url", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.RequiredPropertyMissing(systemData.JSONPointer+"/url", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsURLIncorrectType checks for incorrect type of the packages[].tools[].systems[].url property.
-func PackageIndexPackagesToolsSystemsURLIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsURLIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.PropertyTypeMismatch(systemData.JSONPointer+"/url", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.PropertyTypeMismatch(systemData.JSONPointer+"/url", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsURLInvalidFormat checks for incorrect format of the packages[].tools[].systems[].url property.
-func PackageIndexPackagesToolsSystemsURLInvalidFormat() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsURLInvalidFormat(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.PropertyFormatMismatch(systemData.JSONPointer+"/url", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.PropertyFormatMismatch(systemData.JSONPointer+"/url", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsURLDeadLink checks for dead links in packages[].tools[].systems[].url.
-func PackageIndexPackagesToolsSystemsURLDeadLink() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
+func PackageIndexPackagesToolsSystemsURLDeadLink(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if projectData.ToolConfiguration().Offline() {
+		return ruleresult.NotRun, offlineOutput, nil
 	}
 
 	nonCompliantIDs := []string{}
-	for _, data := range projectdata.PackageIndexSystems() {
+	for _, data := range projectData.PackageIndexSystems() {
 		url, ok := data.Object["url"].(string)
 		if !ok {
 			continue
@@ -2501,228 +2054,184 @@ func PackageIndexPackagesToolsSystemsURLDeadLink() (result ruleresult.Type, outp
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsArchiveFileNameMissing checks for missing packages[].tools[].systems[].archiveFileName property.
-func PackageIndexPackagesToolsSystemsArchiveFileNameMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsArchiveFileNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.RequiredPropertyMissing(systemData.JSONPointer+"/archiveFileName", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.RequiredPropertyMissing(systemData.JSONPointer+"/archiveFileName", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsArchiveFileNameIncorrectType checks for incorrect type of the packages[].tools[].systems[].archiveFileName property.
-func PackageIndexPackagesToolsSystemsArchiveFileNameIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsArchiveFileNameIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.PropertyTypeMismatch(systemData.JSONPointer+"/archiveFileName", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.PropertyTypeMismatch(systemData.JSONPointer+"/archiveFileName", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsArchiveFileNameLTMinLength checks for packages[].tools[].systems[].archiveFileName property less than the minimum length.
-func PackageIndexPackagesToolsSystemsArchiveFileNameLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsArchiveFileNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.PropertyLessThanMinLength(systemData.JSONPointer+"/archiveFileName", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.PropertyLessThanMinLength(systemData.JSONPointer+"/archiveFileName", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsArchiveFileNameInvalid checks for invalid format of packages[].tools[].systems[].archiveFileName property.
-func PackageIndexPackagesToolsSystemsArchiveFileNameInvalid() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsArchiveFileNameInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.PropertyPatternMismatch(systemData.JSONPointer+"/archiveFileName", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.PropertyPatternMismatch(systemData.JSONPointer+"/archiveFileName", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsChecksumMissing checks for missing packages[].tools[].systems[].checksum property.
-func PackageIndexPackagesToolsSystemsChecksumMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsChecksumMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.RequiredPropertyMissing(systemData.JSONPointer+"/checksum", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.RequiredPropertyMissing(systemData.JSONPointer+"/checksum", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsChecksumIncorrectType checks for incorrect type of the packages[].tools[].systems[].checksum property.
-func PackageIndexPackagesToolsSystemsChecksumIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsChecksumIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.PropertyTypeMismatch(systemData.JSONPointer+"/checksum", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.PropertyTypeMismatch(systemData.JSONPointer+"/checksum", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsChecksumInvalid checks for invalid format of packages[].tools[].systems[].checksum property.
-func PackageIndexPackagesToolsSystemsChecksumInvalid() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsChecksumInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.PropertyPatternMismatch(systemData.JSONPointer+"/checksum", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.PropertyPatternMismatch(systemData.JSONPointer+"/checksum", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsChecksumDiscouragedAlgorithm checks for use of discouraged hash algorithm in packages[].tools[].systems[].checksum property.
-func PackageIndexPackagesToolsSystemsChecksumDiscouragedAlgorithm() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsChecksumDiscouragedAlgorithm(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.ValidationErrorMatch("^#"+systemData.JSONPointer+"/checksum$", "/patternObjects/usesSHA256", "", "", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Strict]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.ValidationErrorMatch("^#"+systemData.JSONPointer+"/checksum$", "/patternObjects/usesSHA256", "", "", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Strict]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsSizeMissing checks for missing packages[].tools[].systems[].size property.
-func PackageIndexPackagesToolsSystemsSizeMissing() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsSizeMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.RequiredPropertyMissing(systemData.JSONPointer+"/size", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.RequiredPropertyMissing(systemData.JSONPointer+"/size", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsSizeIncorrectType checks for incorrect type of the packages[].tools[].systems[].size property.
-func PackageIndexPackagesToolsSystemsSizeIncorrectType() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsSizeIncorrectType(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.PropertyTypeMismatch(systemData.JSONPointer+"/size", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.PropertyTypeMismatch(systemData.JSONPointer+"/size", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PackageIndexPackagesToolsSystemsSizeInvalid checks for invalid format of packages[].tools[].systems[].size property.
-func PackageIndexPackagesToolsSystemsSizeInvalid() (result ruleresult.Type, output string) {
-	if projectdata.PackageIndexLoadError() != nil {
-		return ruleresult.NotRun, "Error loading package index"
-	}
-
+func PackageIndexPackagesToolsSystemsSizeInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	nonCompliantIDs := []string{}
-	for _, systemData := range projectdata.PackageIndexSystems() {
-		if schema.PropertyPatternMismatch(systemData.JSONPointer+"/size", projectdata.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, systemData := range projectData.PackageIndexSystems() {
+		if schema.PropertyPatternMismatch(systemData.JSONPointer+"/size", projectData.PackageIndexSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantIDs = append(nonCompliantIDs, systemData.ID)
 		}
 	}
 
 	if len(nonCompliantIDs) > 0 {
-		return ruleresult.Fail, brokenOutputList(nonCompliantIDs)
+		return ruleresult.Fail, brokenOutputList(nonCompliantIDs), subjectFindings(projectData.ProjectPath(), nonCompliantIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
@@ -23,6 +23,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
@@ -127,9 +128,9 @@ func checkPackageIndexRuleFunctionForPath(
 		SuperprojectType: projecttype.PackageIndex,
 	}
 
-	projectdata.Initialize(testProject)
+	projectData := projectdata.Initialize(testProject, testToolConfiguration, &testLibraryManagerIndex)
 
-	result, output := ruleFunction()
+	result, output, _ := ruleFunction(projectData)
 	assert.Equal(t, testTable.expectedRuleResult, result, testTable.testName)
 	assert.True(
 		t,
@@ -186,7 +187,7 @@ func TestPackageIndexOfficialFilenameInvalid(t *testing.T) {
 func TestPackageIndexJSONFormat(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
 		{"Invalid JSON", "invalid-JSON", ruleresult.Fail, ""},
-		{"Not valid package index", "invalid-package-index", ruleresult.Pass, ""},
+		{"Not JSON object", "invalid-package-index", ruleresult.Fail, ""},
 		{"Valid package index", "valid-package-index", ruleresult.Pass, ""},
 	}
 
@@ -205,7 +206,6 @@ func TestPackageIndexFormat(t *testing.T) {
 
 func TestPackageIndexAdditionalProperties(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Additional root properties", "root-additional-properties", ruleresult.Fail, ""},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -215,7 +215,6 @@ func TestPackageIndexAdditionalProperties(t *testing.T) {
 
 func TestPackageIndexPackagesMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Packages key missing", "packages-missing", ruleresult.Fail, ""},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -225,7 +224,6 @@ func TestPackageIndexPackagesMissing(t *testing.T) {
 
 func TestPackageIndexPackagesIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages type", "packages-incorrect-type", ruleresult.Fail, ""},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -235,7 +233,6 @@ func TestPackageIndexPackagesIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesAdditionalProperties(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Additional packages properties", "packages-additional-properties", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -245,7 +242,6 @@ func TestPackageIndexPackagesAdditionalProperties(t *testing.T) {
 
 func TestPackageIndexPackagesNameMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].name missing", "packages-name-missing", ruleresult.Fail, "^/packages/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -255,7 +251,6 @@ func TestPackageIndexPackagesNameMissing(t *testing.T) {
 
 func TestPackageIndexPackagesNameIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].name type", "packages-name-incorrect-type", ruleresult.Fail, "^/packages/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -265,7 +260,6 @@ func TestPackageIndexPackagesNameIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesNameLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].name < min length", "packages-name-length-lt", ruleresult.Fail, "^/packages/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -275,7 +269,6 @@ func TestPackageIndexPackagesNameLTMinLength(t *testing.T) {
 
 func TestPackageIndexPackagesNameIsArduino(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].name is arduino", "packages-name-is-arduino", ruleresult.Fail, "^/packages/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -285,7 +278,6 @@ func TestPackageIndexPackagesNameIsArduino(t *testing.T) {
 
 func TestPackageIndexPackagesMaintainerMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].maintainer missing", "packages-maintainer-missing", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -295,7 +287,6 @@ func TestPackageIndexPackagesMaintainerMissing(t *testing.T) {
 
 func TestPackageIndexPackagesMaintainerIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].maintainer type", "packages-maintainer-incorrect-type", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -305,7 +296,6 @@ func TestPackageIndexPackagesMaintainerIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesMaintainerLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].maintainer < min length", "packages-maintainer-length-lt", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -315,7 +305,6 @@ func TestPackageIndexPackagesMaintainerLTMinLength(t *testing.T) {
 
 func TestPackageIndexPackagesMaintainerStartsWithArduino(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].maintainer starts with arduino", "packages-maintainer-starts-with-arduino", ruleresult.Fail, "^/packages/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -325,7 +314,6 @@ func TestPackageIndexPackagesMaintainerStartsWithArduino(t *testing.T) {
 
 func TestPackageIndexPackagesWebsiteURLMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].websiteURL missing", "packages-websiteurl-missing", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -335,7 +323,6 @@ func TestPackageIndexPackagesWebsiteURLMissing(t *testing.T) {
 
 func TestPackageIndexPackagesWebsiteURLIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].websiteURL type", "packages-websiteurl-incorrect-type", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -345,7 +332,6 @@ func TestPackageIndexPackagesWebsiteURLIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesWebsiteURLInvalidFormat(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].websiteURL format", "packages-websiteurl-invalid-format", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -355,7 +341,6 @@ func TestPackageIndexPackagesWebsiteURLInvalidFormat(t *testing.T) {
 
 func TestPackageIndexPackagesWebsiteURLDeadLink(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Invalid URL", "packages-websiteurl-invalid", ruleresult.Fail, "^foopackager$"},
 	}
 
@@ -434,9 +419,24 @@ func TestPackageIndexPackagesWebsiteURLDeadLink(t *testing.T) {
 	}
 }
 
+func TestPackageIndexPackagesWebsiteURLDeadLinkOffline(t *testing.T) {
+	flags := test.ConfigurationFlags()
+	flags.Set("offline", "true")
+	defaultToolConfiguration := testToolConfiguration
+	defer func() { testToolConfiguration = defaultToolConfiguration }()
+	var err error
+	testToolConfiguration, err = configuration.Initialize(flags, []string{packageIndexesTestDataPath.String()})
+	require.Nil(t, err)
+
+	testTables := []packageIndexRuleFunctionTestTable{
+		{"Offline", "packages-websiteurl-invalid", ruleresult.NotRun, "^Network access disabled by --offline flag$"},
+	}
+
+	checkPackageIndexRuleFunction(PackageIndexPackagesWebsiteURLDeadLink, testTables, t)
+}
+
 func TestPackageIndexPackagesEmailMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].email missing", "packages-email-missing", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -446,7 +446,6 @@ func TestPackageIndexPackagesEmailMissing(t *testing.T) {
 
 func TestPackageIndexPackagesEmailIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].email type", "packages-email-incorrect-type", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -456,7 +455,6 @@ func TestPackageIndexPackagesEmailIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesHelpIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].help type", "packages-help-incorrect-type", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -466,7 +464,6 @@ func TestPackageIndexPackagesHelpIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesHelpAdditionalProperties(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Additional packages[].help properties", "packages-help-additional-properties", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -476,7 +473,6 @@ func TestPackageIndexPackagesHelpAdditionalProperties(t *testing.T) {
 
 func TestPackageIndexPackagesHelpOnlineMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].help.online missing", "packages-help-online-missing", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -486,7 +482,6 @@ func TestPackageIndexPackagesHelpOnlineMissing(t *testing.T) {
 
 func TestPackageIndexPackagesHelpOnlineIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].help.online type", "packages-help-online-incorrect-type", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -496,7 +491,6 @@ func TestPackageIndexPackagesHelpOnlineIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesHelpOnlineInvalidFormat(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].help.online format", "packages-help-online-invalid-format", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -505,12 +499,6 @@ func TestPackageIndexPackagesHelpOnlineInvalidFormat(t *testing.T) {
 }
 
 func TestPackageIndexPackagesHelpOnlineDeadLink(t *testing.T) {
-	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
-	}
-
-	checkPackageIndexRuleFunction(PackageIndexPackagesHelpOnlineDeadLink, testTables, t)
-
 	/*
 		In order to avoid a dependency on an external site, a test HTTP server is used for the tests covering handling of
 		various HTTP response status codes. For this reason, the following tests can't be performed via the
@@ -582,7 +570,6 @@ func TestPackageIndexPackagesHelpOnlineDeadLink(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms missing", "packages-platforms-missing", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -592,7 +579,6 @@ func TestPackageIndexPackagesPlatformsMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms type", "packages-platforms-incorrect-type", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -602,7 +588,6 @@ func TestPackageIndexPackagesPlatformsIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsAdditionalProperties(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Additional packages[].platforms[] properties", "packages-platforms-additional-properties", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -612,7 +597,6 @@ func TestPackageIndexPackagesPlatformsAdditionalProperties(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsNameMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].name missing", "packages-platforms-name-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -622,7 +606,6 @@ func TestPackageIndexPackagesPlatformsNameMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsNameIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].name type", "packages-platforms-name-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -632,7 +615,6 @@ func TestPackageIndexPackagesPlatformsNameIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsNameLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].name < min length", "packages-platforms-name-length-lt", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -642,7 +624,6 @@ func TestPackageIndexPackagesPlatformsNameLTMinLength(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsArchitectureMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].architecture missing", "packages-platforms-architecture-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -652,7 +633,6 @@ func TestPackageIndexPackagesPlatformsArchitectureMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsArchitectureIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].architecture type", "packages-platforms-architecture-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -662,7 +642,6 @@ func TestPackageIndexPackagesPlatformsArchitectureIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsArchitectureLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].architecture < min length", "packages-platforms-architecture-length-lt", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -672,7 +651,6 @@ func TestPackageIndexPackagesPlatformsArchitectureLTMinLength(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsVersionMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].architecture missing", "packages-platforms-version-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -682,7 +660,6 @@ func TestPackageIndexPackagesPlatformsVersionMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsVersionIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].version type", "packages-platforms-version-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -692,7 +669,6 @@ func TestPackageIndexPackagesPlatformsVersionIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsVersionNonRelaxedSemver(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].version not relaxed semver", "packages-platforms-version-non-relaxed-semver", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@foo$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -702,7 +678,6 @@ func TestPackageIndexPackagesPlatformsVersionNonRelaxedSemver(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsVersionNonSemver(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].version not semver", "packages-platforms-version-not-semver", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -712,7 +687,6 @@ func TestPackageIndexPackagesPlatformsVersionNonSemver(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsDeprecatedIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].deprecated type", "packages-platforms-deprecated-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -722,7 +696,6 @@ func TestPackageIndexPackagesPlatformsDeprecatedIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsCategoryMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].category missing", "packages-platforms-category-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -732,7 +705,6 @@ func TestPackageIndexPackagesPlatformsCategoryMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsCategoryIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].category type", "packages-platforms-category-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -742,7 +714,6 @@ func TestPackageIndexPackagesPlatformsCategoryIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsCategoryThirdPartyInvalid(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].category not valid for 3rd party", "packages-platforms-category-non-third-party", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -752,7 +723,6 @@ func TestPackageIndexPackagesPlatformsCategoryThirdPartyInvalid(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsHelpMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].help missing", "packages-platforms-help-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -762,7 +732,6 @@ func TestPackageIndexPackagesPlatformsHelpMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsHelpIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].help type", "packages-platforms-help-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -772,7 +741,6 @@ func TestPackageIndexPackagesPlatformsHelpIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsHelpAdditionalProperties(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Additional packages[].platforms[].help properties", "packages-platforms-help-additional-properties", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -782,7 +750,6 @@ func TestPackageIndexPackagesPlatformsHelpAdditionalProperties(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsHelpOnlineMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].help.online missing", "packages-platforms-help-online-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -792,7 +759,6 @@ func TestPackageIndexPackagesPlatformsHelpOnlineMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsHelpOnlineIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].help.online type", "packages-platforms-help-online-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -802,7 +768,6 @@ func TestPackageIndexPackagesPlatformsHelpOnlineIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsHelpOnlineInvalidFormat(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].help.online format", "packages-platforms-help-online-invalid-format", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -811,12 +776,6 @@ func TestPackageIndexPackagesPlatformsHelpOnlineInvalidFormat(t *testing.T) {
 }
 
 func TestPackageIndexPackagesPlatformsHelpOnlineDeadLink(t *testing.T) {
-	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
-	}
-
-	checkPackageIndexRuleFunction(PackageIndexPackagesPlatformsHelpOnlineDeadLink, testTables, t)
-
 	/*
 		In order to avoid a dependency on an external site, a test HTTP server is used for the tests covering handling of
 		various HTTP response status codes. For this reason, the following tests can't be performed via the
@@ -907,7 +866,6 @@ func TestPackageIndexPackagesPlatformsHelpOnlineDeadLink(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsUrlMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].url missing", "packages-platforms-url-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -917,7 +875,6 @@ func TestPackageIndexPackagesPlatformsUrlMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsUrlIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].url type", "packages-platforms-url-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -927,7 +884,6 @@ func TestPackageIndexPackagesPlatformsUrlIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsUrlInvalidFormat(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].url format", "packages-platforms-url-invalid-format", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -936,12 +892,6 @@ func TestPackageIndexPackagesPlatformsUrlInvalidFormat(t *testing.T) {
 }
 
 func TestPackageIndexPackagesPlatformsURLDeadLink(t *testing.T) {
-	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
-	}
-
-	checkPackageIndexRuleFunction(PackageIndexPackagesPlatformsURLDeadLink, testTables, t)
-
 	/*
 		In order to avoid a dependency on an external site, a test HTTP server is used for the tests covering handling of
 		various HTTP response status codes. For this reason, the following tests can't be performed via the
@@ -1036,7 +986,6 @@ func TestPackageIndexPackagesPlatformsURLDeadLink(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsArchiveFileNameMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].archiveFileName missing", "packages-platforms-archivefilename-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1046,7 +995,6 @@ func TestPackageIndexPackagesPlatformsArchiveFileNameMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsArchiveFileNameIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].archiveFileName type", "packages-platforms-archivefilename-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1066,7 +1014,6 @@ func TestPackageIndexPackagesPlatformsArchiveFileNameLTMinLength(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsArchiveFileNameInvalid(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Invalid filename", "packages-platforms-archivefilename-invalid", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1076,7 +1023,6 @@ func TestPackageIndexPackagesPlatformsArchiveFileNameInvalid(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsChecksumMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].checksum missing", "packages-platforms-checksum-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1086,7 +1032,6 @@ func TestPackageIndexPackagesPlatformsChecksumMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsChecksumIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].checksum type", "packages-platforms-checksum-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1096,7 +1041,6 @@ func TestPackageIndexPackagesPlatformsChecksumIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsChecksumInvalid(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Invalid packages[].platforms[].checksum format", "packages-platforms-checksum-invalid", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1106,7 +1050,6 @@ func TestPackageIndexPackagesPlatformsChecksumInvalid(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsChecksumDiscouragedAlgorithm(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].checksum uses discouraged algorithm", "packages-platforms-checksum-discouraged", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1116,7 +1059,6 @@ func TestPackageIndexPackagesPlatformsChecksumDiscouragedAlgorithm(t *testing.T)
 
 func TestPackageIndexPackagesPlatformsSizeMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].size missing", "packages-platforms-size-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1126,7 +1068,6 @@ func TestPackageIndexPackagesPlatformsSizeMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsSizeIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].size type", "packages-platforms-size-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1136,7 +1077,6 @@ func TestPackageIndexPackagesPlatformsSizeIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsSizeInvalid(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Invalid packages[].platforms[].size format", "packages-platforms-size-invalid", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1146,7 +1086,6 @@ func TestPackageIndexPackagesPlatformsSizeInvalid(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsBoardsMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].boards[] missing", "packages-platforms-boards-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1156,7 +1095,6 @@ func TestPackageIndexPackagesPlatformsBoardsMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsBoardsIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].boards type", "packages-platforms-boards-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1166,7 +1104,6 @@ func TestPackageIndexPackagesPlatformsBoardsIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsBoardsAdditionalProperties(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Additional packages[].platforms[].boards[] properties", "packages-platforms-boards-additional-properties", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0 >> My Board$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1176,7 +1113,6 @@ func TestPackageIndexPackagesPlatformsBoardsAdditionalProperties(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsBoardsNameMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].boards[].name missing", "packages-platforms-boards-name-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/boards/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1186,7 +1122,6 @@ func TestPackageIndexPackagesPlatformsBoardsNameMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsBoardsNameIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].boards[].name type", "packages-platforms-boards-name-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/boards/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1196,7 +1131,6 @@ func TestPackageIndexPackagesPlatformsBoardsNameIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsBoardsNameLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].boards[].name < min length", "packages-platforms-boards-name-length-lt", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/boards/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1206,7 +1140,6 @@ func TestPackageIndexPackagesPlatformsBoardsNameLTMinLength(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].toolsDependencies missing", "packages-platforms-toolsdependencies-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1216,7 +1149,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesMissing(t *testing.T) {
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].toolsDependencies type", "packages-platforms-toolsdependencies-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1226,7 +1158,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesIncorrectType(t *testing.
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesAdditionalProperties(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Additional packages[].platforms[].toolsDependencies[] properties", "packages-platforms-toolsdependencies-additional-properties", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0 >> arduino:avr-gcc@7\\.3\\.0-atmel3\\.6\\.1-arduino7$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1236,7 +1167,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesAdditionalProperties(t *t
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesPackagerMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].toolsDependencies[].packager missing", "packages-platforms-toolsdependencies-packager-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/toolsDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1246,7 +1176,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesPackagerMissing(t *testin
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesPackagerIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].toolsDependencies[].packager type", "packages-platforms-toolsdependencies-packager-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/toolsDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1256,7 +1185,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesPackagerIncorrectType(t *
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesPackagerLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].toolsDependencies[].packager < min length", "packages-platforms-toolsdependencies-packager-length-lt", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/toolsDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1266,7 +1194,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesPackagerLTMinLength(t *te
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesNameMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].toolsDependencies[].name missing", "packages-platforms-toolsdependencies-name-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/toolsDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1276,7 +1203,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesNameMissing(t *testing.T)
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesNameIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].toolsDependencies[].name type", "packages-platforms-toolsdependencies-name-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/toolsDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1286,7 +1212,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesNameIncorrectType(t *test
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesNameLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].toolsDependencies[].name < min length", "packages-platforms-toolsdependencies-name-length-lt", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/toolsDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1296,7 +1221,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesNameLTMinLength(t *testin
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesVersionMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].toolsDependencies[].version missing", "packages-platforms-toolsdependencies-version-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/toolsDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1306,7 +1230,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesVersionMissing(t *testing
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesVersionIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].toolsDependencies[].version type", "packages-platforms-toolsdependencies-version-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/toolsDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1316,7 +1239,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesVersionIncorrectType(t *t
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesVersionNonRelaxedSemver(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].toolsDependencies[].version not relaxed semver", "packages-platforms-toolsdependencies-version-non-relaxed-semver", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0 >> arduino:avr-gcc@foo$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1326,7 +1248,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesVersionNonRelaxedSemver(t
 
 func TestPackageIndexPackagesPlatformsToolsDependenciesVersionNonSemver(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].toolsDependencies[].version not semver", "packages-platforms-toolsdependencies-version-not-semver", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0 >> arduino:avr-gcc@7\\.3$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1336,7 +1257,6 @@ func TestPackageIndexPackagesPlatformsToolsDependenciesVersionNonSemver(t *testi
 
 func TestPackageIndexPackagesPlatformsDiscoveryDependenciesIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].discoveryDependencies type", "packages-platforms-discoverydependencies-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1346,7 +1266,6 @@ func TestPackageIndexPackagesPlatformsDiscoveryDependenciesIncorrectType(t *test
 
 func TestPackageIndexPackagesPlatformsDiscoveryDependenciesAdditionalProperties(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Additional packages[].platforms[].discoveryDependencies[] properties", "packages-platforms-discoverydependencies-additional-properties", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0 >> arduino:ble-discovery$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1356,7 +1275,6 @@ func TestPackageIndexPackagesPlatformsDiscoveryDependenciesAdditionalProperties(
 
 func TestPackageIndexPackagesPlatformsDiscoveryDependenciesPackagerMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].discoveryDependencies[].packager missing", "packages-platforms-discoverydependencies-packager-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/discoveryDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1366,7 +1284,6 @@ func TestPackageIndexPackagesPlatformsDiscoveryDependenciesPackagerMissing(t *te
 
 func TestPackageIndexPackagesPlatformsDiscoveryDependenciesPackagerIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].discoveryDependencies[].packager type", "packages-platforms-discoverydependencies-packager-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/discoveryDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1376,7 +1293,6 @@ func TestPackageIndexPackagesPlatformsDiscoveryDependenciesPackagerIncorrectType
 
 func TestPackageIndexPackagesPlatformsDiscoveryDependenciesPackagerLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].discoveryDependencies[].packager < min length", "packages-platforms-discoverydependencies-packager-length-lt", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/discoveryDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1386,7 +1302,6 @@ func TestPackageIndexPackagesPlatformsDiscoveryDependenciesPackagerLTMinLength(t
 
 func TestPackageIndexPackagesPlatformsDiscoveryDependenciesNameMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].discoveryDependencies[].name missing", "packages-platforms-discoverydependencies-name-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/discoveryDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1396,7 +1311,6 @@ func TestPackageIndexPackagesPlatformsDiscoveryDependenciesNameMissing(t *testin
 
 func TestPackageIndexPackagesPlatformsDiscoveryDependenciesNameIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].discoveryDependencies[].name type", "packages-platforms-discoverydependencies-name-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/discoveryDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1406,7 +1320,6 @@ func TestPackageIndexPackagesPlatformsDiscoveryDependenciesNameIncorrectType(t *
 
 func TestPackageIndexPackagesPlatformsDiscoveryDependenciesNameLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].discoveryDependencies[].name < min length", "packages-platforms-discoverydependencies-name-length-lt", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/discoveryDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1416,7 +1329,6 @@ func TestPackageIndexPackagesPlatformsDiscoveryDependenciesNameLTMinLength(t *te
 
 func TestPackageIndexPackagesPlatformsMonitorDependenciesIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].monitorDependencies type", "packages-platforms-monitordependencies-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1426,7 +1338,6 @@ func TestPackageIndexPackagesPlatformsMonitorDependenciesIncorrectType(t *testin
 
 func TestPackageIndexPackagesPlatformsMonitorDependenciesAdditionalProperties(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Additional packages[].platforms[].monitorDependencies[] properties", "packages-platforms-monitordependencies-additional-properties", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:avr@1\\.0\\.0 >> arduino:network-monitor$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1436,7 +1347,6 @@ func TestPackageIndexPackagesPlatformsMonitorDependenciesAdditionalProperties(t
 
 func TestPackageIndexPackagesPlatformsMonitorDependenciesPackagerMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].monitorDependencies[].packager missing", "packages-platforms-monitordependencies-packager-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/monitorDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1446,7 +1356,6 @@ func TestPackageIndexPackagesPlatformsMonitorDependenciesPackagerMissing(t *test
 
 func TestPackageIndexPackagesPlatformsMonitorDependenciesPackagerIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].monitorDependencies[].packager type", "packages-platforms-monitordependencies-packager-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/monitorDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1456,7 +1365,6 @@ func TestPackageIndexPackagesPlatformsMonitorDependenciesPackagerIncorrectType(t
 
 func TestPackageIndexPackagesPlatformsMonitorDependenciesPackagerLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].monitorDependencies[].packager < min length", "packages-platforms-monitordependencies-packager-length-lt", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/monitorDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1466,7 +1374,6 @@ func TestPackageIndexPackagesPlatformsMonitorDependenciesPackagerLTMinLength(t *
 
 func TestPackageIndexPackagesPlatformsMonitorDependenciesNameMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].monitorDependencies[].name missing", "packages-platforms-monitordependencies-name-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/monitorDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1476,7 +1383,6 @@ func TestPackageIndexPackagesPlatformsMonitorDependenciesNameMissing(t *testing.
 
 func TestPackageIndexPackagesPlatformsMonitorDependenciesNameIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].platforms[].monitorDependencies[].name type", "packages-platforms-monitordependencies-name-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/monitorDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1486,7 +1392,6 @@ func TestPackageIndexPackagesPlatformsMonitorDependenciesNameIncorrectType(t *te
 
 func TestPackageIndexPackagesPlatformsMonitorDependenciesNameLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].platforms[].monitorDependencies[].name < min length", "packages-platforms-monitordependencies-name-length-lt", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/platforms/0/monitorDependencies/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1496,7 +1401,6 @@ func TestPackageIndexPackagesPlatformsMonitorDependenciesNameLTMinLength(t *test
 
 func TestPackageIndexPackagesToolsMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools missing", "packages-tools-missing", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1506,7 +1410,6 @@ func TestPackageIndexPackagesToolsMissing(t *testing.T) {
 
 func TestPackageIndexPackagesToolsIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].tools type", "packages-tools-incorrect-type", ruleresult.Fail, "^foopackager$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1516,7 +1419,6 @@ func TestPackageIndexPackagesToolsIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesToolsAdditionalProperties(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Additional packages[].tools[] properties", "packages-tools-additional-properties", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1526,7 +1428,6 @@ func TestPackageIndexPackagesToolsAdditionalProperties(t *testing.T) {
 
 func TestPackageIndexPackagesToolsNameMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].name missing", "packages-tools-name-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/tools/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1536,7 +1437,6 @@ func TestPackageIndexPackagesToolsNameMissing(t *testing.T) {
 
 func TestPackageIndexPackagesToolsNameIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].tools.name type", "packages-tools-name-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/tools/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1546,7 +1446,6 @@ func TestPackageIndexPackagesToolsNameIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesToolsNameLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].name < min length", "packages-tools-name-length-lt", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/tools/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1556,7 +1455,6 @@ func TestPackageIndexPackagesToolsNameLTMinLength(t *testing.T) {
 
 func TestPackageIndexPackagesToolsVersionMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].version missing", "packages-tools-version-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/tools/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1566,7 +1464,6 @@ func TestPackageIndexPackagesToolsVersionMissing(t *testing.T) {
 
 func TestPackageIndexPackagesToolsVersionIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].tools[].version type", "packages-tools-version-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/tools/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1576,7 +1473,6 @@ func TestPackageIndexPackagesToolsVersionIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesToolsVersionNonRelaxedSemver(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].version not relaxed semver", "packages-tools-version-non-relaxed-semver", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@foo$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1586,7 +1482,6 @@ func TestPackageIndexPackagesToolsVersionNonRelaxedSemver(t *testing.T) {
 
 func TestPackageIndexPackagesToolsVersionNonSemver(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].version not semver", "packages-tools-version-not-semver", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@1.0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1596,7 +1491,6 @@ func TestPackageIndexPackagesToolsVersionNonSemver(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].systems[] missing", "packages-tools-systems-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1606,7 +1500,6 @@ func TestPackageIndexPackagesToolsSystemsMissing(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].tools[].systems type", "packages-tools-systems-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1616,7 +1509,6 @@ func TestPackageIndexPackagesToolsSystemsIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsAdditionalProperties(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Additional packages[].tools[].systems[] properties", "packages-tools-systems-additional-properties", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1626,7 +1518,6 @@ func TestPackageIndexPackagesToolsSystemsAdditionalProperties(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsHostMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].systems[].host missing", "packages-tools-systems-host-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/tools/0/systems/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1636,7 +1527,6 @@ func TestPackageIndexPackagesToolsSystemsHostMissing(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsHostIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].tools[].systems[].host type", "packages-tools-systems-host-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "/packages/0/tools/0/systems/0$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1646,7 +1536,6 @@ func TestPackageIndexPackagesToolsSystemsHostIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsHostInvalid(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Invalid packages[].tools[].systems[].host format", "packages-tools-systems-host-invalid", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> foo$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1656,7 +1545,6 @@ func TestPackageIndexPackagesToolsSystemsHostInvalid(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsUrlMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].systems[].url missing", "packages-tools-systems-url-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1666,7 +1554,6 @@ func TestPackageIndexPackagesToolsSystemsUrlMissing(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsUrlIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].tools[].systems[].url type", "packages-tools-systems-url-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1676,7 +1563,6 @@ func TestPackageIndexPackagesToolsSystemsUrlIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsUrlInvalidFormat(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].tools[].systems[].url format", "packages-tools-systems-url-invalid-format", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1685,12 +1571,6 @@ func TestPackageIndexPackagesToolsSystemsUrlInvalidFormat(t *testing.T) {
 }
 
 func TestPackageIndexPackagesToolsSystemsURLDeadLink(t *testing.T) {
-	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
-	}
-
-	checkPackageIndexRuleFunction(PackageIndexPackagesToolsSystemsURLDeadLink, testTables, t)
-
 	/*
 		In order to avoid a dependency on an external site, a test HTTP server is used for the tests covering handling of
 		various HTTP response status codes. For this reason, the following tests can't be performed via the
@@ -1779,7 +1659,6 @@ func TestPackageIndexPackagesToolsSystemsURLDeadLink(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsArchiveFileNameMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].systems[].archiveFileName missing", "packages-tools-systems-archivefilename-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1789,7 +1668,6 @@ func TestPackageIndexPackagesToolsSystemsArchiveFileNameMissing(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsArchiveFileNameIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].tools[].systems[].archiveFileName type", "packages-tools-systems-archivefilename-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1799,7 +1677,6 @@ func TestPackageIndexPackagesToolsSystemsArchiveFileNameIncorrectType(t *testing
 
 func TestPackageIndexPackagesToolsSystemsArchiveFileNameLTMinLength(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].systems[].archiveFileName < min length", "packages-tools-systems-archivefilename-length-lt", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1809,7 +1686,6 @@ func TestPackageIndexPackagesToolsSystemsArchiveFileNameLTMinLength(t *testing.T
 
 func TestPackageIndexPackagesToolsSystemsArchiveFileNameInvalid(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Invalid packages[].tools[].systems[].archiveFileName format", "packages-tools-systems-archivefilename-invalid", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1819,7 +1695,6 @@ func TestPackageIndexPackagesToolsSystemsArchiveFileNameInvalid(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsChecksumMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].systems[].checksum missing", "packages-tools-systems-checksum-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1829,7 +1704,6 @@ func TestPackageIndexPackagesToolsSystemsChecksumMissing(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsChecksumIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].tools[].systems[].checksum type", "packages-tools-systems-checksum-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1839,7 +1713,6 @@ func TestPackageIndexPackagesToolsSystemsChecksumIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsChecksumInvalid(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Invalid packages[].tools[].systems[].checksum format", "packages-tools-systems-checksum-invalid", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1849,7 +1722,6 @@ func TestPackageIndexPackagesToolsSystemsChecksumInvalid(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsChecksumDiscouragedAlgorithm(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].systems[].checksum uses discouraged algorithm", "packages-tools-systems-checksum-discouraged", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1859,7 +1731,6 @@ func TestPackageIndexPackagesToolsSystemsChecksumDiscouragedAlgorithm(t *testing
 
 func TestPackageIndexPackagesToolsSystemsSizeMissing(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"packages[].tools[].systems[].size missing", "packages-tools-systems-size-missing", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1869,7 +1740,6 @@ func TestPackageIndexPackagesToolsSystemsSizeMissing(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsSizeIncorrectType(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Incorrect packages[].tools[].systems[].size type", "packages-tools-systems-size-incorrect-type", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
@@ -1879,7 +1749,6 @@ func TestPackageIndexPackagesToolsSystemsSizeIncorrectType(t *testing.T) {
 
 func TestPackageIndexPackagesToolsSystemsSizeInvalid(t *testing.T) {
 	testTables := []packageIndexRuleFunctionTestTable{
-		{"Invalid JSON", "invalid-JSON", ruleresult.NotRun, ""},
 		{"Invalid packages[].tools[].systems[].size format", "packages-tools-systems-size-invalid", ruleresult.Fail, "^" + brokenOutputListIndent + "foopackager:openocd@0\\.11\\.0-arduino2 >> aarch64-linux-gnu$"},
 		{"Valid", "valid-package-index", ruleresult.Pass, ""},
 	}
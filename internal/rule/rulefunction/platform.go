@@ -18,8 +18,11 @@ package rulefunction
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/arduino/arduino-lint/internal/project/general"
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
 	"github.com/arduino/arduino-lint/internal/rule/schema"
@@ -31,1818 +34,1428 @@ import (
 // The rule functions for platforms.
 
 // BoardsTxtMissing checks whether the platform contains a boards.txt
-func BoardsTxtMissing() (result ruleresult.Type, output string) {
-	boardsTxtPath := projectdata.ProjectPath().Join("boards.txt")
+func BoardsTxtMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	boardsTxtPath := projectData.ProjectPath().Join("boards.txt")
 	exist, err := boardsTxtPath.ExistCheck()
 	if err != nil {
 		panic(err)
 	}
 
 	if exist {
-		return ruleresult.Pass, ""
+		return ruleresult.Pass, "", nil
 	}
 
-	return ruleresult.Fail, boardsTxtPath.String()
+	return ruleresult.Fail, boardsTxtPath.String(), []Finding{{Path: boardsTxtPath}}
 }
 
 // BoardsTxtFormat checks for invalid boards.txt format.
-func BoardsTxtFormat() (result ruleresult.Type, output string) {
-	if !projectdata.ProjectPath().Join("boards.txt").Exist() {
-		return ruleresult.NotRun, "boards.txt missing"
+func BoardsTxtFormat(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.ProjectPath().Join("boards.txt").Exist() {
+		return ruleresult.NotRun, "boards.txt missing", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() == nil {
-		return ruleresult.Pass, ""
+	if projectData.BoardsTxtLoadError() == nil {
+		return ruleresult.Pass, "", nil
 	}
 
-	return ruleresult.Fail, projectdata.BoardsTxtLoadError().Error()
+	return ruleresult.Fail, projectData.BoardsTxtLoadError().Error(), []Finding{{Path: projectData.ProjectPath().Join("boards.txt")}}
 }
 
 // BoardsTxtBoardIDNameMissing checks if any of the boards are missing name properties.
-func BoardsTxtBoardIDNameMissing() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func BoardsTxtBoardIDNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
-	}
-
-	nonCompliantBoardIDs := iDMissingRequiredProperty(projectdata.BoardsTxtBoardIds(), "name", projectdata.BoardsTxtSchemaValidationResult()[compliancelevel.Specification])
+	nonCompliantBoardIDs := iDMissingRequiredProperty(projectData.BoardsTxtBoardIds(), "name", projectData.BoardsTxtSchemaValidationResult()[compliancelevel.Specification])
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "name")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDNameLTMinLength checks if any of the board names are less than the minimum length.
-func BoardsTxtBoardIDNameLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
+func BoardsTxtBoardIDNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	nonCompliantBoardIDs := boardIDValueLTMinLength(projectdata.BoardsTxtBoardIds(), "name", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueLTMinLength(projectData, projectData.BoardsTxtBoardIds(), "name", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "name")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDBuildBoardMissing checks if any of the boards are missing build.board properties.
-func BoardsTxtBoardIDBuildBoardMissing() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func BoardsTxtBoardIDBuildBoardMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
-	}
-
-	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectdata.BoardsTxtBoardIds(), "build.board", false)
+	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectData, projectData.BoardsTxtBoardIds(), "build.board", false)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "build\\.board")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDBuildBoardLTMinLength checks if any of the board build.board values are less than the minimum length.
-func BoardsTxtBoardIDBuildBoardLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
+func BoardsTxtBoardIDBuildBoardLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	nonCompliantBoardIDs := boardIDValueLTMinLength(projectdata.BoardsTxtBoardIds(), "build\\.board", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueLTMinLength(projectData, projectData.BoardsTxtBoardIds(), "build\\.board", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "build\\.board")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDBuildCoreMissing checks if any of the boards are missing build.core properties.
-func BoardsTxtBoardIDBuildCoreMissing() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func BoardsTxtBoardIDBuildCoreMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtVisibleBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no visible boards", nil
 	}
 
-	if len(projectdata.BoardsTxtVisibleBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no visible boards"
-	}
-
-	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectdata.BoardsTxtVisibleBoardIds(), "build.core", false)
+	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectData, projectData.BoardsTxtVisibleBoardIds(), "build.core", false)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "build\\.core")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDBuildCoreLTMinLength checks if any of the board build.core values are less than the minimum length.
-func BoardsTxtBoardIDBuildCoreLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtVisibleBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no visible boards"
+func BoardsTxtBoardIDBuildCoreLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtVisibleBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no visible boards", nil
 	}
 
-	nonCompliantBoardIDs := boardIDValueLTMinLength(projectdata.BoardsTxtVisibleBoardIds(), "build\\.core", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueLTMinLength(projectData, projectData.BoardsTxtVisibleBoardIds(), "build\\.core", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "build\\.core")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtUserExtraFlagsUsage checks if the user's compiler.<pattern type>.extra_flags properties are used in boards.txt.
-func BoardsTxtUserExtraFlagsUsage() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
+func BoardsTxtUserExtraFlagsUsage(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
 	nonCompliantBoardIDs := []string{}
-	for _, boardID := range projectdata.BoardsTxtBoardIds() {
-		if schema.ValidationErrorMatch("#/"+boardID, "/userExtraFlagsProperties/", "", "", projectdata.BoardsTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	for _, boardID := range projectData.BoardsTxtBoardIds() {
+		if schema.ValidationErrorMatch("#/"+boardID, "/userExtraFlagsProperties/", "", "", projectData.BoardsTxtSchemaValidationResult()[compliancelevel.Strict]) {
 			nonCompliantBoardIDs = append(nonCompliantBoardIDs, boardID)
 		}
 	}
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), []Finding{{Path: projectData.ProjectPath().Join("boards.txt")}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDHideInvalid checks if any of the board hide values have invalid format
-func BoardsTxtBoardIDHideInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
+func BoardsTxtBoardIDHideInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectdata.BoardsTxtBoardIds(), "hide", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectData, projectData.BoardsTxtBoardIds(), "hide", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "hide")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtMenuMenuIDLTMinLength checks if any of the menu titles are less than the minimum length.
-func BoardsTxtMenuMenuIDLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtMenuIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no menus"
+func BoardsTxtMenuMenuIDLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtMenuIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no menus", nil
 	}
 
 	nonCompliantMenuIDs := []string{}
-	for _, menuID := range projectdata.BoardsTxtMenuIds() {
-		if schema.PropertyLessThanMinLength("menu/"+menuID, projectdata.BoardsTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, menuID := range projectData.BoardsTxtMenuIds() {
+		if schema.PropertyLessThanMinLength("menu/"+menuID, projectData.BoardsTxtSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliantMenuIDs = append(nonCompliantMenuIDs, menuID)
 		}
 	}
 
 	if len(nonCompliantMenuIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantMenuIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantMenuIDs, ", "), boardsTxtMenuIDFindings(projectData, nonCompliantMenuIDs)
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDSerialDisableDTRInvalid checks if any of the board serial.disableDTR values are invalid.
-func BoardsTxtBoardIDSerialDisableDTRInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
+func BoardsTxtBoardIDSerialDisableDTRInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectdata.BoardsTxtBoardIds(), "serial\\.disableDTR", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectData, projectData.BoardsTxtBoardIds(), "serial\\.disableDTR", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "serial\\.disableDTR")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDSerialDisableRTSInvalid checks if any of the board serial.disableRTS values are invalid.
-func BoardsTxtBoardIDSerialDisableRTSInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
+func BoardsTxtBoardIDSerialDisableRTSInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectdata.BoardsTxtBoardIds(), "serial\\.disableRTS", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectData, projectData.BoardsTxtBoardIds(), "serial\\.disableRTS", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "serial\\.disableRTS")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDUploadToolMissing checks if any of the boards are missing upload.tool properties.
-func BoardsTxtBoardIDUploadToolMissing() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func BoardsTxtBoardIDUploadToolMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtVisibleBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no visible boards", nil
 	}
 
-	if len(projectdata.BoardsTxtVisibleBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no visible boards"
-	}
-
-	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectdata.BoardsTxtVisibleBoardIds(), "upload.tool", true)
+	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectData, projectData.BoardsTxtVisibleBoardIds(), "upload.tool", true)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "upload\\.tool")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDUploadToolLTMinLength checks if any of the board upload.tool values are less than the minimum length.
-func BoardsTxtBoardIDUploadToolLTMinLength() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
+func BoardsTxtBoardIDUploadToolLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	nonCompliantBoardIDs := boardIDValueLTMinLength(projectdata.BoardsTxtBoardIds(), "upload\\.tool(\\..+)?", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueLTMinLength(projectData, projectData.BoardsTxtBoardIds(), "upload\\.tool(\\..+)?", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "upload\\.tool(\\..+)?")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDUploadMaximumSizeMissing checks if any of the boards are missing upload.maximum_size properties.
-func BoardsTxtBoardIDUploadMaximumSizeMissing() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func BoardsTxtBoardIDUploadMaximumSizeMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtVisibleBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no visible boards", nil
 	}
 
-	if len(projectdata.BoardsTxtVisibleBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no visible boards"
-	}
-
-	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectdata.BoardsTxtVisibleBoardIds(), "upload.maximum_size", false)
+	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectData, projectData.BoardsTxtVisibleBoardIds(), "upload.maximum_size", false)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "upload\\.maximum_size")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDUploadMaximumSizeInvalid checks if any of the board upload.maximum_size values have an invalid format.
-func BoardsTxtBoardIDUploadMaximumSizeInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
+func BoardsTxtBoardIDUploadMaximumSizeInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectdata.BoardsTxtBoardIds(), "upload(\\..+)?\\.maximum_size", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectData, projectData.BoardsTxtBoardIds(), "upload(\\..+)?\\.maximum_size", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "upload(\\..+)?\\.maximum_size")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDUploadMaximumDataSizeMissing checks if any of the boards are missing upload.maximum_data_size properties.
-func BoardsTxtBoardIDUploadMaximumDataSizeMissing() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func BoardsTxtBoardIDUploadMaximumDataSizeMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtVisibleBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no visible boards", nil
 	}
 
-	if len(projectdata.BoardsTxtVisibleBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no visible boards"
-	}
-
-	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectdata.BoardsTxtVisibleBoardIds(), "upload.maximum_data_size", false)
+	nonCompliantBoardIDs := boardIDMissingRequiredProperty(projectData, projectData.BoardsTxtVisibleBoardIds(), "upload.maximum_data_size", false)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "upload\\.maximum_data_size")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDUploadMaximumDataSizeInvalid checks if any of the board upload.maximum_data_size values have an invalid format.
-func BoardsTxtBoardIDUploadMaximumDataSizeInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
+func BoardsTxtBoardIDUploadMaximumDataSizeInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectdata.BoardsTxtBoardIds(), "upload(\\..+)?\\.maximum_data_size", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectData, projectData.BoardsTxtBoardIds(), "upload(\\..+)?\\.maximum_data_size", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "upload(\\..+)?\\.maximum_data_size")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDUploadUse1200bpsTouchInvalid checks if any of the board upload.use_1200bps_touch values are invalid.
-func BoardsTxtBoardIDUploadUse1200bpsTouchInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func BoardsTxtBoardIDUploadUse1200bpsTouchInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
-	}
-
-	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectdata.BoardsTxtBoardIds(), "upload(\\..+)?\\.use_1200bps_touch", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectData, projectData.BoardsTxtBoardIds(), "upload(\\..+)?\\.use_1200bps_touch", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "upload(\\..+)?\\.use_1200bps_touch")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDUploadWaitForUploadPortInvalid checks if any of the board upload.wait_for_upload_port values are invalid.
-func BoardsTxtBoardIDUploadWaitForUploadPortInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
+func BoardsTxtBoardIDUploadWaitForUploadPortInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectdata.BoardsTxtBoardIds(), "upload(\\..+)?\\.wait_for_upload_port", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValueEnumMismatch(projectData, projectData.BoardsTxtBoardIds(), "upload(\\..+)?\\.wait_for_upload_port", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "upload(\\..+)?\\.wait_for_upload_port")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDVidNInvalid checks if any of the board vid.n values have an invalid format.
-func BoardsTxtBoardIDVidNInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func BoardsTxtBoardIDVidNInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
-	}
-
-	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectdata.BoardsTxtBoardIds(), "vid\\.[0-9]+", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectData, projectData.BoardsTxtBoardIds(), "vid\\.[0-9]+", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "vid\\.[0-9]+")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // BoardsTxtBoardIDPidNInvalid checks if any of the board pid.n values have an invalid format.
-func BoardsTxtBoardIDPidNInvalid() (result ruleresult.Type, output string) {
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
-	}
-
-	if len(projectdata.BoardsTxtBoardIds()) == 0 {
-		return ruleresult.Skip, "boards.txt has no boards"
+func BoardsTxtBoardIDPidNInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if len(projectData.BoardsTxtBoardIds()) == 0 {
+		return ruleresult.Skip, "boards.txt has no boards", nil
 	}
 
-	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectdata.BoardsTxtBoardIds(), "pid\\.[0-9]+", compliancelevel.Specification)
+	nonCompliantBoardIDs := boardIDValuePatternMismatch(projectData, projectData.BoardsTxtBoardIds(), "pid\\.[0-9]+", compliancelevel.Specification)
 
 	if len(nonCompliantBoardIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantBoardIDs, ", "), boardsTxtBoardIDFindings(projectData, nonCompliantBoardIDs, "pid\\.[0-9]+")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // ProgrammersTxtFormat checks for invalid programmers.txt format.
-func ProgrammersTxtFormat() (result ruleresult.Type, output string) {
-	if !projectdata.ProgrammersTxtExists() {
-		return ruleresult.Skip, "Platform has no programmers.txt"
+func ProgrammersTxtFormat(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.ProgrammersTxtExists() {
+		return ruleresult.Skip, "Platform has no programmers.txt", nil
 	}
 
-	if projectdata.ProgrammersTxtLoadError() == nil {
-		return ruleresult.Pass, ""
+	if projectData.ProgrammersTxtLoadError() == nil {
+		return ruleresult.Pass, "", nil
 	}
 
-	return ruleresult.Fail, projectdata.ProgrammersTxtLoadError().Error()
+	return ruleresult.Fail, projectData.ProgrammersTxtLoadError().Error(), []Finding{{Path: projectData.ProjectPath().Join("programmers.txt")}}
 }
 
 // ProgrammersTxtProgrammerIDNameMissing checks if any of the programmers are missing name properties.
-func ProgrammersTxtProgrammerIDNameMissing() (result ruleresult.Type, output string) {
-	if !projectdata.ProgrammersTxtExists() {
-		return ruleresult.Skip, "Platform has no programmers.txt"
+func ProgrammersTxtProgrammerIDNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.ProgrammersTxtExists() {
+		return ruleresult.Skip, "Platform has no programmers.txt", nil
 	}
 
-	if projectdata.ProgrammersTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load programmers.txt"
+	if len(projectData.ProgrammersTxtProgrammerIds()) == 0 {
+		return ruleresult.Skip, "programmers.txt has no programmers", nil
 	}
 
-	if len(projectdata.ProgrammersTxtProgrammerIds()) == 0 {
-		return ruleresult.Skip, "programmers.txt has no programmers"
-	}
-
-	nonCompliantProgrammerIDs := programmerIDMissingRequiredProperty("name", compliancelevel.Specification)
+	nonCompliantProgrammerIDs := programmerIDMissingRequiredProperty(projectData, "name", compliancelevel.Specification)
 
 	if len(nonCompliantProgrammerIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantProgrammerIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantProgrammerIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // ProgrammersTxtProgrammerIDNameLTMinLength checks if any of the programmer names are less than the minimum length.
-func ProgrammersTxtProgrammerIDNameLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.ProgrammersTxtExists() {
-		return ruleresult.Skip, "Platform has no programmers.txt"
-	}
-
-	if projectdata.ProgrammersTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load programmers.txt"
+func ProgrammersTxtProgrammerIDNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.ProgrammersTxtExists() {
+		return ruleresult.Skip, "Platform has no programmers.txt", nil
 	}
 
-	if len(projectdata.ProgrammersTxtProgrammerIds()) == 0 {
-		return ruleresult.Skip, "programmers.txt has no programmers"
+	if len(projectData.ProgrammersTxtProgrammerIds()) == 0 {
+		return ruleresult.Skip, "programmers.txt has no programmers", nil
 	}
 
-	nonCompliantProgrammerIDs := programmerIDValueLTMinLength("name", compliancelevel.Specification)
+	nonCompliantProgrammerIDs := programmerIDValueLTMinLength(projectData, "name", compliancelevel.Specification)
 
 	if len(nonCompliantProgrammerIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantProgrammerIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantProgrammerIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // ProgrammersTxtProgrammerIDProgramToolMissing checks if any of the programmers are missing program.tool properties.
-func ProgrammersTxtProgrammerIDProgramToolMissing() (result ruleresult.Type, output string) {
-	if !projectdata.ProgrammersTxtExists() {
-		return ruleresult.Skip, "Platform has no programmers.txt"
-	}
-
-	if projectdata.ProgrammersTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load programmers.txt"
+func ProgrammersTxtProgrammerIDProgramToolMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.ProgrammersTxtExists() {
+		return ruleresult.Skip, "Platform has no programmers.txt", nil
 	}
 
-	if len(projectdata.ProgrammersTxtProgrammerIds()) == 0 {
-		return ruleresult.Skip, "programmers.txt has no programmers"
+	if len(projectData.ProgrammersTxtProgrammerIds()) == 0 {
+		return ruleresult.Skip, "programmers.txt has no programmers", nil
 	}
 
-	nonCompliantProgrammerIDs := programmerIDMissingRequiredProperty("program\\.tool", compliancelevel.Specification)
+	nonCompliantProgrammerIDs := programmerIDMissingRequiredProperty(projectData, "program\\.tool", compliancelevel.Specification)
 
 	if len(nonCompliantProgrammerIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantProgrammerIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantProgrammerIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // ProgrammersTxtProgrammerIDProgramToolLTMinLength checks if any of the programmer program.tool properties are less than the minimum length.
-func ProgrammersTxtProgrammerIDProgramToolLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.ProgrammersTxtExists() {
-		return ruleresult.Skip, "Platform has no programmers.txt"
-	}
-
-	if projectdata.ProgrammersTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load programmers.txt"
+func ProgrammersTxtProgrammerIDProgramToolLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.ProgrammersTxtExists() {
+		return ruleresult.Skip, "Platform has no programmers.txt", nil
 	}
 
-	if len(projectdata.ProgrammersTxtProgrammerIds()) == 0 {
-		return ruleresult.Skip, "programmers.txt has no programmers"
+	if len(projectData.ProgrammersTxtProgrammerIds()) == 0 {
+		return ruleresult.Skip, "programmers.txt has no programmers", nil
 	}
 
-	nonCompliantProgrammerIDs := programmerIDValueLTMinLength("program\\.tool", compliancelevel.Specification)
+	nonCompliantProgrammerIDs := programmerIDValueLTMinLength(projectData, "program\\.tool", compliancelevel.Specification)
 
 	if len(nonCompliantProgrammerIDs) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantProgrammerIDs, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantProgrammerIDs, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtFormat checks for invalid platform.txt format.
-func PlatformTxtFormat() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtFormat(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() == nil {
-		return ruleresult.Pass, ""
+	if projectData.PlatformTxtLoadError() == nil {
+		return ruleresult.Pass, "", nil
 	}
 
-	return ruleresult.Fail, projectdata.PlatformTxtLoadError().Error()
+	return ruleresult.Fail, projectData.PlatformTxtLoadError().Error(), []Finding{{Path: projectData.ProjectPath().Join("platform.txt")}}
 }
 
 // PlatformTxtNameMissing checks for missing name property in platform.txt.
-func PlatformTxtNameMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtNameMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if schema.RequiredPropertyMissing("name", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("name", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "name")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtNameLTMinLength checks if the platform.txt name property value is less than the minimum length.
-func PlatformTxtNameLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtNameLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if !projectData.PlatformTxt().ContainsKey("name") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("name") {
-		return ruleresult.NotRun, "Property not present"
+	if schema.PropertyLessThanMinLength("name", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "name")
 	}
 
-	if schema.PropertyLessThanMinLength("name", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtVersionMissing checks for missing version property in platform.txt.
-func PlatformTxtVersionMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtVersionMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if schema.RequiredPropertyMissing("version", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("version", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "version")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtVersionNonRelaxedSemver checks whether the platform.txt version property is "relaxed semver" compliant.
-func PlatformTxtVersionNonRelaxedSemver() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtVersionNonRelaxedSemver(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
-	}
-
-	version, ok := projectdata.PlatformTxt().GetOk("version")
+	version, ok := projectData.PlatformTxt().GetOk("version")
 	if !ok {
-		return ruleresult.NotRun, "Property not present"
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if schema.PropertyPatternMismatch("version", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, version
+	if schema.PropertyPatternMismatch("version", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, version, platformTxtPropertyFindings(projectData, "version")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtVersionNonSemver checks whether the platform.txt version property is semver compliant.
-func PlatformTxtVersionNonSemver() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtVersionNonSemver(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	version, ok := projectdata.PlatformTxt().GetOk("version")
+	version, ok := projectData.PlatformTxt().GetOk("version")
 	if !ok {
-		return ruleresult.NotRun, "Property not present"
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if schema.PropertyPatternMismatch("version", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, version
+	if schema.PropertyPatternMismatch("version", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, version, platformTxtPropertyFindings(projectData, "version")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerWarningFlagsNoneMissing checks for missing compiler.warning_flags.none property in platform.txt.
-func PlatformTxtCompilerWarningFlagsNoneMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtCompilerWarningFlagsNoneMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.none", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.warning_flags.none")
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
-	}
-
-	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.none", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerWarningFlagsDefaultMissing checks for missing compiler.warning_flags.default property in platform.txt.
-func PlatformTxtCompilerWarningFlagsDefaultMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
-	}
-
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func PlatformTxtCompilerWarningFlagsDefaultMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.default", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.default", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.warning_flags.default")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerWarningFlagsMoreMissing checks for missing compiler.warning_flags.more property in platform.txt.
-func PlatformTxtCompilerWarningFlagsMoreMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtCompilerWarningFlagsMoreMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.more", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.warning_flags.more")
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
-	}
-
-	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.more", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerWarningFlagsAllMissing checks for missing compiler.warning_flags.all property in platform.txt.
-func PlatformTxtCompilerWarningFlagsAllMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
-	}
-
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func PlatformTxtCompilerWarningFlagsAllMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.all", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("compiler\\.warning_flags\\.all", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.warning_flags.all")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerOptimizationFlagsDebugMissing checks for missing compiler.optimization_flags.debug property in platform.txt.
-func PlatformTxtCompilerOptimizationFlagsDebugMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
-	}
-
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func PlatformTxtCompilerOptimizationFlagsDebugMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.optimization_flags.release") {
-		return ruleresult.Skip, "Dependent property not present"
+	if !projectData.PlatformTxt().ContainsKey("compiler.optimization_flags.release") {
+		return ruleresult.Skip, "Dependent property not present", nil
 	}
 
-	if schema.PropertyDependenciesMissing("compiler\\.optimization_flags\\.release", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyDependenciesMissing("compiler\\.optimization_flags\\.release", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.optimization_flags.release")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerOptimizationFlagsReleaseMissing checks for missing compiler.optimization_flags.release property in platform.txt.
-func PlatformTxtCompilerOptimizationFlagsReleaseMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtCompilerOptimizationFlagsReleaseMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if !projectData.PlatformTxt().ContainsKey("compiler.optimization_flags.debug") {
+		return ruleresult.Skip, "Dependent property not present", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.optimization_flags.debug") {
-		return ruleresult.Skip, "Dependent property not present"
+	if schema.PropertyDependenciesMissing("compiler\\.optimization_flags\\.debug", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.optimization_flags.debug")
 	}
 
-	if schema.PropertyDependenciesMissing("compiler\\.optimization_flags\\.debug", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerCExtraFlagsMissing checks for missing compiler.c.extra_flags property in platform.txt.
-func PlatformTxtCompilerCExtraFlagsMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtCompilerCExtraFlagsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if schema.RequiredPropertyMissing("compiler\\.c\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.c.extra_flags")
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.c\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerCExtraFlagsNotEmpty checks for non-empty compiler.c.extra_flags property in platform.txt.
-func PlatformTxtCompilerCExtraFlagsNotEmpty() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtCompilerCExtraFlagsNotEmpty(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.c.extra_flags") {
-		return ruleresult.Skip, "Property not present"
+	if !projectData.PlatformTxt().ContainsKey("compiler.c.extra_flags") {
+		return ruleresult.Skip, "Property not present", nil
 	}
 
-	if schema.PropertyEnumMismatch("compiler\\.c\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyEnumMismatch("compiler\\.c\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.c.extra_flags")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerCppExtraFlagsMissing checks for missing compiler.cpp.extra_flags property in platform.txt.
-func PlatformTxtCompilerCppExtraFlagsMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtCompilerCppExtraFlagsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if schema.RequiredPropertyMissing("compiler\\.cpp\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.cpp.extra_flags")
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
-	}
-
-	if schema.RequiredPropertyMissing("compiler\\.cpp\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerCppExtraFlagsNotEmpty checks for non-empty compiler.cpp.extra_flags property in platform.txt.
-func PlatformTxtCompilerCppExtraFlagsNotEmpty() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtCompilerCppExtraFlagsNotEmpty(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if !projectData.PlatformTxt().ContainsKey("compiler.cpp.extra_flags") {
+		return ruleresult.Skip, "Property not present", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.cpp.extra_flags") {
-		return ruleresult.Skip, "Property not present"
+	if schema.PropertyEnumMismatch("compiler\\.cpp\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.cpp.extra_flags")
 	}
 
-	if schema.PropertyEnumMismatch("compiler\\.cpp\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerSExtraFlagsMissing checks for missing compiler.S.extra_flags property in platform.txt.
-func PlatformTxtCompilerSExtraFlagsMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtCompilerSExtraFlagsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if schema.RequiredPropertyMissing("compiler\\.S\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.S.extra_flags")
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.S\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerSExtraFlagsNotEmpty checks for non-empty compiler.S.extra_flags property in platform.txt.
-func PlatformTxtCompilerSExtraFlagsNotEmpty() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtCompilerSExtraFlagsNotEmpty(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.S.extra_flags") {
-		return ruleresult.Skip, "Property not present"
+	if !projectData.PlatformTxt().ContainsKey("compiler.S.extra_flags") {
+		return ruleresult.Skip, "Property not present", nil
 	}
 
-	if schema.PropertyEnumMismatch("compiler\\.S\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyEnumMismatch("compiler\\.S\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.S.extra_flags")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerArExtraFlagsMissing checks for missing compiler.ar.extra_flags property in platform.txt.
-func PlatformTxtCompilerArExtraFlagsMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
-	}
-
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func PlatformTxtCompilerArExtraFlagsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.ar\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("compiler\\.ar\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.ar.extra_flags")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerArExtraFlagsNotEmpty checks for non-empty compiler.ar.extra_flags property in platform.txt.
-func PlatformTxtCompilerArExtraFlagsNotEmpty() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtCompilerArExtraFlagsNotEmpty(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.ar.extra_flags") {
-		return ruleresult.Skip, "Property not present"
+	if !projectData.PlatformTxt().ContainsKey("compiler.ar.extra_flags") {
+		return ruleresult.Skip, "Property not present", nil
 	}
 
-	if schema.PropertyEnumMismatch("compiler\\.ar\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyEnumMismatch("compiler\\.ar\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.ar.extra_flags")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerCElfExtraFlagsMissing checks for missing compiler.c.elf.extra_flags property in platform.txt.
-func PlatformTxtCompilerCElfExtraFlagsMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
-	}
-
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func PlatformTxtCompilerCElfExtraFlagsMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if schema.RequiredPropertyMissing("compiler\\.c\\.elf\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("compiler\\.c\\.elf\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.c.elf.extra_flags")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtCompilerCElfExtraFlagsNotEmpty checks for non-empty compiler.c.elf.extra_flags property in platform.txt.
-func PlatformTxtCompilerCElfExtraFlagsNotEmpty() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtCompilerCElfExtraFlagsNotEmpty(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if !projectData.PlatformTxt().ContainsKey("compiler.c.elf.extra_flags") {
+		return ruleresult.Skip, "Property not present", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("compiler.c.elf.extra_flags") {
-		return ruleresult.Skip, "Property not present"
+	if schema.PropertyEnumMismatch("compiler\\.c\\.elf\\.extra_flags", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "compiler.c.elf.extra_flags")
 	}
 
-	if schema.PropertyEnumMismatch("compiler\\.c\\.elf\\.extra_flags", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipePreprocMacrosLTMinLength checks if the platform.txt recipe.preproc.macros property value is less than the minimum length.
-func PlatformTxtRecipePreprocMacrosLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtRecipePreprocMacrosLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.preproc.macros") {
-		return ruleresult.Skip, "Property not present"
+	if !projectData.PlatformTxt().ContainsKey("recipe.preproc.macros") {
+		return ruleresult.Skip, "Property not present", nil
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.preproc\\.macros", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyLessThanMinLength("recipe\\.preproc\\.macros", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.preproc.macros")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipePreprocMacrosExtraFlagsSupport checks if platform.txt recipe.preproc.macros provides support for user extra flags.
-func PlatformTxtRecipePreprocMacrosExtraFlagsSupport() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtRecipePreprocMacrosExtraFlagsSupport(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if !projectData.PlatformTxt().ContainsKey("recipe.preproc.macros") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.preproc.macros") {
-		return ruleresult.NotRun, "Property not present"
+	if schema.PropertyPatternMismatch("recipe\\.preproc\\.macros", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.preproc.macros")
 	}
 
-	if schema.PropertyPatternMismatch("recipe\\.preproc\\.macros", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeCOPatternMissing checks for missing recipe.c.o.pattern property in platform.txt.
-func PlatformTxtRecipeCOPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtRecipeCOPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if schema.RequiredPropertyMissing("recipe\\.c\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.c.o.pattern")
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.c\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeCOPatternLTMinLength checks if the platform.txt recipe.c.o.pattern property value is less than the minimum length.
-func PlatformTxtRecipeCOPatternLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtRecipeCOPatternLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.c.o.pattern") {
-		return ruleresult.NotRun, "Property not present"
+	if !projectData.PlatformTxt().ContainsKey("recipe.c.o.pattern") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.c\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyLessThanMinLength("recipe\\.c\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.c.o.pattern")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeCOPatternExtraFlagsSupport checks if platform.txt recipe.c.o.pattern provides support for user extra flags.
-func PlatformTxtRecipeCOPatternExtraFlagsSupport() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtRecipeCOPatternExtraFlagsSupport(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if !projectData.PlatformTxt().ContainsKey("recipe.c.o.pattern") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.c.o.pattern") {
-		return ruleresult.NotRun, "Property not present"
+	if schema.PropertyPatternMismatch("recipe\\.c\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.c.o.pattern")
 	}
 
-	if schema.PropertyPatternMismatch("recipe\\.c\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeCppOPatternMissing checks for missing recipe.cpp.o.pattern property in platform.txt.
-func PlatformTxtRecipeCppOPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtRecipeCppOPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if schema.RequiredPropertyMissing("recipe\\.cpp\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.cpp.o.pattern")
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.cpp\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeCppOPatternLTMinLength checks if the platform.txt recipe.cpp.o.pattern property value is less than the minimum length.
-func PlatformTxtRecipeCppOPatternLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtRecipeCppOPatternLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.cpp.o.pattern") {
-		return ruleresult.NotRun, "Property not present"
+	if !projectData.PlatformTxt().ContainsKey("recipe.cpp.o.pattern") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.cpp\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyLessThanMinLength("recipe\\.cpp\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.cpp.o.pattern")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeCppOPatternExtraFlagsSupport checks if platform.txt recipe.cpp.o.pattern provides support for user extra flags.
-func PlatformTxtRecipeCppOPatternExtraFlagsSupport() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtRecipeCppOPatternExtraFlagsSupport(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if !projectData.PlatformTxt().ContainsKey("recipe.cpp.o.pattern") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.cpp.o.pattern") {
-		return ruleresult.NotRun, "Property not present"
+	if schema.PropertyPatternMismatch("recipe\\.cpp\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.cpp.o.pattern")
 	}
 
-	if schema.PropertyPatternMismatch("recipe\\.cpp\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeSOPatternMissing checks for missing recipe.S.o.pattern property in platform.txt.
-func PlatformTxtRecipeSOPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
-	}
-
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func PlatformTxtRecipeSOPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.S\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("recipe\\.S\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.S.o.pattern")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeSOPatternLTMinLength checks if the platform.txt recipe.S.o.pattern property value is less than the minimum length.
-func PlatformTxtRecipeSOPatternLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtRecipeSOPatternLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.S.o.pattern") {
-		return ruleresult.NotRun, "Property not present"
+	if !projectData.PlatformTxt().ContainsKey("recipe.S.o.pattern") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.S\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyLessThanMinLength("recipe\\.S\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.S.o.pattern")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeSOPatternExtraFlagsSupport checks if platform.txt recipe.S.o.pattern provides support for user extra flags.
-func PlatformTxtRecipeSOPatternExtraFlagsSupport() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtRecipeSOPatternExtraFlagsSupport(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.S.o.pattern") {
-		return ruleresult.NotRun, "Property not present"
+	if !projectData.PlatformTxt().ContainsKey("recipe.S.o.pattern") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if schema.PropertyPatternMismatch("recipe\\.S\\.o\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyPatternMismatch("recipe\\.S\\.o\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.S.o.pattern")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeArPatternMissing checks for missing recipe.ar.pattern property in platform.txt.
-func PlatformTxtRecipeArPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtRecipeArPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if schema.RequiredPropertyMissing("recipe\\.ar\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.ar.pattern")
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
-	}
-
-	if schema.RequiredPropertyMissing("recipe\\.ar\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeArPatternLTMinLength checks if the platform.txt recipe.ar.pattern property value is less than the minimum length.
-func PlatformTxtRecipeArPatternLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtRecipeArPatternLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if !projectData.PlatformTxt().ContainsKey("recipe.ar.pattern") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.ar.pattern") {
-		return ruleresult.NotRun, "Property not present"
+	if schema.PropertyLessThanMinLength("recipe\\.ar\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.ar.pattern")
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.ar\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeArPatternExtraFlagsSupport checks if platform.txt recipe.ar.pattern provides support for user extra flags.
-func PlatformTxtRecipeArPatternExtraFlagsSupport() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtRecipeArPatternExtraFlagsSupport(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.ar.pattern") {
-		return ruleresult.NotRun, "Property not present"
+	if !projectData.PlatformTxt().ContainsKey("recipe.ar.pattern") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if schema.PropertyPatternMismatch("recipe\\.ar\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyPatternMismatch("recipe\\.ar\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.ar.pattern")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeCCombinePatternMissing checks for missing recipe.c.combine.pattern property in platform.txt.
-func PlatformTxtRecipeCCombinePatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtRecipeCCombinePatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if schema.RequiredPropertyMissing("recipe\\.c\\.combine\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.c.combine.pattern")
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
-	}
-
-	if schema.RequiredPropertyMissing("recipe\\.c\\.combine\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeCCombinePatternLTMinLength checks if the platform.txt recipe.c.combine.pattern property value is less than the minimum length.
-func PlatformTxtRecipeCCombinePatternLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtRecipeCCombinePatternLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if !projectData.PlatformTxt().ContainsKey("recipe.c.combine.pattern") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.c.combine.pattern") {
-		return ruleresult.NotRun, "Property not present"
+	if schema.PropertyLessThanMinLength("recipe\\.c\\.combine\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.c.combine.pattern")
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.c\\.combine\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeCCombinePatternExtraFlagsSupport checks if platform.txt recipe.c.combine.pattern provides support for user extra flags.
-func PlatformTxtRecipeCCombinePatternExtraFlagsSupport() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtRecipeCCombinePatternExtraFlagsSupport(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.c.combine.pattern") {
-		return ruleresult.NotRun, "Property not present"
+	if !projectData.PlatformTxt().ContainsKey("recipe.c.combine.pattern") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if schema.PropertyPatternMismatch("recipe\\.c\\.combine\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyPatternMismatch("recipe\\.c\\.combine\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.c.combine.pattern")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeOutputTmpFileMissing checks for missing recipe.output.tmp_file property in platform.txt.
-func PlatformTxtRecipeOutputTmpFileMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtRecipeOutputTmpFileMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if schema.RequiredPropertyMissing("recipe\\.output\\.tmp_file", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.output.tmp_file")
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
-	}
-
-	if schema.RequiredPropertyMissing("recipe\\.output\\.tmp_file", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeOutputTmpFileLTMinLength checks if the platform.txt recipe.output.tmp_file property value is less than the minimum length.
-func PlatformTxtRecipeOutputTmpFileLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtRecipeOutputTmpFileLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if !projectData.PlatformTxt().ContainsKey("recipe.output.tmp_file") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.output.tmp_file") {
-		return ruleresult.NotRun, "Property not present"
+	if schema.PropertyLessThanMinLength("recipe\\.output\\.tmp_file", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.output.tmp_file")
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.output\\.tmp_file", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeOutputSaveFileMissing checks for missing recipe.output.save_file property in platform.txt.
-func PlatformTxtRecipeOutputSaveFileMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
-	}
-
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func PlatformTxtRecipeOutputSaveFileMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.output\\.save_file", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("recipe\\.output\\.save_file", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.output.save_file")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeOutputSaveFileLTMinLength checks if the platform.txt recipe.output.save_file property value is less than the minimum length.
-func PlatformTxtRecipeOutputSaveFileLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtRecipeOutputSaveFileLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.output.save_file") {
-		return ruleresult.NotRun, "Property not present"
+	if !projectData.PlatformTxt().ContainsKey("recipe.output.save_file") {
+		return ruleresult.NotRun, "Property not present", nil
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.output\\.save_file", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyLessThanMinLength("recipe\\.output\\.save_file", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.output.save_file")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeSizePatternMissing checks for missing recipe.size.pattern property in platform.txt.
-func PlatformTxtRecipeSizePatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtRecipeSizePatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if schema.RequiredPropertyMissing("recipe\\.size\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.size.pattern")
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.size\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeSizePatternLTMinLength checks if the platform.txt recipe.size.pattern property value is less than the minimum length.
-func PlatformTxtRecipeSizePatternLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtRecipeSizePatternLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if !projectdata.PlatformTxt().ContainsKey("recipe.size.pattern") {
-		return ruleresult.Skip, "Property not present"
+	if !projectData.PlatformTxt().ContainsKey("recipe.size.pattern") {
+		return ruleresult.Skip, "Property not present", nil
 	}
 
-	if schema.PropertyLessThanMinLength("recipe\\.size\\.pattern", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.PropertyLessThanMinLength("recipe\\.size\\.pattern", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.size.pattern")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeSizeRegexMissing checks for missing recipe.size.regex property in platform.txt.
-func PlatformTxtRecipeSizeRegexMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
-	}
-
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+func PlatformTxtRecipeSizeRegexMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if schema.RequiredPropertyMissing("recipe\\.size\\.regex", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
+	if schema.RequiredPropertyMissing("recipe\\.size\\.regex", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.size.regex")
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtRecipeSizeRegexDataMissing checks for missing recipe.size.regex.data property in platform.txt.
-func PlatformTxtRecipeSizeRegexDataMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtRecipeSizeRegexDataMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if platformReferencesCore(projectData) {
+		return ruleresult.Skip, "Core reference used", nil
 	}
 
-	if projectdata.BoardsTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load boards.txt"
+	if schema.RequiredPropertyMissing("recipe\\.size\\.regex\\.data", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+		return ruleresult.Fail, "", platformTxtPropertyFindings(projectData, "recipe.size.regex.data")
 	}
 
-	if platformReferencesCore() {
-		return ruleresult.Skip, "Core reference used"
-	}
-
-	if schema.RequiredPropertyMissing("recipe\\.size\\.regex\\.data", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtUploadParamsVerboseMissing checks if any of the tools are missing upload.params.verbose properties.
-func PlatformTxtUploadParamsVerboseMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtUploadParamsVerboseMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	// The function is not used by a rule, so it can't depend on rule PF031.
+	if projectData.PlatformTxtLoadError() != nil {
+		return ruleresult.NotRun, "Couldn't load platform.txt", nil
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no tools"
+	if len(projectData.PlatformTxtToolNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no tools", nil
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("upload/params/verbose", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "upload/params/verbose", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantTools, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtUploadParamsQuietMissing checks if any of the tools are missing upload.params.quiet properties.
-func PlatformTxtUploadParamsQuietMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtUploadParamsQuietMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	// The function is not used by a rule, so it can't depend on rule PF031.
+	if projectData.PlatformTxtLoadError() != nil {
+		return ruleresult.NotRun, "Couldn't load platform.txt", nil
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no tools"
+	if len(projectData.PlatformTxtToolNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no tools", nil
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("upload/params/quiet", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "upload/params/quiet", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantTools, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtPluggableDiscoveryRequiredInvalid checks if any of the pluggable discovery tool references have invalid format.
-func PlatformTxtPluggableDiscoveryRequiredInvalid() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtPluggableDiscoveryRequiredInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if !containsKeyOrParent(projectData.PlatformTxt(), "pluggable_discovery.required") {
+		return ruleresult.Skip, "Property not present", nil
 	}
 
-	if !containsKeyOrParent(projectdata.PlatformTxt(), "pluggable_discovery.required") {
-		return ruleresult.Skip, "Property not present"
+	if schema.PropertyPatternMismatch("pluggable_discovery/required", projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+		return ruleresult.Fail, "", nil
 	}
 
-	if schema.PropertyPatternMismatch("pluggable_discovery/required", projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
-		return ruleresult.Fail, ""
-	}
-
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtPluggableDiscoveryDiscoveryIDPatternMissing checks if any of the manual installation pluggable discoveries
 // are missing pattern properties.
-func PlatformTxtPluggableDiscoveryDiscoveryIDPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtPluggableDiscoveryDiscoveryIDPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if len(projectdata.PlatformTxtPluggableDiscoveryNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no manual installation pluggable discoveries"
+	if len(projectData.PlatformTxtPluggableDiscoveryNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no manual installation pluggable discoveries", nil
 	}
 
 	nonCompliant := []string{}
-	for _, discovery := range projectdata.PlatformTxtPluggableDiscoveryNames() {
+	for _, discovery := range projectData.PlatformTxtPluggableDiscoveryNames() {
 		if schema.RequiredPropertyMissing(
 			fmt.Sprintf("pluggable_discovery/%s/pattern", discovery),
-			projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification],
+			projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification],
 		) {
 			nonCompliant = append(nonCompliant, discovery)
 		}
 	}
 
 	if len(nonCompliant) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliant, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliant, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtPluggableMonitorPatternProtocolIDLTMinLength checks if the platform.txt pluggable_monitor.pattern.PROTOCOL_ID property value is less than the minimum length.
-func PlatformTxtPluggableMonitorPatternProtocolIDLTMinLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtPluggableMonitorPatternProtocolIDLTMinLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
-	}
-
-	if projectdata.PlatformTxt().SubTree("pluggable_monitor.pattern").Size() == 0 {
-		return ruleresult.Skip, "Property not present"
+	if projectData.PlatformTxt().SubTree("pluggable_monitor.pattern").Size() == 0 {
+		return ruleresult.Skip, "Property not present", nil
 	}
 
 	nonCompliant := []string{}
-	for _, protocol := range projectdata.PlatformTxt().SubTree("pluggable_monitor.pattern").Keys() {
-		if schema.PropertyLessThanMinLength("pluggable_monitor\\.pattern/"+protocol, projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, protocol := range projectData.PlatformTxt().SubTree("pluggable_monitor.pattern").Keys() {
+		if schema.PropertyLessThanMinLength("pluggable_monitor\\.pattern/"+protocol, projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliant = append(nonCompliant, protocol)
 		}
 	}
 
 	if len(nonCompliant) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliant, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliant, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtPluggableMonitorRequiredProtocolIDInvalid checks if any of the pluggable monitor tool references have invalid format.
-func PlatformTxtPluggableMonitorRequiredProtocolIDInvalid() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtPluggableMonitorRequiredProtocolIDInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxt().SubTree("pluggable_monitor.required").Size() == 0 {
-		return ruleresult.Skip, "Property not present"
+	if projectData.PlatformTxt().SubTree("pluggable_monitor.required").Size() == 0 {
+		return ruleresult.Skip, "Property not present", nil
 	}
 
 	nonCompliant := []string{}
-	for _, protocol := range projectdata.PlatformTxt().SubTree("pluggable_monitor.required").Keys() {
-		if schema.PropertyPatternMismatch("pluggable_monitor\\.required/"+protocol, projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
+	for _, protocol := range projectData.PlatformTxt().SubTree("pluggable_monitor.required").Keys() {
+		if schema.PropertyPatternMismatch("pluggable_monitor\\.required/"+protocol, projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Specification]) {
 			nonCompliant = append(nonCompliant, protocol)
 		}
 	}
 
 	if len(nonCompliant) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliant, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliant, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtUploadFieldFieldNameGTMaxLength checks if any platform.txt tools.UPLOAD_RECIPE_ID.upload.field.FIELD_NAME property value is greater than the maximum length.
-func PlatformTxtUploadFieldFieldNameGTMaxLength() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtUploadFieldFieldNameGTMaxLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
-	}
-
-	if len(projectdata.PlatformTxtUserProvidedFieldNames()) == 0 {
-		return ruleresult.Skip, "Property not present"
+	if len(projectData.PlatformTxtUserProvidedFieldNames()) == 0 {
+		return ruleresult.Skip, "Property not present", nil
 	}
 
 	nonCompliant := []string{}
-	for _, toolName := range projectdata.PlatformTxtToolNames() {
-		for _, fieldName := range projectdata.PlatformTxtUserProvidedFieldNames()[toolName] {
-			if schema.PropertyGreaterThanMaxLength(fmt.Sprintf("tools/%s/upload/field/%s", toolName, fieldName), projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+	for _, toolName := range projectData.PlatformTxtToolNames() {
+		for _, fieldName := range projectData.PlatformTxtUserProvidedFieldNames()[toolName] {
+			if schema.PropertyGreaterThanMaxLength(fmt.Sprintf("tools/%s/upload/field/%s", toolName, fieldName), projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
 				nonCompliant = append(nonCompliant, fmt.Sprintf("%s >> %s", toolName, fieldName))
 			}
 		}
 	}
 
 	if len(nonCompliant) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliant, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliant, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtUploadFieldFieldNameSecretInvalid checks if any of the platform.txt tools.UPLOAD_RECIPE_ID.upload.field.FIELD_NAME.secret property values have invalid format.
-func PlatformTxtUploadFieldFieldNameSecretInvalid() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtUploadFieldFieldNameSecretInvalid(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if len(projectdata.PlatformTxtUserProvidedFieldNames()) == 0 {
-		return ruleresult.Skip, "Property not present"
+	if len(projectData.PlatformTxtUserProvidedFieldNames()) == 0 {
+		return ruleresult.Skip, "Property not present", nil
 	}
 
 	found := false
 	nonCompliant := []string{}
-	for _, toolName := range projectdata.PlatformTxtToolNames() {
-		for _, fieldName := range projectdata.PlatformTxtUserProvidedFieldNames()[toolName] {
-			if projectdata.PlatformTxt().ContainsKey(fmt.Sprintf("tools.%s.upload.field.%s.secret", toolName, fieldName)) {
+	for _, toolName := range projectData.PlatformTxtToolNames() {
+		for _, fieldName := range projectData.PlatformTxtUserProvidedFieldNames()[toolName] {
+			if projectData.PlatformTxt().ContainsKey(fmt.Sprintf("tools.%s.upload.field.%s.secret", toolName, fieldName)) {
 				found = true
-				if schema.PropertyEnumMismatch(fmt.Sprintf("tools/%s/upload/field/%s\\.secret", toolName, fieldName), projectdata.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
+				if schema.PropertyEnumMismatch(fmt.Sprintf("tools/%s/upload/field/%s\\.secret", toolName, fieldName), projectData.PlatformTxtSchemaValidationResult()[compliancelevel.Strict]) {
 					nonCompliant = append(nonCompliant, fmt.Sprintf("%s >> %s", toolName, fieldName))
 				}
 			}
@@ -1850,244 +1463,204 @@ func PlatformTxtUploadFieldFieldNameSecretInvalid() (result ruleresult.Type, out
 	}
 
 	if !found {
-		return ruleresult.Skip, "Property not present"
+		return ruleresult.Skip, "Property not present", nil
 	}
 
 	if len(nonCompliant) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliant, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliant, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtUploadPatternMissing checks if any of the tools are missing upload.pattern properties.
-func PlatformTxtUploadPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtUploadPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no tools"
+	if len(projectData.PlatformTxtToolNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no tools", nil
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("upload/pattern", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "upload/pattern", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantTools, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtProgramParamsVerboseMissing checks if any of the tools are missing program.params.verbose properties.
-func PlatformTxtProgramParamsVerboseMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtProgramParamsVerboseMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no tools"
+	if len(projectData.PlatformTxtToolNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no tools", nil
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("program/params/verbose", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "program/params/verbose", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantTools, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtProgramParamsQuietMissing checks if any of the tools are missing program.params.quiet properties.
-func PlatformTxtProgramParamsQuietMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtProgramParamsQuietMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no tools"
+	if len(projectData.PlatformTxtToolNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no tools", nil
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("program/params/quiet", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "program/params/quiet", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantTools, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtProgramPatternMissing checks if any of the tools are missing program.pattern properties.
-func PlatformTxtProgramPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtProgramPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if len(projectData.PlatformTxtToolNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no tools", nil
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no tools"
-	}
-
-	nonCompliantTools := toolNameMissingRequiredProperty("program/pattern", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "program/pattern", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantTools, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtEraseParamsVerboseMissing checks if any of the tools are missing erase.params.verbose properties.
-func PlatformTxtEraseParamsVerboseMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtEraseParamsVerboseMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no tools"
+	if len(projectData.PlatformTxtToolNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no tools", nil
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("erase/params/verbose", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "erase/params/verbose", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantTools, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtEraseParamsQuietMissing checks if any of the tools are missing erase.params.quiet properties.
-func PlatformTxtEraseParamsQuietMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtEraseParamsQuietMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if len(projectData.PlatformTxtToolNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no tools", nil
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no tools"
-	}
-
-	nonCompliantTools := toolNameMissingRequiredProperty("erase/params/quiet", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "erase/params/quiet", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantTools, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtErasePatternMissing checks if any of the tools are missing erase.pattern properties.
-func PlatformTxtErasePatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtErasePatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no tools"
+	if len(projectData.PlatformTxtToolNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no tools", nil
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("erase/pattern", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "erase/pattern", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantTools, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtBootloaderParamsVerboseMissing checks if any of the tools are missing bootloader.params.verbose properties.
-func PlatformTxtBootloaderParamsVerboseMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtBootloaderParamsVerboseMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if len(projectData.PlatformTxtToolNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no tools", nil
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no tools"
-	}
-
-	nonCompliantTools := toolNameMissingRequiredProperty("bootloader/params/verbose", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "bootloader/params/verbose", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantTools, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtBootloaderParamsQuietMissing checks if any of the tools are missing bootloader.params.quiet properties.
-func PlatformTxtBootloaderParamsQuietMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
-	}
-
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+func PlatformTxtBootloaderParamsQuietMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no tools"
+	if len(projectData.PlatformTxtToolNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no tools", nil
 	}
 
-	nonCompliantTools := toolNameMissingRequiredProperty("bootloader/params/quiet", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "bootloader/params/quiet", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantTools, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PlatformTxtBootloaderPatternMissing checks if any of the tools are missing bootloader.pattern properties.
-func PlatformTxtBootloaderPatternMissing() (result ruleresult.Type, output string) {
-	if !projectdata.PlatformTxtExists() {
-		return ruleresult.Skip, "Platform has no platform.txt"
+func PlatformTxtBootloaderPatternMissing(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	if !projectData.PlatformTxtExists() {
+		return ruleresult.Skip, "Platform has no platform.txt", nil
 	}
 
-	if projectdata.PlatformTxtLoadError() != nil {
-		return ruleresult.NotRun, "Couldn't load platform.txt"
+	if len(projectData.PlatformTxtToolNames()) == 0 {
+		return ruleresult.Skip, "platform.txt has no tools", nil
 	}
 
-	if len(projectdata.PlatformTxtToolNames()) == 0 {
-		return ruleresult.Skip, "platform.txt has no tools"
-	}
-
-	nonCompliantTools := toolNameMissingRequiredProperty("bootloader/pattern", compliancelevel.Specification)
+	nonCompliantTools := toolNameMissingRequiredProperty(projectData, "bootloader/pattern", compliancelevel.Specification)
 
 	if len(nonCompliantTools) > 0 {
-		return ruleresult.Fail, strings.Join(nonCompliantTools, ", ")
+		return ruleresult.Fail, strings.Join(nonCompliantTools, ", "), nil
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 /*
@@ -2096,13 +1669,13 @@ Unlike iDMissingRequiredProperty(), this function does a direct check on the pro
 This is necessary because JSON schema does not have the capability to account for the custom board options system.
 This function should not be used in cases where the JSON schema does cover a required property.
 */
-func boardIDMissingRequiredProperty(boardIDs []string, propertyName string, parentOK bool) []string {
+func boardIDMissingRequiredProperty(projectData *projectdata.Type, boardIDs []string, propertyName string, parentOK bool) []string {
 	containsKey := func(key string) bool {
 		if parentOK {
-			return containsKeyOrParent(projectdata.BoardsTxt(), key)
+			return containsKeyOrParent(projectData.BoardsTxt(), key)
 		}
 
-		return projectdata.BoardsTxt().ContainsKey(key)
+		return projectData.BoardsTxt().ContainsKey(key)
 	}
 
 	nonCompliantBoardIDs := []string{}
@@ -2117,7 +1690,7 @@ func boardIDMissingRequiredProperty(boardIDs []string, propertyName string, pare
 
 			// There is no first level definition of the property, so check if it is provided by the custom board options.
 			menuProvidesProperty := false
-			boardMenuProperties := projectdata.BoardsTxt().SubTree(boardID + ".menu")
+			boardMenuProperties := projectData.BoardsTxt().SubTree(boardID + ".menu")
 			boardMenuIDs := boardMenuProperties.FirstLevelKeys()
 			for _, boardMenuID := range boardMenuIDs {
 				logrus.Tracef("Menu ID: %s\n", boardMenuID)
@@ -2152,48 +1725,48 @@ func boardIDMissingRequiredProperty(boardIDs []string, propertyName string, pare
 }
 
 // boardIDValueLTMinLength returns the list of board IDs with value of the given property less than the minimum length.
-func boardIDValueLTMinLength(boardIDs []string, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	nonCompliantBoardIDs := iDValueLTMinLength(boardIDs, propertyNameQuery, projectdata.BoardsTxtSchemaValidationResult()[complianceLevel])
-	return append(nonCompliantBoardIDs, iDValueLTMinLength(boardIDs, "menu/[^.]+/[^.]+/"+propertyNameQuery, projectdata.BoardsTxtSchemaValidationResult()[complianceLevel])...)
+func boardIDValueLTMinLength(projectData *projectdata.Type, boardIDs []string, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantBoardIDs := iDValueLTMinLength(boardIDs, propertyNameQuery, projectData.BoardsTxtSchemaValidationResult()[complianceLevel])
+	return append(nonCompliantBoardIDs, iDValueLTMinLength(boardIDs, "menu/[^.]+/[^.]+/"+propertyNameQuery, projectData.BoardsTxtSchemaValidationResult()[complianceLevel])...)
 }
 
 // boardIDValueEnumMismatch returns the list of board IDs with value of the given property less than the minimum length.
-func boardIDValueEnumMismatch(boardIDs []string, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	nonCompliantBoardIDs := iDValueEnumMismatch(boardIDs, propertyNameQuery, projectdata.BoardsTxtSchemaValidationResult()[complianceLevel])
-	return append(nonCompliantBoardIDs, iDValueEnumMismatch(boardIDs, "menu/[^.]+/[^.]+/"+propertyNameQuery, projectdata.BoardsTxtSchemaValidationResult()[complianceLevel])...)
+func boardIDValueEnumMismatch(projectData *projectdata.Type, boardIDs []string, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantBoardIDs := iDValueEnumMismatch(boardIDs, propertyNameQuery, projectData.BoardsTxtSchemaValidationResult()[complianceLevel])
+	return append(nonCompliantBoardIDs, iDValueEnumMismatch(boardIDs, "menu/[^.]+/[^.]+/"+propertyNameQuery, projectData.BoardsTxtSchemaValidationResult()[complianceLevel])...)
 }
 
 // boardIDValuePatternMismatch returns the list of board IDs with value of the given property less than the minimum length.
-func boardIDValuePatternMismatch(boardIDs []string, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	nonCompliantBoardIDs := iDValuePatternMismatch(boardIDs, propertyNameQuery, projectdata.BoardsTxtSchemaValidationResult()[complianceLevel])
-	return append(nonCompliantBoardIDs, iDValuePatternMismatch(boardIDs, "menu/[^.]+/[^.]+/"+propertyNameQuery, projectdata.BoardsTxtSchemaValidationResult()[complianceLevel])...)
+func boardIDValuePatternMismatch(projectData *projectdata.Type, boardIDs []string, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	nonCompliantBoardIDs := iDValuePatternMismatch(boardIDs, propertyNameQuery, projectData.BoardsTxtSchemaValidationResult()[complianceLevel])
+	return append(nonCompliantBoardIDs, iDValuePatternMismatch(boardIDs, "menu/[^.]+/[^.]+/"+propertyNameQuery, projectData.BoardsTxtSchemaValidationResult()[complianceLevel])...)
 }
 
 // programmerIDMissingRequiredProperty returns the list of programmer IDs missing the given required property.
-func programmerIDMissingRequiredProperty(propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	return iDMissingRequiredProperty(projectdata.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectdata.ProgrammersTxtSchemaValidationResult()[complianceLevel])
+func programmerIDMissingRequiredProperty(projectData *projectdata.Type, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	return iDMissingRequiredProperty(projectData.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectData.ProgrammersTxtSchemaValidationResult()[complianceLevel])
 }
 
 // programmerIDValueLTMinLength returns the list of programmer IDs with value of the given property less than the minimum length.
-func programmerIDValueLTMinLength(propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	return iDValueLTMinLength(projectdata.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectdata.ProgrammersTxtSchemaValidationResult()[complianceLevel])
+func programmerIDValueLTMinLength(projectData *projectdata.Type, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	return iDValueLTMinLength(projectData.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectData.ProgrammersTxtSchemaValidationResult()[complianceLevel])
 }
 
 // programmerIDValueEnumMismatch returns the list of programmer IDs with value of the given property not matching the JSON schema enum.
-func programmerIDValueEnumMismatch(propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	return iDValueEnumMismatch(projectdata.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectdata.ProgrammersTxtSchemaValidationResult()[complianceLevel])
+func programmerIDValueEnumMismatch(projectData *projectdata.Type, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	return iDValueEnumMismatch(projectData.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectData.ProgrammersTxtSchemaValidationResult()[complianceLevel])
 }
 
 // programmerIDValueEnumMismatch returns the list of programmer IDs with value of the given property not matching the JSON schema pattern.
-func programmerIDValuePatternMismatch(propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
-	return iDValuePatternMismatch(projectdata.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectdata.ProgrammersTxtSchemaValidationResult()[complianceLevel])
+func programmerIDValuePatternMismatch(projectData *projectdata.Type, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+	return iDValuePatternMismatch(projectData.ProgrammersTxtProgrammerIds(), propertyNameQuery, projectData.ProgrammersTxtSchemaValidationResult()[complianceLevel])
 }
 
 // toolNameMissingRequiredProperty returns the list of tool names missing the given required property.
-func toolNameMissingRequiredProperty(propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
+func toolNameMissingRequiredProperty(projectData *projectdata.Type, propertyNameQuery string, complianceLevel compliancelevel.Type) []string {
 	nonCompliantTools := []string{}
-	for _, tool := range projectdata.PlatformTxtToolNames() {
-		if schema.RequiredPropertyMissing("tools/"+tool+"/"+propertyNameQuery, projectdata.PlatformTxtSchemaValidationResult()[complianceLevel]) {
+	for _, tool := range projectData.PlatformTxtToolNames() {
+		if schema.RequiredPropertyMissing("tools/"+tool+"/"+propertyNameQuery, projectData.PlatformTxtSchemaValidationResult()[complianceLevel]) {
 			nonCompliantTools = append(nonCompliantTools, tool)
 		}
 	}
@@ -2255,14 +1828,73 @@ func iDValuePatternMismatch(iDs []string, propertyNameQuery string, validationRe
 	return nonCompliantIDs
 }
 
+// platformTxtPropertyFindings returns the location of the given property in platform.txt.
+func platformTxtPropertyFindings(projectData *projectdata.Type, key string) []Finding {
+	return propertiesKeyFindings(projectData.ProjectPath().Join("platform.txt"), key)
+}
+
+// boardsTxtBoardIDFindings returns the locations in boards.txt of the properties matching the given query for each of the given boards.
+// The query is a regular expression, which is also matched against the custom board option properties.
+// The location of the board's first property is used when the board does not define a matching property.
+// The board ID is the subject of its findings.
+func boardsTxtBoardIDFindings(projectData *projectdata.Type, boardIDs []string, propertyNameQuery string) []Finding {
+	boardsTxtPath := projectData.ProjectPath().Join("boards.txt")
+	keyLines, err := general.PropertiesKeyLines(boardsTxtPath)
+	if err != nil {
+		panic(err)
+	}
+
+	findings := []Finding{}
+	for _, boardID := range boardIDs {
+		propertyRegexp := regexp.MustCompile("^" + regexp.QuoteMeta(boardID) + `\.(menu\.[^.]+\.[^.]+\.)?` + propertyNameQuery + "$")
+		firstLine := 0
+		lines := []int{}
+		for key, line := range keyLines {
+			if !strings.HasPrefix(key, boardID+".") {
+				continue
+			}
+			if firstLine == 0 || line < firstLine {
+				firstLine = line
+			}
+			if propertyRegexp.MatchString(key) {
+				lines = append(lines, line)
+			}
+		}
+		if len(lines) == 0 {
+			lines = append(lines, firstLine)
+		}
+		sort.Ints(lines)
+
+		for _, line := range lines {
+			findings = append(findings, Finding{Path: boardsTxtPath, Line: line, Subject: boardID})
+		}
+	}
+
+	return findings
+}
+
+// boardsTxtMenuIDFindings returns the locations in boards.txt of the titles of the given custom board option menus.
+// The menu ID is the subject of its findings.
+func boardsTxtMenuIDFindings(projectData *projectdata.Type, menuIDs []string) []Finding {
+	findings := []Finding{}
+	for _, menuID := range menuIDs {
+		for _, finding := range propertiesKeyFindings(projectData.ProjectPath().Join("boards.txt"), "menu."+menuID) {
+			finding.Subject = menuID
+			findings = append(findings, finding)
+		}
+	}
+
+	return findings
+}
+
 // platformReferencesCore checks whether all boards of the platform use core references.
 // See: https://arduino.github.io/arduino-cli/dev/platform-specification/#core-reference
-func platformReferencesCore() bool {
+func platformReferencesCore(projectData *projectdata.Type) bool {
 	referencesCore := false // Default return value.
-	for _, boardID := range projectdata.BoardsTxt().FirstLevelKeys() {
+	for _, boardID := range projectData.BoardsTxt().FirstLevelKeys() {
 		if boardID != "menu" {
 			// It is a board ID.
-			boardProperties := projectdata.BoardsTxt().SubTree(boardID)
+			boardProperties := projectData.BoardsTxt().SubTree(boardID)
 			for _, key := range boardProperties.Keys() {
 				if key == "build.core" || strings.HasSuffix(key, ".build.core") {
 					if !strings.Contains(boardProperties.ExpandPropsInString(boardProperties.Get(key)), ":") {
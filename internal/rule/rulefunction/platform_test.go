@@ -58,9 +58,9 @@ func checkPlatformRuleFunction(ruleFunction Type, testTables []platformRuleFunct
 			SuperprojectType: projecttype.Platform,
 		}
 
-		projectdata.Initialize(testProject)
+		projectData := projectdata.Initialize(testProject, testToolConfiguration, &testLibraryManagerIndex)
 
-		result, output := ruleFunction()
+		result, output, _ := ruleFunction(projectData)
 		assert.Equal(t, testTable.expectedRuleResult, result, testTable.testName)
 		assert.True(t, expectedOutputRegexp.MatchString(output), fmt.Sprintf("%s (output: %s, assertion regex: %s)", testTable.testName, output, testTable.expectedOutputQuery))
 	}
@@ -87,8 +87,6 @@ func TestBoardsTxtFormat(t *testing.T) {
 
 func TestBoardsTxtBoardIDNameMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property missing", "boardID-name-missing-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -97,10 +95,45 @@ func TestBoardsTxtBoardIDNameMissing(t *testing.T) {
 	checkPlatformRuleFunction(BoardsTxtBoardIDNameMissing, testTables, t)
 }
 
+func TestBoardsTxtBoardIDFindings(t *testing.T) {
+	platformPath := platformTestDataPath.Join("boardID-name-missing-boards.txt")
+	projectData := projectdata.Initialize(
+		project.Type{
+			Path:             platformPath,
+			ProjectType:      projecttype.Platform,
+			SuperprojectType: projecttype.Platform,
+		},
+		testToolConfiguration,
+		&testLibraryManagerIndex,
+	)
+
+	_, _, findings := BoardsTxtBoardIDNameMissing(projectData)
+	boardsTxtPath := platformPath.Join("boards.txt")
+	assert.Equal(
+		t,
+		[]Finding{{Path: boardsTxtPath, Line: 1, Subject: "buno"}, {Path: boardsTxtPath, Line: 16, Subject: "funo"}, {Path: boardsTxtPath, Line: 29, Subject: "zuno"}},
+		findings,
+		"First line of the board is located for missing property, custom board option property is located",
+	)
+
+	platformPath = platformTestDataPath.Join("boardID-name-LT-boards.txt")
+	projectData = projectdata.Initialize(
+		project.Type{
+			Path:             platformPath,
+			ProjectType:      projecttype.Platform,
+			SuperprojectType: projecttype.Platform,
+		},
+		testToolConfiguration,
+		&testLibraryManagerIndex,
+	)
+
+	findings = boardsTxtBoardIDFindings(projectData, []string{"buno", "funo"}, "name")
+	boardsTxtPath = platformPath.Join("boards.txt")
+	assert.Equal(t, []Finding{{Path: boardsTxtPath, Line: 1, Subject: "buno"}, {Path: boardsTxtPath, Line: 17, Subject: "funo"}}, findings, "Location of the property is found")
+}
+
 func TestBoardsTxtBoardIDNameLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property LT min", "boardID-name-LT-boards.txt", ruleresult.Fail, "buno, funo"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -111,8 +144,6 @@ func TestBoardsTxtBoardIDNameLTMinLength(t *testing.T) {
 
 func TestBoardsTxtBoardIDBuildBoardMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property missing", "boardID-build-board-missing-boards.txt", ruleresult.Fail, "buno, funo"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -123,8 +154,6 @@ func TestBoardsTxtBoardIDBuildBoardMissing(t *testing.T) {
 
 func TestBoardsTxtBoardIDBuildBoardLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property LT min", "boardID-build-board-LT-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -135,8 +164,6 @@ func TestBoardsTxtBoardIDBuildBoardLTMinLength(t *testing.T) {
 
 func TestBoardsTxtBoardIDBuildCoreMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property missing", "boardID-build-core-missing-boards.txt", ruleresult.Fail, "buno, funo"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -147,8 +174,6 @@ func TestBoardsTxtBoardIDBuildCoreMissing(t *testing.T) {
 
 func TestBoardsTxtBoardIDBuildCoreLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property LT min", "boardID-build-core-LT-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -159,8 +184,6 @@ func TestBoardsTxtBoardIDBuildCoreLTMinLength(t *testing.T) {
 
 func TestBoardsTxtUserExtraFlagsUsage(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Use of user extra flags", "boardID-compiler-x-extra_flags-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -171,8 +194,6 @@ func TestBoardsTxtUserExtraFlagsUsage(t *testing.T) {
 
 func TestBoardsTxtBoardIDHideInvalid(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property invalid", "boardID-hide-invalid-boards.txt", ruleresult.Fail, "buno, funo"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -183,8 +204,6 @@ func TestBoardsTxtBoardIDHideInvalid(t *testing.T) {
 
 func TestBoardsTxtMenuMenuIDLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No menus", "no-menus-boards.txt", ruleresult.Skip, ""},
 		{"Menu title too short", "menu-menuID-LT-boards.txt", ruleresult.Fail, "foo, baz"},
 		{"Menu title valid", "menu-menuID-valid-boards.txt", ruleresult.Pass, ""},
@@ -195,8 +214,6 @@ func TestBoardsTxtMenuMenuIDLTMinLength(t *testing.T) {
 
 func TestBoardsTxtBoardIDSerialDisableDTRInvalid(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property invalid", "boardID-serial-disableDTR-invalid-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -207,8 +224,6 @@ func TestBoardsTxtBoardIDSerialDisableDTRInvalid(t *testing.T) {
 
 func TestBoardsTxtBoardIDSerialDisableRTSInvalid(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property invalid", "boardID-serial-disableRTS-invalid-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -219,8 +234,6 @@ func TestBoardsTxtBoardIDSerialDisableRTSInvalid(t *testing.T) {
 
 func TestBoardsTxtBoardIDUploadToolMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property missing", "boardID-upload-tool-missing-boards.txt", ruleresult.Fail, "buno, funo"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -231,8 +244,6 @@ func TestBoardsTxtBoardIDUploadToolMissing(t *testing.T) {
 
 func TestBoardsTxtBoardIDUploadToolLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property LT min", "boardID-upload-tool-LT-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -243,8 +254,6 @@ func TestBoardsTxtBoardIDUploadToolLTMinLength(t *testing.T) {
 
 func TestBoardsTxtBoardIDUploadMaximumSizeMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property missing", "boardID-upload-maximum_size-missing-boards.txt", ruleresult.Fail, "buno, funo"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -255,8 +264,6 @@ func TestBoardsTxtBoardIDUploadMaximumSizeMissing(t *testing.T) {
 
 func TestBoardsTxtBoardIDUploadMaximumSizeInvalid(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property invalid", "boardID-upload-maximum_size-invalid-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -267,8 +274,6 @@ func TestBoardsTxtBoardIDUploadMaximumSizeInvalid(t *testing.T) {
 
 func TestBoardsTxtBoardIDUploadMaximumDataSizeMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property missing", "boardID-upload-maximum_data_size-missing-boards.txt", ruleresult.Fail, "buno, funo"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -279,8 +284,6 @@ func TestBoardsTxtBoardIDUploadMaximumDataSizeMissing(t *testing.T) {
 
 func TestBoardsTxtBoardIDUploadMaximumDataSizeInvalid(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property invalid", "boardID-upload-maximum_data_size-invalid-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -291,8 +294,6 @@ func TestBoardsTxtBoardIDUploadMaximumDataSizeInvalid(t *testing.T) {
 
 func TestBoardsTxtBoardIDUploadUse1200bpsTouchInvalid(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property invalid", "boardID-upload-use_1200bps_touch-invalid-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -303,8 +304,6 @@ func TestBoardsTxtBoardIDUploadUse1200bpsTouchInvalid(t *testing.T) {
 
 func TestBoardsTxtBoardIDUploadWaitForUploadPortInvalid(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property invalid", "boardID-upload-wait_for_upload_port-invalid-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -315,8 +314,6 @@ func TestBoardsTxtBoardIDUploadWaitForUploadPortInvalid(t *testing.T) {
 
 func TestBoardsTxtBoardIDVidNInvalid(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property invalid", "boardID-vid-n-invalid-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -327,8 +324,6 @@ func TestBoardsTxtBoardIDVidNInvalid(t *testing.T) {
 
 func TestBoardsTxtBoardIDPidNInvalid(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
-		{"Missing", "missing-boards.txt", ruleresult.NotRun, ""},
-		{"Invalid", "invalid-boards.txt", ruleresult.NotRun, ""},
 		{"No boards", "no-boards-boards.txt", ruleresult.Skip, ""},
 		{"Property invalid", "boardID-pid-n-invalid-boards.txt", ruleresult.Fail, "buno, funo, zuno"},
 		{"Valid", "valid-boards.txt", ruleresult.Pass, ""},
@@ -340,7 +335,6 @@ func TestBoardsTxtBoardIDPidNInvalid(t *testing.T) {
 func TestProgrammersTxtProgrammerIDNameMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-programmers.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-programmers.txt", ruleresult.NotRun, ""},
 		{"No programmers", "no-programmers-programmers.txt", ruleresult.Skip, ""},
 		{"Property missing", "programmerID-name-missing-programmers.txt", ruleresult.Fail, "foo, bar"},
 		{"Valid", "valid-programmers.txt", ruleresult.Pass, ""},
@@ -352,7 +346,6 @@ func TestProgrammersTxtProgrammerIDNameMissing(t *testing.T) {
 func TestProgrammersTxtProgrammerIDNameLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-programmers.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-programmers.txt", ruleresult.NotRun, ""},
 		{"No programmers", "no-programmers-programmers.txt", ruleresult.Skip, ""},
 		{"Property LT min", "programmerID-name-LT-programmers.txt", ruleresult.Fail, "foo, bar"},
 		{"Valid", "valid-programmers.txt", ruleresult.Pass, ""},
@@ -364,7 +357,6 @@ func TestProgrammersTxtProgrammerIDNameLTMinLength(t *testing.T) {
 func TestProgrammersTxtProgrammerIDProgramToolMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-programmers.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-programmers.txt", ruleresult.NotRun, ""},
 		{"No programmers", "no-programmers-programmers.txt", ruleresult.Skip, ""},
 		{"Property missing", "programmerID-program-tool-missing-programmers.txt", ruleresult.Fail, "foo, bar"},
 		{"Valid", "valid-programmers.txt", ruleresult.Pass, ""},
@@ -376,7 +368,6 @@ func TestProgrammersTxtProgrammerIDProgramToolMissing(t *testing.T) {
 func TestProgrammersTxtProgrammerIDProgramToolLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-programmers.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-programmers.txt", ruleresult.NotRun, ""},
 		{"No programmers", "no-programmers-programmers.txt", ruleresult.Skip, ""},
 		{"Property LT min", "programmerID-program-tool-LT-programmers.txt", ruleresult.Fail, "foo, bar"},
 		{"Valid", "valid-programmers.txt", ruleresult.Pass, ""},
@@ -398,7 +389,6 @@ func TestPlatformTxtFormat(t *testing.T) {
 func TestPlatformTxtNameMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "name-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
 	}
@@ -409,7 +399,6 @@ func TestPlatformTxtNameMissing(t *testing.T) {
 func TestPlatformTxtNameLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"Property LT min", "name-LT-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -421,7 +410,6 @@ func TestPlatformTxtNameLTMinLength(t *testing.T) {
 func TestPlatformTxtVersionMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "version-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
 	}
@@ -432,7 +420,6 @@ func TestPlatformTxtVersionMissing(t *testing.T) {
 func TestPlatformTxtVersionNonRelaxedSemver(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"Property invalid", "version-invalid-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -444,7 +431,6 @@ func TestPlatformTxtVersionNonRelaxedSemver(t *testing.T) {
 func TestPlatformTxtVersionNonSemver(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"Property invalid", "version-non-semver-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -453,11 +439,28 @@ func TestPlatformTxtVersionNonSemver(t *testing.T) {
 	checkPlatformRuleFunction(PlatformTxtVersionNonSemver, testTables, t)
 }
 
+func TestPlatformTxtPropertyFindings(t *testing.T) {
+	platformPath := platformTestDataPath.Join("version-non-semver-platform.txt")
+	projectData := projectdata.Initialize(
+		project.Type{
+			Path:             platformPath,
+			ProjectType:      projecttype.Platform,
+			SuperprojectType: projecttype.Platform,
+		},
+		testToolConfiguration,
+		&testLibraryManagerIndex,
+	)
+
+	_, _, findings := PlatformTxtVersionNonSemver(projectData)
+	assert.Equal(t, []Finding{{Path: platformPath.Join("platform.txt"), Line: 2}}, findings, "Location of the property is found")
+
+	findings = platformTxtPropertyFindings(projectData, "foo")
+	assert.Equal(t, []Finding{{Path: platformPath.Join("platform.txt")}}, findings, "Only file is located for missing property")
+}
+
 func TestPlatformTxtCompilerWarningFlagsNoneMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "compiler-warning_flags-none-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -469,8 +472,6 @@ func TestPlatformTxtCompilerWarningFlagsNoneMissing(t *testing.T) {
 func TestPlatformTxtCompilerWarningFlagsDefaultMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "compiler-warning_flags-default-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -482,8 +483,6 @@ func TestPlatformTxtCompilerWarningFlagsDefaultMissing(t *testing.T) {
 func TestPlatformTxtCompilerWarningFlagsMoreMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "compiler-warning_flags-more-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -495,8 +494,6 @@ func TestPlatformTxtCompilerWarningFlagsMoreMissing(t *testing.T) {
 func TestPlatformTxtCompilerWarningFlagsAllMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "compiler-warning_flags-all-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -508,8 +505,6 @@ func TestPlatformTxtCompilerWarningFlagsAllMissing(t *testing.T) {
 func TestPlatformTxtCompilerOptimizationFlagsDebugMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Dependent property not present", "properties-missing-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "compiler-optimization_flags-debug-missing-platform.txt", ruleresult.Fail, ""},
@@ -522,8 +517,6 @@ func TestPlatformTxtCompilerOptimizationFlagsDebugMissing(t *testing.T) {
 func TestPlatformTxtCompilerOptimizationFlagsReleaseMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Dependent property not present", "properties-missing-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "compiler-optimization_flags-release-missing-platform.txt", ruleresult.Fail, ""},
@@ -536,8 +529,6 @@ func TestPlatformTxtCompilerOptimizationFlagsReleaseMissing(t *testing.T) {
 func TestPlatformTxtCompilerCExtraFlagsMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "compiler-c-extra_flags-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -549,7 +540,6 @@ func TestPlatformTxtCompilerCExtraFlagsMissing(t *testing.T) {
 func TestPlatformTxtCompilerCExtraFlagsNotEmpty(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.Skip, ""},
 		{"Not empty", "compiler-c-extra_flags-not-empty-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -561,8 +551,6 @@ func TestPlatformTxtCompilerCExtraFlagsNotEmpty(t *testing.T) {
 func TestPlatformTxtCompilerCppExtraFlagsMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "compiler-cpp-extra_flags-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -574,7 +562,6 @@ func TestPlatformTxtCompilerCppExtraFlagsMissing(t *testing.T) {
 func TestPlatformTxtCompilerCppExtraFlagsNotEmpty(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.Skip, ""},
 		{"Not empty", "compiler-cpp-extra_flags-not-empty-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -586,8 +573,6 @@ func TestPlatformTxtCompilerCppExtraFlagsNotEmpty(t *testing.T) {
 func TestPlatformTxtCompilerSExtraFlagsMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "compiler-S-extra_flags-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -599,7 +584,6 @@ func TestPlatformTxtCompilerSExtraFlagsMissing(t *testing.T) {
 func TestPlatformTxtCompilerSExtraFlagsNotEmpty(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.Skip, ""},
 		{"Not empty", "compiler-S-extra_flags-not-empty-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -611,8 +595,6 @@ func TestPlatformTxtCompilerSExtraFlagsNotEmpty(t *testing.T) {
 func TestPlatformTxtCompilerArExtraFlagsMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "compiler-ar-extra_flags-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -624,7 +606,6 @@ func TestPlatformTxtCompilerArExtraFlagsMissing(t *testing.T) {
 func TestPlatformTxtCompilerArExtraFlagsNotEmpty(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.Skip, ""},
 		{"Not empty", "compiler-ar-extra_flags-not-empty-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -636,8 +617,6 @@ func TestPlatformTxtCompilerArExtraFlagsNotEmpty(t *testing.T) {
 func TestPlatformTxtCompilerCElfExtraFlagsMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "compiler-c-elf-extra_flags-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -649,7 +628,6 @@ func TestPlatformTxtCompilerCElfExtraFlagsMissing(t *testing.T) {
 func TestPlatformTxtCompilerCElfExtraFlagsNotEmpty(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.Skip, ""},
 		{"Not empty", "compiler-c-elf-extra_flags-not-empty-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -661,7 +639,6 @@ func TestPlatformTxtCompilerCElfExtraFlagsNotEmpty(t *testing.T) {
 func TestPlatformTxtRecipePreprocMacrosLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.Skip, ""},
 		{"Property LT min", "recipe-preproc-macros-LT-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -673,7 +650,6 @@ func TestPlatformTxtRecipePreprocMacrosLTMinLength(t *testing.T) {
 func TestPlatformTxtRecipePreprocMacrosExtraFlagsSupport(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"No extra flags support", "recipe-preproc-macros-no-extra-flags-support-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -685,8 +661,6 @@ func TestPlatformTxtRecipePreprocMacrosExtraFlagsSupport(t *testing.T) {
 func TestPlatformTxtRecipeCOPatternMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "recipe-c-o-pattern-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -698,7 +672,6 @@ func TestPlatformTxtRecipeCOPatternMissing(t *testing.T) {
 func TestPlatformTxtRecipeCOPatternLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"Property LT min", "recipe-c-o-pattern-LT-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -710,7 +683,6 @@ func TestPlatformTxtRecipeCOPatternLTMinLength(t *testing.T) {
 func TestPlatformTxtRecipeCOPatternExtraFlagsSupport(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"No extra flags support", "recipe-c-o-pattern-no-extra-flags-support-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -722,8 +694,6 @@ func TestPlatformTxtRecipeCOPatternExtraFlagsSupport(t *testing.T) {
 func TestPlatformTxtRecipeCppOPatternMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "recipe-cpp-o-pattern-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -735,7 +705,6 @@ func TestPlatformTxtRecipeCppOPatternMissing(t *testing.T) {
 func TestPlatformTxtRecipeCppOPatternLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"Property LT min", "recipe-cpp-o-pattern-LT-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -747,7 +716,6 @@ func TestPlatformTxtRecipeCppOPatternLTMinLength(t *testing.T) {
 func TestPlatformTxtRecipeCppOPatternExtraFlagsSupport(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"No extra flags support", "recipe-cpp-o-pattern-no-extra-flags-support-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -759,8 +727,6 @@ func TestPlatformTxtRecipeCppOPatternExtraFlagsSupport(t *testing.T) {
 func TestPlatformTxtRecipeSOPatternMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "recipe-S-o-pattern-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -772,7 +738,6 @@ func TestPlatformTxtRecipeSOPatternMissing(t *testing.T) {
 func TestPlatformTxtRecipeSOPatternLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"Property LT min", "recipe-S-o-pattern-LT-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -784,7 +749,6 @@ func TestPlatformTxtRecipeSOPatternLTMinLength(t *testing.T) {
 func TestPlatformTxtRecipeSOPatternExtraFlagsSupport(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"No extra flags support", "recipe-S-o-pattern-no-extra-flags-support-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -796,8 +760,6 @@ func TestPlatformTxtRecipeSOPatternExtraFlagsSupport(t *testing.T) {
 func TestPlatformTxtRecipeArPatternMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "recipe-ar-pattern-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -809,7 +771,6 @@ func TestPlatformTxtRecipeArPatternMissing(t *testing.T) {
 func TestPlatformTxtRecipeArPatternLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"Property LT min", "recipe-ar-pattern-LT-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -821,7 +782,6 @@ func TestPlatformTxtRecipeArPatternLTMinLength(t *testing.T) {
 func TestPlatformTxtRecipeArPatternExtraFlagsSupport(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"No extra flags support", "recipe-ar-pattern-no-extra-flags-support-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -833,8 +793,6 @@ func TestPlatformTxtRecipeArPatternExtraFlagsSupport(t *testing.T) {
 func TestPlatformTxtRecipeCCombinePatternMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "recipe-c-combine-pattern-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -846,7 +804,6 @@ func TestPlatformTxtRecipeCCombinePatternMissing(t *testing.T) {
 func TestPlatformTxtRecipeCCombinePatternLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"Property LT min", "recipe-c-combine-pattern-LT-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -858,7 +815,6 @@ func TestPlatformTxtRecipeCCombinePatternLTMinLength(t *testing.T) {
 func TestPlatformTxtRecipeCCombinePatternExtraFlagsSupport(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"No extra flags support", "recipe-c-combine-pattern-no-extra-flags-support-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -870,8 +826,6 @@ func TestPlatformTxtRecipeCCombinePatternExtraFlagsSupport(t *testing.T) {
 func TestPlatformTxtRecipeOutputTmpFileMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "recipe-output-tmp_file-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -883,7 +837,6 @@ func TestPlatformTxtRecipeOutputTmpFileMissing(t *testing.T) {
 func TestPlatformTxtRecipeOutputTmpFileLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"Property LT min", "recipe-output-tmp_file-LT-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -895,8 +848,6 @@ func TestPlatformTxtRecipeOutputTmpFileLTMinLength(t *testing.T) {
 func TestPlatformTxtRecipeOutputSaveFileMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "recipe-output-save_file-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -908,7 +859,6 @@ func TestPlatformTxtRecipeOutputSaveFileMissing(t *testing.T) {
 func TestPlatformTxtRecipeOutputSaveFileLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.NotRun, ""},
 		{"Property LT min", "recipe-output-save_file-LT-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -920,8 +870,6 @@ func TestPlatformTxtRecipeOutputSaveFileLTMinLength(t *testing.T) {
 func TestPlatformTxtRecipeSizePatternMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "recipe-size-pattern-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -933,7 +881,6 @@ func TestPlatformTxtRecipeSizePatternMissing(t *testing.T) {
 func TestPlatformTxtRecipeSizePatternLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property missing", "properties-missing-platform.txt", ruleresult.Skip, ""},
 		{"Property LT min", "recipe-size-pattern-LT-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -945,8 +892,6 @@ func TestPlatformTxtRecipeSizePatternLTMinLength(t *testing.T) {
 func TestPlatformTxtRecipeSizeRegexMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "recipe-size-regex-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -958,8 +903,6 @@ func TestPlatformTxtRecipeSizeRegexMissing(t *testing.T) {
 func TestPlatformTxtRecipeSizeRegexDataMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
-		{"Missing boards.txt", "missing-boards.txt", ruleresult.NotRun, "boards.txt"},
 		{"Core reference", "core-reference", ruleresult.Skip, "[cC]ore reference"},
 		{"Property missing", "recipe-size-regex-data-missing-platform.txt", ruleresult.Fail, ""},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -971,7 +914,6 @@ func TestPlatformTxtRecipeSizeRegexDataMissing(t *testing.T) {
 func TestPlatformTxtPluggableDiscoveryRequiredInvalid(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property absent", "no-pluggable-discoveries-platform.txt", ruleresult.Skip, ""},
 		{"Property invalid (root prop)", "invalid-pluggable-discovery-required-platform.txt", ruleresult.Fail, "^$"},
 		{"Property invalid (list prop)", "invalid-pluggable-discovery-required-n-platform.txt", ruleresult.Fail, "^$"},
@@ -984,7 +926,6 @@ func TestPlatformTxtPluggableDiscoveryRequiredInvalid(t *testing.T) {
 func TestPlatformTxtPluggableDiscoveryDiscoveryIDPatternMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No pluggable discoveries", "no-pluggable-discoveries-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "pluggable-discovery-discovery-id-pattern-missing-platform.txt", ruleresult.Fail, "^foo_discovery, baz_discovery$"},
 		{"Valid", "valid-with-manual-installation-pluggable-discoveries-platform.txt", ruleresult.Pass, ""},
@@ -996,7 +937,6 @@ func TestPlatformTxtPluggableDiscoveryDiscoveryIDPatternMissing(t *testing.T) {
 func TestPlatformTxtPluggableMonitorPatternProtocolIDLTMinLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property absent", "no-pluggable-monitors-platform.txt", ruleresult.Skip, ""},
 		{"Property LT min", "pluggable-monitor-pattern-protocol-id-LT-platform.txt", ruleresult.Fail, "^network, ble$"},
 		{"Valid", "valid-with-manual-installation-pluggable-monitors-platform.txt", ruleresult.Pass, ""},
@@ -1008,7 +948,6 @@ func TestPlatformTxtPluggableMonitorPatternProtocolIDLTMinLength(t *testing.T) {
 func TestPlatformTxtPluggableMonitorRequiredProtocolIDInvalid(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"Property absent", "no-pluggable-monitors-platform.txt", ruleresult.Skip, ""},
 		{"Property invalid", "invalid-pluggable-monitor-required-platform.txt", ruleresult.Fail, "^network, ble$"},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -1020,7 +959,6 @@ func TestPlatformTxtPluggableMonitorRequiredProtocolIDInvalid(t *testing.T) {
 func TestPlatformTxtUploadFieldFieldNameGTMaxLength(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No field", "no-user-defined-field-platform.txt", ruleresult.Skip, ""},
 		{"Property GT max", "user-defined-field-GT-platform.txt", ruleresult.Fail, "^avrdude >> foo_field_name, bossac >> bar_field_name$"},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -1032,7 +970,6 @@ func TestPlatformTxtUploadFieldFieldNameGTMaxLength(t *testing.T) {
 func TestPlatformTxtUploadFieldFieldNameSecretInvalid(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No field", "no-user-defined-field-platform.txt", ruleresult.Skip, ""},
 		{"No field secret", "no-user-defined-field-secret-platform.txt", ruleresult.Skip, ""},
 		{"Property invalid", "user-defined-field-secret-invalid-platform.txt", ruleresult.Fail, "^avrdude >> foo_field_name, footool >> qux_field_name$"},
@@ -1045,7 +982,6 @@ func TestPlatformTxtUploadFieldFieldNameSecretInvalid(t *testing.T) {
 func TestPlatformTxtUploadPatternMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No tools", "no-tools-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "upload-pattern-missing-platform.txt", ruleresult.Fail, "avrdude, bossac"},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -1057,7 +993,6 @@ func TestPlatformTxtUploadPatternMissing(t *testing.T) {
 func TestPlatformTxtProgramParamsVerboseMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No tools", "no-tools-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "program-params-verbose-missing-platform.txt", ruleresult.Fail, "avrdude, bossac"},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -1069,7 +1004,6 @@ func TestPlatformTxtProgramParamsVerboseMissing(t *testing.T) {
 func TestPlatformTxtProgramParamsQuietMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No tools", "no-tools-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "program-params-quiet-missing-platform.txt", ruleresult.Fail, "avrdude, bossac"},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -1081,7 +1015,6 @@ func TestPlatformTxtProgramParamsQuietMissing(t *testing.T) {
 func TestPlatformTxtProgramPatternMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No tools", "no-tools-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "program-pattern-missing-platform.txt", ruleresult.Fail, "avrdude, bossac"},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -1093,7 +1026,6 @@ func TestPlatformTxtProgramPatternMissing(t *testing.T) {
 func TestPlatformTxtEraseParamsVerboseMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No tools", "no-tools-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "erase-params-verbose-missing-platform.txt", ruleresult.Fail, "avrdude, bossac"},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -1105,7 +1037,6 @@ func TestPlatformTxtEraseParamsVerboseMissing(t *testing.T) {
 func TestPlatformTxtEraseParamsQuietMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No tools", "no-tools-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "erase-params-quiet-missing-platform.txt", ruleresult.Fail, "avrdude, bossac"},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -1117,7 +1048,6 @@ func TestPlatformTxtEraseParamsQuietMissing(t *testing.T) {
 func TestPlatformTxtErasePatternMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No tools", "no-tools-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "erase-pattern-missing-platform.txt", ruleresult.Fail, "avrdude, bossac"},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -1129,7 +1059,6 @@ func TestPlatformTxtErasePatternMissing(t *testing.T) {
 func TestPlatformTxtBootloaderParamsVerboseMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No tools", "no-tools-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "bootloader-params-verbose-missing-platform.txt", ruleresult.Fail, "avrdude, bossac"},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -1141,7 +1070,6 @@ func TestPlatformTxtBootloaderParamsVerboseMissing(t *testing.T) {
 func TestPlatformTxtBootloaderParamsQuietMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No tools", "no-tools-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "bootloader-params-quiet-missing-platform.txt", ruleresult.Fail, "avrdude, bossac"},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -1153,7 +1081,6 @@ func TestPlatformTxtBootloaderParamsQuietMissing(t *testing.T) {
 func TestPlatformTxtBootloaderPatternMissing(t *testing.T) {
 	testTables := []platformRuleFunctionTestTable{
 		{"Missing", "missing-platform.txt", ruleresult.Skip, ""},
-		{"Invalid", "invalid-platform.txt", ruleresult.NotRun, ""},
 		{"No tools", "no-tools-platform.txt", ruleresult.Skip, ""},
 		{"Property missing", "bootloader-pattern-missing-platform.txt", ruleresult.Fail, "avrdude, bossac"},
 		{"Valid", "valid-platform.txt", ruleresult.Pass, ""},
@@ -18,12 +18,12 @@
 package rulefunction
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
 
+	"github.com/arduino/arduino-lint/internal/project/general"
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/project/sketch"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
@@ -32,26 +32,37 @@ import (
 )
 
 // Type is the function signature for the rule functions.
+// The `projectData` argument is the data of the project the rule is run on.
 // The `output` result is the contextual information that will be inserted into the rule's message template.
-type Type func() (result ruleresult.Type, output string)
+// The `findings` result is the location of each rule violation, when the rule is able to determine it.
+// When the findings have subjects, each subject is reported as a separate rule violation, with the subject as the output.
+type Type func(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding)
+
+// Finding is the location of a rule violation.
+type Finding struct {
+	Path    *paths.Path // Path of the file or folder where the violation was found.
+	Line    int         // Line number where the violation was found, starting from 1. 0 when not applicable.
+	Column  int         // Column number where the violation was found, starting from 1. 0 when not applicable.
+	Subject string      // The item that violates the rule (e.g., board ID or file path), when the rule checks multiple items.
+}
 
 // MissingReadme checks if the project has a readme that will be recognized by GitHub.
-func MissingReadme() (result ruleresult.Type, output string) {
+func MissingReadme(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	// https://github.com/github/markup/blob/master/README.md
 	readmeRegexp := regexp.MustCompile(`(?i)^readme\.((markdown)|(mdown)|(mkdn)|(md)|(textile)|(rdoc)|(org)|(creole)|(mediawiki)|(wiki)|(rst)|(asciidoc)|(adoc)|(asc)|(pod)|(txt))$`)
 
 	// https://docs.github.com/en/free-pro-team@latest/github/creating-cloning-and-archiving-repositories/about-readmes#about-readmes
-	if pathContainsRegexpMatch(projectdata.ProjectPath(), readmeRegexp) ||
-		(projectdata.ProjectPath().Join("docs").Exist() && pathContainsRegexpMatch(projectdata.ProjectPath().Join("docs"), readmeRegexp)) ||
-		(projectdata.ProjectPath().Join(".github").Exist() && pathContainsRegexpMatch(projectdata.ProjectPath().Join(".github"), readmeRegexp)) {
-		return ruleresult.Pass, ""
+	if pathContainsRegexpMatch(projectData.ProjectPath(), readmeRegexp) ||
+		(projectData.ProjectPath().Join("docs").Exist() && pathContainsRegexpMatch(projectData.ProjectPath().Join("docs"), readmeRegexp)) ||
+		(projectData.ProjectPath().Join(".github").Exist() && pathContainsRegexpMatch(projectData.ProjectPath().Join(".github"), readmeRegexp)) {
+		return ruleresult.Pass, "", nil
 	}
 
-	return ruleresult.Fail, ""
+	return ruleresult.Fail, "", nil
 }
 
 // MissingLicenseFile checks if the project has a license file that will be recognized by GitHub.
-func MissingLicenseFile() (result ruleresult.Type, output string) {
+func MissingLicenseFile(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	// https://docs.github.com/en/free-pro-team@latest/github/creating-cloning-and-archiving-repositories/licensing-a-repository#detecting-a-license
 	// https://github.com/licensee/licensee/blob/master/docs/what-we-look-at.md#detecting-the-license-file
 	// Should be `(?i)^(((un)?licen[sc]e)|(copy(ing|right))|(ofl)|(patents))(\.(?!spdx|header|gemspec).+)?$` but regexp package doesn't support negative lookahead, so only using "preferred extensions".
@@ -59,18 +70,18 @@ func MissingLicenseFile() (result ruleresult.Type, output string) {
 	licenseRegexp := regexp.MustCompile(`(?i)^(((un)?licen[sc]e)|(copy(ing|right))|(ofl)|(patents))(\.((md)|(markdown)|(txt)|(html)))?$`)
 
 	// License file must be in root of repo
-	if pathContainsRegexpMatch(projectdata.ProjectPath(), licenseRegexp) {
-		return ruleresult.Pass, ""
+	if pathContainsRegexpMatch(projectData.ProjectPath(), licenseRegexp) {
+		return ruleresult.Pass, "", nil
 	}
 
-	return ruleresult.Fail, ""
+	return ruleresult.Fail, "", nil
 }
 
 // IncorrectArduinoDotHFileNameCase checks for incorrect file name case of Arduino.h in #include directives.
-func IncorrectArduinoDotHFileNameCase() (result ruleresult.Type, output string) {
+func IncorrectArduinoDotHFileNameCase(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
 	incorrectCaseRegexp := regexp.MustCompile(`^\s*#\s*include\s*["<](a((?i)rduino)|(ARDUINO))\.[hH][">]`)
 
-	directoryListing, err := projectdata.ProjectPath().ReadDirRecursive()
+	directoryListing, err := projectData.ProjectPath().ReadDirRecursive()
 	if err != nil {
 		panic(err)
 	}
@@ -88,12 +99,17 @@ func IncorrectArduinoDotHFileNameCase() (result ruleresult.Type, output string)
 
 		for lineNumber, line := range lines {
 			if incorrectCaseRegexp.MatchString(line) {
-				return ruleresult.Fail, fmt.Sprintf("%s:%v: %s", file, lineNumber+1, line)
+				finding := Finding{
+					Path:   file,
+					Line:   lineNumber + 1,
+					Column: len(line) - len(strings.TrimLeft(line, " \t")) + 1,
+				}
+				return ruleresult.Fail, fmt.Sprintf("%s:%v: %s", file, lineNumber+1, line), []Finding{finding}
 			}
 		}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 const brokenOutputListIndent = "  " // Use this as indent for rule output that takes the form of newline-separated list.
@@ -103,6 +119,18 @@ func brokenOutputList(list []string) string {
 	return brokenOutputListIndent + strings.Join(list, "\n"+brokenOutputListIndent)
 }
 
+// SubjectOutput returns the output for the violation of the given subject, from the output of a rule whose violations
+// have the given subjects.
+// When the rule output is a newline-separated list of the subjects, the rule's message template is written for a list, so
+// the subject is formatted the same way.
+func SubjectOutput(ruleOutput string, subjects []string, subject string) string {
+	if ruleOutput == brokenOutputList(subjects) {
+		return brokenOutputList([]string{subject})
+	}
+
+	return subject
+}
+
 // validProjectPathBaseName checks whether the provided library folder or sketch filename contains prohibited characters.
 func validProjectPathBaseName(name string) bool {
 	baseNameRegexp := regexp.MustCompile("^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$")
@@ -158,15 +186,35 @@ func pathContainsRegexpMatch(path *paths.Path, pathRegexp *regexp.Regexp) bool {
 	return false
 }
 
-// isValidJSON checks whether the specified file is a valid JSON document.
-func isValidJSON(path *paths.Path) bool {
-	data, err := path.ReadFile()
+// propertiesKeyFindings returns the location of the definition of the given key in the given properties file.
+// Only the file is located if the key is not defined, and there is no location if the file doesn't exist.
+func propertiesKeyFindings(propertiesPath *paths.Path, key string) []Finding {
+	if propertiesPath.NotExist() {
+		return nil
+	}
+
+	keyLines, err := general.PropertiesKeyLines(propertiesPath)
 	if err != nil {
 		panic(err)
 	}
-	return json.Valid(data)
+
+	return []Finding{{Path: propertiesPath, Line: keyLines[key]}}
+}
+
+// subjectFindings returns a finding in the given file for each of the given subjects.
+func subjectFindings(path *paths.Path, subjects []string) []Finding {
+	findings := []Finding{}
+	for _, subject := range subjects {
+		findings = append(findings, Finding{Path: path, Subject: subject})
+	}
+
+	return findings
 }
 
+// offlineOutput is the output of the rules that are not run because they require network access.
+const offlineOutput = "Network access disabled by --offline flag"
+
+// checkURL returns an error if the URL can't be loaded.
 func checkURL(url string) error {
 	logrus.Tracef("Checking URL: %s", url)
 	response, err := http.Head(url)
@@ -22,19 +22,33 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/project"
 	"github.com/arduino/arduino-lint/internal/project/projectdata"
 	"github.com/arduino/arduino-lint/internal/project/projecttype"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
+	"github.com/arduino/arduino-lint/internal/util/test"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
 )
 
 var testDataPath *paths.Path
 
+// testToolConfiguration is the tool configuration the rule functions are tested under.
+var testToolConfiguration *configuration.Type
+
+// testLibraryManagerIndex is shared by the tests, so that the index is only loaded once.
+var testLibraryManagerIndex projectdata.LibraryManagerIndexType
+
 func init() {
 	workingDirectory, _ := os.Getwd()
 	testDataPath = paths.New(workingDirectory, "testdata", "general")
+
+	var err error
+	testToolConfiguration, err = configuration.Initialize(test.ConfigurationFlags(), []string{workingDirectory})
+	if err != nil {
+		panic(err)
+	}
 }
 
 type ruleFunctionTestTable struct {
@@ -54,9 +68,9 @@ func checkRuleFunction(ruleFunction Type, testTables []ruleFunctionTestTable, t
 			SuperprojectType: projecttype.Library,
 		}
 
-		projectdata.Initialize(testProject)
+		projectData := projectdata.Initialize(testProject, testToolConfiguration, &testLibraryManagerIndex)
 
-		result, output := ruleFunction()
+		result, output, _ := ruleFunction(projectData)
 		assert.Equal(t, testTable.expectedRuleResult, result, testTable.testName)
 		assert.True(t, expectedOutputRegexp.MatchString(output), fmt.Sprintf("%s (output: %s, assertion regex: %s)", testTable.testName, output, testTable.expectedOutputQuery))
 	}
@@ -90,3 +104,41 @@ func TestIncorrectArduinoDotHFileNameCase(t *testing.T) {
 
 	checkRuleFunction(IncorrectArduinoDotHFileNameCase, testTables, t)
 }
+
+func TestIncorrectArduinoDotHFileNameCaseFindings(t *testing.T) {
+	projectPath := testDataPath.Join("arduino.h-angle")
+	projectData := projectdata.Initialize(
+		project.Type{
+			Path:             projectPath,
+			ProjectType:      projecttype.Library,
+			SuperprojectType: projecttype.Library,
+		},
+		testToolConfiguration,
+		&testLibraryManagerIndex,
+	)
+
+	_, _, findings := IncorrectArduinoDotHFileNameCase(projectData)
+	assert.Equal(t, []Finding{{Path: projectPath.Join("foo.h"), Line: 1, Column: 1}}, findings)
+}
+
+func Test_subjectFindings(t *testing.T) {
+	packageIndexPath := paths.New("/foo/package_foo_index.json")
+	assert.Equal(t, []Finding{}, subjectFindings(packageIndexPath, []string{}))
+	assert.Equal(t, []Finding{{Path: packageIndexPath, Subject: "foo"}, {Path: packageIndexPath, Subject: "bar"}}, subjectFindings(packageIndexPath, []string{"foo", "bar"}))
+}
+
+func TestSubjectOutput(t *testing.T) {
+	assert.Equal(t, brokenOutputList([]string{"bar"}), SubjectOutput(brokenOutputList([]string{"foo", "bar"}), []string{"foo", "bar"}, "bar"), "List output")
+	assert.Equal(t, "bar", SubjectOutput("foo, bar", []string{"foo", "bar"}, "bar"), "Inline output")
+	assert.Equal(t, "foo", SubjectOutput("foo", []string{"foo"}, "foo"), "Single subject inline output")
+}
+
+func Test_propertiesKeyFindings(t *testing.T) {
+	propertiesPath, err := paths.WriteToTempFile([]byte("foo=bar\nbaz=qux\n"), nil, "arduino-lint-rulefunction")
+	assert.Nil(t, err)
+	defer propertiesPath.Remove()
+
+	assert.Equal(t, []Finding{{Path: propertiesPath, Line: 2}}, propertiesKeyFindings(propertiesPath, "baz"))
+	assert.Equal(t, []Finding{{Path: propertiesPath}}, propertiesKeyFindings(propertiesPath, "nonexistent"), "Undefined key")
+	assert.Nil(t, propertiesKeyFindings(testDataPath.Join("nonexistent.txt"), "foo"), "Nonexistent file")
+}
@@ -28,10 +28,10 @@ import (
 )
 
 // SketchNameMismatch checks for mismatch between sketch folder name and primary file name.
-func SketchNameMismatch() (result ruleresult.Type, output string) {
-	primarySketchFilePrefix := projectdata.ProjectPath().Base()
+func SketchNameMismatch(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	primarySketchFilePrefix := projectData.ProjectPath().Base()
 
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -41,17 +41,17 @@ func SketchNameMismatch() (result ruleresult.Type, output string) {
 		for extension := range globals.MainFileValidExtensions {
 			if filePath.Base() == primarySketchFilePrefix+extension {
 				// There was a case-sensitive match (paths package's Exist() is not always case-sensitive, so can't be used here).
-				return ruleresult.Pass, ""
+				return ruleresult.Pass, "", nil
 			}
 		}
 	}
 
-	return ruleresult.Fail, projectdata.ProjectPath().Base() + ".ino"
+	return ruleresult.Fail, projectData.ProjectPath().Base() + ".ino", nil
 }
 
 // ProhibitedCharactersInSketchFileName checks for prohibited characters in the sketch file names.
-func ProhibitedCharactersInSketchFileName() (result ruleresult.Type, output string) {
-	directoryListing, _ := projectdata.ProjectPath().ReadDir()
+func ProhibitedCharactersInSketchFileName(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	directoryListing, _ := projectData.ProjectPath().ReadDir()
 	directoryListing.FilterOutDirs()
 
 	foundInvalidSketchFileNames := []string{}
@@ -59,20 +59,21 @@ func ProhibitedCharactersInSketchFileName() (result ruleresult.Type, output stri
 		if sketch.HasSupportedExtension(potentialSketchFile) {
 			if !validProjectPathBaseName(potentialSketchFile.Base()) {
 				foundInvalidSketchFileNames = append(foundInvalidSketchFileNames, potentialSketchFile.Base())
+				findings = append(findings, Finding{Path: potentialSketchFile})
 			}
 		}
 	}
 
 	if len(foundInvalidSketchFileNames) > 0 {
-		return ruleresult.Fail, strings.Join(foundInvalidSketchFileNames, ", ")
+		return ruleresult.Fail, strings.Join(foundInvalidSketchFileNames, ", "), findings
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // SketchFileNameGTMaxLength checks if the sketch file names exceed the maximum length.
-func SketchFileNameGTMaxLength() (result ruleresult.Type, output string) {
-	directoryListing, _ := projectdata.ProjectPath().ReadDir()
+func SketchFileNameGTMaxLength(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	directoryListing, _ := projectData.ProjectPath().ReadDir()
 	directoryListing.FilterOutDirs()
 
 	foundTooLongSketchFileNames := []string{}
@@ -80,38 +81,40 @@ func SketchFileNameGTMaxLength() (result ruleresult.Type, output string) {
 		if sketch.HasSupportedExtension(potentialSketchFile) {
 			if len(potentialSketchFile.Base())-len(potentialSketchFile.Ext()) > 63 {
 				foundTooLongSketchFileNames = append(foundTooLongSketchFileNames, potentialSketchFile.Base())
+				findings = append(findings, Finding{Path: potentialSketchFile})
 			}
 		}
 	}
 
 	if len(foundTooLongSketchFileNames) > 0 {
-		return ruleresult.Fail, strings.Join(foundTooLongSketchFileNames, ", ")
+		return ruleresult.Fail, strings.Join(foundTooLongSketchFileNames, ", "), findings
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // PdeSketchExtension checks for use of deprecated .pde sketch file extensions.
-func PdeSketchExtension() (result ruleresult.Type, output string) {
-	directoryListing, _ := projectdata.ProjectPath().ReadDir()
+func PdeSketchExtension(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	directoryListing, _ := projectData.ProjectPath().ReadDir()
 	directoryListing.FilterOutDirs()
 	pdeSketches := []string{}
 	for _, filePath := range directoryListing {
 		if filePath.Ext() == ".pde" {
 			pdeSketches = append(pdeSketches, filePath.Base())
+			findings = append(findings, Finding{Path: filePath})
 		}
 	}
 
 	if len(pdeSketches) > 0 {
-		return ruleresult.Fail, strings.Join(pdeSketches, ", ")
+		return ruleresult.Fail, strings.Join(pdeSketches, ", "), findings
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
 
 // IncorrectSketchSrcFolderNameCase checks for incorrect case of src subfolder name in recursive format libraries.
-func IncorrectSketchSrcFolderNameCase() (result ruleresult.Type, output string) {
-	directoryListing, err := projectdata.ProjectPath().ReadDir()
+func IncorrectSketchSrcFolderNameCase(projectData *projectdata.Type) (result ruleresult.Type, output string, findings []Finding) {
+	directoryListing, err := projectData.ProjectPath().ReadDir()
 	if err != nil {
 		panic(err)
 	}
@@ -119,8 +122,8 @@ func IncorrectSketchSrcFolderNameCase() (result ruleresult.Type, output string)
 
 	path, found := containsIncorrectPathBaseCase(directoryListing, "src")
 	if found {
-		return ruleresult.Fail, path.String()
+		return ruleresult.Fail, path.String(), []Finding{{Path: path}}
 	}
 
-	return ruleresult.Pass, ""
+	return ruleresult.Pass, "", nil
 }
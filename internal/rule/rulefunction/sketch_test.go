@@ -55,9 +55,9 @@ func checkSketchRuleFunction(ruleFunction Type, testTables []sketchRuleFunctionT
 				SuperprojectType: projecttype.Sketch,
 			}
 
-			projectdata.Initialize(testProject)
+			projectData := projectdata.Initialize(testProject, testToolConfiguration, &testLibraryManagerIndex)
 
-			result, output := ruleFunction()
+			result, output, _ := ruleFunction(projectData)
 			assert.Equal(t, testTable.expectedRuleResult, result, testTable.testName)
 			assert.True(t, expectedOutputRegexp.MatchString(output), fmt.Sprintf("%s (output: %s, assertion regex: %s)", testTable.testName, output, testTable.expectedOutputQuery))
 		})
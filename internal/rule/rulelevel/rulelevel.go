@@ -40,17 +40,29 @@ const (
 	Notice              // NOTICE
 )
 
-// RuleLevel determines the rule level assigned to the given result of the given rule under the current tool configuration.
-func RuleLevel(ruleConfiguration ruleconfiguration.Type, ruleResult ruleresult.Type, lintedProject project.Type) (Type, error) {
+// RuleLevel determines the rule level assigned to the given result of the given rule under the given tool configuration.
+func RuleLevel(ruleConfiguration ruleconfiguration.Type, ruleResult ruleresult.Type, lintedProject project.Type, toolConfiguration *configuration.Type) (Type, error) {
 	if ruleResult != ruleresult.Fail {
 		return Notice, nil // Level provided by FailRuleLevel() is only relevant for failure result.
 	}
-	configurationRuleModes := configuration.RuleModes(lintedProject.SuperprojectType)
-	return FailRuleLevel(ruleConfiguration, configurationRuleModes)
+	configurationRuleModes := toolConfiguration.RuleModes(lintedProject.SuperprojectType)
+	return FailRuleLevel(ruleConfiguration, configurationRuleModes, toolConfiguration)
 }
 
-// FailRuleLevel determines the level of a failed rule for the given rule modes.
-func FailRuleLevel(ruleConfiguration ruleconfiguration.Type, configurationRuleModes map[rulemode.Type]bool) (Type, error) {
+// FailRuleLevel determines the level of a failed rule for the given rule modes under the given tool configuration.
+func FailRuleLevel(ruleConfiguration ruleconfiguration.Type, configurationRuleModes map[rulemode.Type]bool, toolConfiguration *configuration.Type) (Type, error) {
+	// The configuration file setting takes precedence over the rule modes.
+	if ruleSetting, ok := toolConfiguration.RuleSetting(ruleConfiguration.ID); ok {
+		switch ruleSetting {
+		case configuration.RuleSettingError:
+			return Error, nil
+		case configuration.RuleSettingWarning:
+			return Warning, nil
+		case configuration.RuleSettingInfo:
+			return Info, nil
+		}
+	}
+
 	for _, errorMode := range ruleConfiguration.ErrorModes {
 		if configurationRuleModes[errorMode] {
 			return Error, nil
@@ -26,7 +26,9 @@ import (
 	"github.com/arduino/arduino-lint/internal/rule/ruleconfiguration"
 	"github.com/arduino/arduino-lint/internal/rule/ruleresult"
 	"github.com/arduino/arduino-lint/internal/util/test"
+	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRuleLevel(t *testing.T) {
@@ -58,7 +60,8 @@ func TestRuleLevel(t *testing.T) {
 		flags.Set("library-manager", testTable.libraryManagerSetting)
 		flags.Set("permissive", testTable.permissiveSetting)
 
-		configuration.Initialize(flags, []string{"/foo"})
+		toolConfiguration, err := configuration.Initialize(flags, []string{})
+		require.Nil(t, err, testTable.testName)
 
 		ruleConfiguration := ruleconfiguration.Type{
 			InfoModes:    testTable.infoModes,
@@ -70,10 +73,42 @@ func TestRuleLevel(t *testing.T) {
 			SuperprojectType: projecttype.Sketch,
 		}
 
-		level, err := RuleLevel(ruleConfiguration, testTable.ruleResult, lintedProject)
+		level, err := RuleLevel(ruleConfiguration, testTable.ruleResult, lintedProject, toolConfiguration)
 		testTable.errorAssertion(t, err, testTable.testName)
 		if err == nil {
 			assert.Equal(t, testTable.expectedLevel, level, testTable.testName)
 		}
 	}
 }
+
+func TestFailRuleLevelRuleSetting(t *testing.T) {
+	projectPath, err := paths.MkTempDir("", "arduino-lint-rulelevel-test")
+	require.Nil(t, err)
+	defer projectPath.RemoveAll()
+	require.Nil(t, projectPath.Join(".arduino-lint.yml").WriteFile([]byte("rules:\n  XX001: error\n  XX002: warning\n  XX003: info\n  XX004: off\n")))
+
+	toolConfiguration, err := configuration.Initialize(test.ConfigurationFlags(), []string{projectPath.String()})
+	require.Nil(t, err)
+
+	configurationRuleModes := map[rulemode.Type]bool{rulemode.Specification: true}
+	testTables := []struct {
+		ruleID        string
+		expectedLevel Type
+	}{
+		{"XX001", Error},
+		{"XX002", Warning},
+		{"XX003", Info},
+		{"XX004", Warning},
+		{"XX005", Warning},
+	}
+
+	for _, testTable := range testTables {
+		ruleConfiguration := ruleconfiguration.Type{
+			ID:           testTable.ruleID,
+			WarningModes: []rulemode.Type{rulemode.Default},
+		}
+		level, err := FailRuleLevel(ruleConfiguration, configurationRuleModes, toolConfiguration)
+		require.Nil(t, err)
+		assert.Equal(t, testTable.expectedLevel, level, testTable.ruleID)
+	}
+}
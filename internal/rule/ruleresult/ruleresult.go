@@ -31,4 +31,6 @@ const (
 	Skip // skipped
 	// NotRun indicates an unrelated error prevented the rule from running.
 	NotRun // unable to run
+	// Suppressed indicates a rule violation that was suppressed by a comment in the project files.
+	Suppressed // suppressed
 )
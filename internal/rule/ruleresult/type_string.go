@@ -12,11 +12,12 @@ func _() {
 	_ = x[Fail-1]
 	_ = x[Skip-2]
 	_ = x[NotRun-3]
+	_ = x[Suppressed-4]
 }
 
-const _Type_name = "passfailskippedunable to run"
+const _Type_name = "passfailskippedunable to runsuppressed"
 
-var _Type_index = [...]uint8{0, 4, 8, 15, 28}
+var _Type_index = [...]uint8{0, 4, 8, 15, 28, 38}
 
 func (i Type) String() string {
 	idx := int(i) - 0
@@ -47,6 +47,17 @@ type ValidationResult struct {
 
 // Compile compiles the schema files specified by the filename arguments and returns the compiled schema.
 func Compile(schemaFilename string, referencedSchemaFilenames []string, dataLoader dataLoaderType) Schema {
+	schemaObject, err := CompileWithError(schemaFilename, referencedSchemaFilenames, dataLoader)
+	if err != nil {
+		panic(err)
+	}
+
+	return schemaObject
+}
+
+// CompileWithError compiles the schema files specified by the filename arguments and returns the compiled schema, or an
+// error if the schemas are not valid. It is intended for schemas provided by the user.
+func CompileWithError(schemaFilename string, referencedSchemaFilenames []string, dataLoader dataLoaderType) (Schema, error) {
 	compiler := jsonschema.NewCompiler()
 
 	// Define a custom schema loader for the binary encoded schema.
@@ -62,20 +73,20 @@ func Compile(schemaFilename string, referencedSchemaFilenames []string, dataLoad
 	// Load the referenced schemas.
 	for _, referencedSchemaFilename := range referencedSchemaFilenames {
 		if err := loadReferencedSchema(compiler, referencedSchemaFilename, dataLoader); err != nil {
-			panic(err)
+			return Schema{}, err
 		}
 	}
 
 	// Compile the schema.
 	compiledSchema, err := compiler.Compile(schemaFilename)
 	if err != nil {
-		panic(err)
+		return Schema{}, err
 	}
 
 	return Schema{
 		Compiled:   compiledSchema,
 		dataLoader: dataLoader,
-	}
+	}, nil
 }
 
 // Validate validates an instance against a JSON schema and returns nil if it was success, or the
@@ -87,6 +87,18 @@ func TestCompile(t *testing.T) {
 	})
 }
 
+func TestCompileWithError(t *testing.T) {
+	_, err := CompileWithError("valid-schema-with-references.json", []string{}, testdata.Asset)
+	assert.Error(t, err, "Missing referenced schema")
+
+	_, err = CompileWithError("invalid-schema.json", []string{}, testdata.Asset)
+	assert.Error(t, err)
+
+	schemaObject, err := CompileWithError("valid-schema.json", []string{}, testdata.Asset)
+	require.Nil(t, err)
+	assert.NotNil(t, schemaObject.Compiled)
+}
+
 func TestValidate(t *testing.T) {
 	schemaObject := Compile("valid-schema.json", []string{}, testdata.Asset)
 	propertiesMap := properties.NewFromHashmap(validMap)
@@ -8,6 +8,7 @@
 // etc/schemas/arduino-library-properties-permissive-schema.json
 // etc/schemas/arduino-library-properties-schema.json
 // etc/schemas/arduino-library-properties-strict-schema.json
+// etc/schemas/arduino-lint-report-schema.json
 // etc/schemas/arduino-package-index-definitions-schema.json
 // etc/schemas/arduino-package-index-permissive-schema.json
 // etc/schemas/arduino-package-index-schema.json
@@ -2530,6 +2531,243 @@ func arduinoLibraryPropertiesStrictSchemaJson() (*asset, error) {
 	return a, nil
 }
 
+var _arduinoLintReportSchemaJson = []byte(`{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "$id": "https://raw.githubusercontent.com/arduino/arduino-lint/main/etc/schemas/arduino-lint-report-schema.json",
+  "title": "Arduino Lint JSON report",
+  "description": "The report produced by Arduino Lint's json output format. See: https://arduino.github.io/arduino-lint/latest/",
+  "$comment": "This is free software: you can redistribute it and/or modify it under the terms of the GNU General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.",
+  "type": "object",
+  "properties": {
+    "reportVersion": {
+      "description": "Version of the report format. Changes to the format increment the version. A previous version can be selected via the --report-version flag.",
+      "const": 4
+    },
+    "configuration": {
+      "description": "Configuration of the Arduino Lint run.",
+      "type": "object",
+      "properties": {
+        "paths": {
+          "description": "The PROJECT_PATH arguments.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "projectType": {
+          "description": "The --project-type setting.",
+          "enum": ["sketch", "library", "platform", "package-index", "all"]
+        },
+        "recursive": {
+          "description": "The --recursive setting.",
+          "type": "boolean"
+        }
+      },
+      "required": ["paths", "projectType", "recursive"],
+      "additionalProperties": false
+    },
+    "projects": {
+      "description": "Reports of the linted projects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/projectReport"
+      }
+    },
+    "summary": {
+      "description": "Summary of the rule results of all projects.",
+      "$ref": "#/definitions/summaryReport"
+    }
+  },
+  "required": ["reportVersion", "configuration", "projects", "summary"],
+  "additionalProperties": false,
+  "definitions": {
+    "projectReport": {
+      "type": "object",
+      "properties": {
+        "path": {
+          "description": "Path of the project.",
+          "type": "string"
+        },
+        "projectType": {
+          "description": "Type of the project.",
+          "enum": ["sketch", "library", "platform", "package-index"]
+        },
+        "configuration": {
+          "description": "Configuration of the rules for the project.",
+          "type": "object",
+          "properties": {
+            "compliance": {
+              "enum": ["strict", "specification", "permissive"]
+            },
+            "libraryManager": {
+              "enum": ["submit", "update", "ARDUINO_LINT_LIBRARY_MANAGER_INDEXING", "false"]
+            },
+            "official": {
+              "type": "boolean"
+            }
+          },
+          "required": ["compliance", "libraryManager", "official"],
+          "additionalProperties": false
+        },
+        "rules": {
+          "description": "Reports of the rules that were applied to the project. Rules that didn't fail are only reported with the --verbose flag or --report-detail full setting.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ruleReport"
+          }
+        },
+        "summary": {
+          "description": "Summary of the rule results of the project.",
+          "$ref": "#/definitions/summaryReport"
+        },
+        "timings": {
+          "description": "Execution times in seconds. Only present when the --timings flag is set.",
+          "type": "object",
+          "properties": {
+            "initialization": {
+              "description": "Time taken to gather the project data used by the rules.",
+              "type": "number",
+              "minimum": 0
+            },
+            "rules": {
+              "description": "Time taken by each rule that was applied to the project.",
+              "type": "array",
+              "items": {
+                "type": "object",
+                "properties": {
+                  "ID": {
+                    "type": "string"
+                  },
+                  "duration": {
+                    "type": "number",
+                    "minimum": 0
+                  }
+                },
+                "required": ["ID", "duration"],
+                "additionalProperties": false
+              }
+            }
+          },
+          "required": ["initialization", "rules"],
+          "additionalProperties": false
+        }
+      },
+      "required": ["path", "projectType", "configuration", "rules", "summary"],
+      "additionalProperties": false
+    },
+    "ruleReport": {
+      "type": "object",
+      "properties": {
+        "category": {
+          "type": "string"
+        },
+        "subcategory": {
+          "type": "string"
+        },
+        "ID": {
+          "type": "string"
+        },
+        "brief": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "result": {
+          "enum": ["pass", "fail", "skipped", "unable to run", "suppressed"]
+        },
+        "level": {
+          "enum": ["INFO", "WARNING", "ERROR", "NOTICE"]
+        },
+        "message": {
+          "type": "string"
+        },
+        "locations": {
+          "description": "Locations of the rule violation in the project files, when provided by the rule.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/locationReport"
+          }
+        }
+      },
+      "required": ["category", "subcategory", "ID", "brief", "description", "result", "level", "message"],
+      "additionalProperties": false
+    },
+    "locationReport": {
+      "type": "object",
+      "properties": {
+        "path": {
+          "type": "string"
+        },
+        "line": {
+          "description": "1-based line number. Omitted when the location is the whole file.",
+          "type": "integer",
+          "minimum": 1
+        },
+        "column": {
+          "description": "1-based column number. Omitted when the location is the whole line.",
+          "type": "integer",
+          "minimum": 1
+        }
+      },
+      "required": ["path"],
+      "additionalProperties": false
+    },
+    "summaryReport": {
+      "type": "object",
+      "properties": {
+        "pass": {
+          "description": "Whether there were no rule violations of the levels that cause a failure under the --fail-on setting.",
+          "type": "boolean"
+        },
+        "warningCount": {
+          "description": "Number of rule violations of warning level. A rule that checks multiple items has a violation for each non-compliant item.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "errorCount": {
+          "description": "Number of rule violations of error level. A rule that checks multiple items has a violation for each non-compliant item.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "passCount": {
+          "description": "Number of rules that passed.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "skipCount": {
+          "description": "Number of rules that were skipped because they don't apply to the project, or were disabled by the user configuration.",
+          "type": "integer",
+          "minimum": 0
+        },
+        "notRunCount": {
+          "description": "Number of rules that were unable to run.",
+          "type": "integer",
+          "minimum": 0
+        }
+      },
+      "required": ["pass", "warningCount", "errorCount", "passCount", "skipCount", "notRunCount"],
+      "additionalProperties": false
+    }
+  }
+}
+`)
+
+func arduinoLintReportSchemaJsonBytes() ([]byte, error) {
+	return _arduinoLintReportSchemaJson, nil
+}
+
+func arduinoLintReportSchemaJson() (*asset, error) {
+	bytes, err := arduinoLintReportSchemaJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "arduino-lint-report-schema.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _arduinoPackageIndexDefinitionsSchemaJson = []byte(`{
   "$schema": "http://json-schema.org/draft-07/schema#",
   "$id": "https://raw.githubusercontent.com/arduino/arduino-lint/main/etc/schemas/arduino-package-index-definitions-schema.json",
@@ -6880,6 +7118,7 @@ var _bindata = map[string]func() (*asset, error){
 	"arduino-library-properties-permissive-schema.json":  arduinoLibraryPropertiesPermissiveSchemaJson,
 	"arduino-library-properties-schema.json":             arduinoLibraryPropertiesSchemaJson,
 	"arduino-library-properties-strict-schema.json":      arduinoLibraryPropertiesStrictSchemaJson,
+	"arduino-lint-report-schema.json":                    arduinoLintReportSchemaJson,
 	"arduino-package-index-definitions-schema.json":      arduinoPackageIndexDefinitionsSchemaJson,
 	"arduino-package-index-permissive-schema.json":       arduinoPackageIndexPermissiveSchemaJson,
 	"arduino-package-index-schema.json":                  arduinoPackageIndexSchemaJson,
@@ -6944,6 +7183,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"arduino-library-properties-permissive-schema.json":  &bintree{arduinoLibraryPropertiesPermissiveSchemaJson, map[string]*bintree{}},
 	"arduino-library-properties-schema.json":             &bintree{arduinoLibraryPropertiesSchemaJson, map[string]*bintree{}},
 	"arduino-library-properties-strict-schema.json":      &bintree{arduinoLibraryPropertiesStrictSchemaJson, map[string]*bintree{}},
+	"arduino-lint-report-schema.json":                    &bintree{arduinoLintReportSchemaJson, map[string]*bintree{}},
 	"arduino-package-index-definitions-schema.json":      &bintree{arduinoPackageIndexDefinitionsSchemaJson, map[string]*bintree{}},
 	"arduino-package-index-permissive-schema.json":       &bintree{arduinoPackageIndexPermissiveSchemaJson, map[string]*bintree{}},
 	"arduino-package-index-schema.json":                  &bintree{arduinoPackageIndexSchemaJson, map[string]*bintree{}},
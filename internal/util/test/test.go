@@ -27,16 +27,32 @@ import (
 // ConfigurationFlags returns a set of the flags used for command line configuration of arduino-lint.
 func ConfigurationFlags() *pflag.FlagSet {
 	flags := pflag.NewFlagSet("", pflag.ExitOnError)
+	flags.String("baseline", "", "")
 	flags.String("compliance", "specification", "")
+	flags.StringSlice("disable-rules", []string{}, "")
+	flags.Bool("dry-run", false, "")
+	flags.StringSlice("enable-rules", []string{}, "")
+	flags.String("fail-on", "error", "")
+	flags.Bool("fix", false, "")
 	flags.String("format", "text", "")
+	flags.Int("jobs", 1, "")
+	flags.String("library-index", "", "")
 	flags.String("library-manager", "", "")
 	flags.String("log-format", "text", "")
 	flags.String("log-level", "panic", "")
+	flags.Bool("offline", false, "")
+	flags.StringSlice("only", []string{}, "")
+	flags.String("plugins-config", "", "")
 	flags.String("project-type", "all", "")
 	flags.Bool("recursive", true, "")
+	flags.String("report-detail", "violations", "")
 	flags.String("report-file", "", "")
+	flags.String("report-format", "", "")
+	flags.String("report-version", "", "")
+	flags.Bool("timings", false, "")
 	flags.Bool("verbose", false, "")
 	flags.Bool("version", false, "")
+	flags.String("write-baseline", "", "")
 
 	return flags
 }
@@ -18,8 +18,10 @@ package main
 
 import (
 	"os"
+	"runtime/debug"
 
 	"github.com/arduino/arduino-lint/internal/cli"
+	"github.com/arduino/arduino-lint/internal/command"
 	"github.com/arduino/arduino-lint/internal/configuration"
 	"github.com/arduino/arduino-lint/internal/result/feedback"
 )
@@ -29,9 +31,23 @@ func init() {
 }
 
 func main() {
+	defer func() {
+		// The Go runtime's exit status for a panic would be mistaken for a configuration error.
+		if recovered := recover(); recovered != nil {
+			feedback.Errorf("Internal error: %v\n%s", recovered, debug.Stack())
+			os.Exit(command.ExitInternalError)
+		}
+	}()
+
+	if err := configuration.InitializeLogging(); err != nil {
+		feedback.Errorf("Invalid configuration: %v", err)
+		os.Exit(command.ExitConfigurationError)
+	}
+
 	rootCommand := cli.Root()
 	if err := rootCommand.Execute(); err != nil {
+		// Cobra only returns errors for invalid flags or arguments.
 		feedback.Error(err.Error())
-		os.Exit(1)
+		os.Exit(command.ExitConfigurationError)
 	}
 }
@@ -0,0 +1,204 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package lint provides a Go API for running Arduino Lint from other programs.
+//
+// Each call to Run has its own linter configuration, so concurrent calls don't affect each other.
+// Log messages are written to the standard logger of the github.com/sirupsen/logrus package, which is configured by the
+// calling program. Unlike the arduino-lint command, Run does not configure it from the environment variables.
+package lint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-lint/internal/cli"
+	"github.com/arduino/arduino-lint/internal/configuration"
+	"github.com/arduino/arduino-lint/internal/project"
+	"github.com/arduino/arduino-lint/internal/project/projectdata"
+	"github.com/arduino/arduino-lint/internal/result"
+	"github.com/arduino/arduino-lint/internal/rule"
+	"github.com/spf13/pflag"
+)
+
+// Options are the settings of a lint run.
+// The zero value of each field results in the default of the equivalent arduino-lint flag.
+// As with the command line interface, settings not provided are taken from the configuration file of the projects.
+type Options struct {
+	Paths              []string // Paths of the projects to lint. The working directory is used if empty.
+	ProjectType        string   // Only lint projects of this type: "sketch", "library", "platform", "package-index", or "all".
+	Recursive          bool     // Search the paths recursively for projects.
+	Compliance         string   // How strict the rules are: "strict", "specification", or "permissive".
+	LibraryManagerMode string   // Library Manager rules configuration: "submit", "update", or "false".
+	Offline            bool     // Don't access the network. Rules which require network access are not run.
+	LibraryIndexPath   string   // Path of a local copy of the Library Manager index to use instead of downloading it.
+	EnableRules        []string // Run the rules matching these selectors, even if they are disabled by the other settings.
+	DisableRules       []string // Don't run the rules matching these selectors.
+	Only               []string // Only run the rules matching these selectors.
+	FailOn             string   // The lowest rule violation level that fails the run: "error", "warning", "info", or "never".
+	FullReport         bool     // Report the result of every rule that was applied, rather than only the violations.
+	Jobs               int      // The number of rules to run at the same time.
+}
+
+// Report is the report of a lint run. It has the structure of the arduino-lint JSON report.
+type Report struct {
+	Projects []ProjectReport `json:"projects"`
+	Summary  Summary         `json:"summary"` // Summary of the rule results of all projects.
+}
+
+// ProjectReport is the report of a linted project.
+type ProjectReport struct {
+	Path        string       `json:"path"`
+	ProjectType string       `json:"projectType"`
+	Rules       []RuleReport `json:"rules"` // Only the rule violations are reported unless Options.FullReport is set.
+	Summary     Summary      `json:"summary"`
+}
+
+// RuleReport is the report of a rule applied to a project.
+type RuleReport struct {
+	Category    string     `json:"category"`
+	Subcategory string     `json:"subcategory"`
+	ID          string     `json:"ID"`
+	Brief       string     `json:"brief"`
+	Description string     `json:"description"`
+	Result      string     `json:"result"` // "pass", "fail", "skipped", "unable to run", or "suppressed".
+	Level       string     `json:"level"`  // "INFO", "WARNING", "ERROR", or "NOTICE".
+	Message     string     `json:"message"`
+	Locations   []Location `json:"locations,omitempty"` // Locations of the rule violation, when provided by the rule.
+}
+
+// Location is the location of a rule violation in a project file.
+type Location struct {
+	Path   string `json:"path"`
+	Line   int    `json:"line,omitempty"`   // 1-based line number. 0 when the location is the whole file.
+	Column int    `json:"column,omitempty"` // 1-based column number. 0 when the location is the whole line.
+}
+
+// Summary is the summary of rule results.
+type Summary struct {
+	Pass         bool `json:"pass"` // Whether there were no rule violations of the levels set by Options.FailOn.
+	WarningCount int  `json:"warningCount"`
+	ErrorCount   int  `json:"errorCount"`
+	PassCount    int  `json:"passCount"`
+	SkipCount    int  `json:"skipCount"`
+	NotRunCount  int  `json:"notRunCount"`
+}
+
+// Run lints the projects according to the given options and returns the report.
+// Nothing is printed to stdout. When the context is canceled, the rules that have not started are not run, running
+// plugins are killed, and the context's error is returned.
+// An error is returned when the Library Manager index is needed, but can't be loaded.
+func Run(ctx context.Context, options Options) (report *Report, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			report = nil
+			err = fmt.Errorf("Internal error: %v", recovered)
+		}
+	}()
+
+	flags, err := optionsFlags(options)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid configuration: %w", err)
+	}
+	toolConfiguration, err := configuration.Initialize(flags, options.Paths)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid configuration: %w", err)
+	}
+	if err := rule.ValidateRuleSettings(toolConfiguration); err != nil {
+		return nil, fmt.Errorf("Invalid configuration: %w", err)
+	}
+
+	projects, err := project.FindProjects(toolConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each run uses the current Library Manager index, rather than the one loaded by a previous run.
+	var libraryManagerIndex projectdata.LibraryManagerIndexType
+	var results result.Type
+	results.Initialize(toolConfiguration)
+	for _, lintedProject := range projects {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// The projects are linted one at a time so that a canceled run doesn't continue with the other projects.
+		rule.Record(&results, lintedProject, rule.Runner(ctx, []project.Type{lintedProject}, toolConfiguration, &libraryManagerIndex)[0])
+		results.AddProjectSummary(lintedProject)
+	}
+	if err := ctx.Err(); err != nil {
+		// Some of the rules of the last project were not run.
+		return nil, err
+	}
+	results.AddSummary()
+
+	if err := libraryManagerIndex.Err(); err != nil {
+		// The rules that use the index were not run, so the report can't be relied on.
+		return nil, fmt.Errorf("%w. The rules that use the Library Manager index were not run", err)
+	}
+
+	report = new(Report)
+	if err := json.Unmarshal([]byte(results.JSONReport()), report); err != nil {
+		panic(err)
+	}
+
+	return report, nil
+}
+
+// optionsFlags returns the arduino-lint flags equivalent to the given options.
+func optionsFlags(options Options) (*pflag.FlagSet, error) {
+	// The flags of the command line interface are used so that the defaults are the same.
+	flags := cli.Root().PersistentFlags()
+
+	flagValues := map[string]string{
+		// The progress of the linting is only printed in the text output format.
+		"format":          "json",
+		"project-type":    options.ProjectType,
+		"compliance":      options.Compliance,
+		"library-manager": options.LibraryManagerMode,
+		"library-index":   options.LibraryIndexPath,
+		"enable-rules":    strings.Join(options.EnableRules, ","),
+		"disable-rules":   strings.Join(options.DisableRules, ","),
+		"only":            strings.Join(options.Only, ","),
+		"fail-on":         options.FailOn,
+	}
+	if options.Recursive {
+		flagValues["recursive"] = strconv.FormatBool(options.Recursive)
+	}
+	if options.Offline {
+		flagValues["offline"] = strconv.FormatBool(options.Offline)
+	}
+	if options.FullReport {
+		flagValues["report-detail"] = "full"
+	}
+	if options.Jobs != 0 {
+		flagValues["jobs"] = strconv.Itoa(options.Jobs)
+	}
+
+	for flagName, flagValue := range flagValues {
+		if flagValue == "" {
+			continue
+		}
+		if err := flags.Set(flagName, flagValue); err != nil {
+			return nil, fmt.Errorf("%s value %s not valid: %v", flagName, flagValue, err)
+		}
+	}
+
+	return flags, nil
+}
@@ -0,0 +1,140 @@
+// This file is part of Arduino Lint.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License, either
+// version 3 of the License, or (at your option) any later version.
+// This license covers the main part of Arduino Lint.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lint
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	projectsPath, err := paths.MkTempDir("", "arduino-lint-lint-TestRun")
+	require.Nil(t, err)
+	defer projectsPath.RemoveAll()
+	sketchPath := projectsPath.Join("Foo")
+	require.Nil(t, sketchPath.Mkdir())
+	require.Nil(t, sketchPath.Join("Foo.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	require.Nil(t, sketchPath.Join("README.md").WriteFile([]byte("# Foo\n")))
+	require.Nil(t, sketchPath.Join("LICENSE.txt").WriteFile([]byte("MIT License\n")))
+
+	// Capture stdout to check that the run doesn't print.
+	stdout := os.Stdout
+	stdoutReader, stdoutWriter, err := os.Pipe()
+	require.Nil(t, err)
+	os.Stdout = stdoutWriter
+	report, err := Run(context.Background(), Options{Paths: []string{sketchPath.String()}, Offline: true})
+	os.Stdout = stdout
+	require.Nil(t, stdoutWriter.Close())
+	output, err := io.ReadAll(stdoutReader)
+	require.Nil(t, err)
+	assert.Empty(t, output, "Nothing is printed to stdout")
+
+	require.NotNil(t, report)
+	require.Len(t, report.Projects, 1)
+	assert.Equal(t, sketchPath.String(), report.Projects[0].Path)
+	assert.Equal(t, "sketch", report.Projects[0].ProjectType)
+	assert.Empty(t, report.Projects[0].Rules, "Only violations are reported by default")
+	assert.True(t, report.Summary.Pass)
+	assert.Positive(t, report.Summary.PassCount)
+
+	report, err = Run(context.Background(), Options{Paths: []string{sketchPath.String()}, Offline: true, FullReport: true, Only: []string{"SS*"}})
+	require.Nil(t, err)
+	assert.NotEmpty(t, report.Projects[0].Rules)
+	for _, ruleReport := range report.Projects[0].Rules {
+		assert.Contains(t, []string{"pass", "skipped"}, ruleReport.Result, ruleReport.ID)
+	}
+
+	report, err = Run(context.Background(), Options{Paths: []string{projectsPath.String()}, Recursive: true, Offline: true})
+	require.Nil(t, err)
+	assert.Len(t, report.Projects, 1, "Recursive search")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = Run(ctx, Options{Paths: []string{sketchPath.String()}, Offline: true})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRunConcurrent(t *testing.T) {
+	sketchPath, err := paths.MkTempDir("", "arduino-lint-lint-TestRunConcurrent")
+	require.Nil(t, err)
+	defer sketchPath.RemoveAll()
+	require.Nil(t, sketchPath.Join(sketchPath.Base()+".ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+
+	// Runs with different options at the same time each get the report of their own options.
+	optionsList := []Options{
+		{Paths: []string{sketchPath.String()}, Offline: true},
+		{Paths: []string{sketchPath.String()}, Offline: true, FullReport: true, Only: []string{"SS*"}},
+	}
+	reports := make([]*Report, len(optionsList))
+	errs := make([]error, len(optionsList))
+	var waitGroup sync.WaitGroup
+	for index, options := range optionsList {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			reports[index], errs[index] = Run(context.Background(), options)
+		}()
+	}
+	waitGroup.Wait()
+
+	for index := range optionsList {
+		require.Nil(t, errs[index])
+		require.Len(t, reports[index].Projects, 1)
+	}
+	for _, ruleReport := range reports[0].Projects[0].Rules {
+		assert.Equal(t, "fail", ruleReport.Result, "Only violations are reported by default")
+	}
+	assert.NotEmpty(t, reports[1].Projects[0].Rules)
+	for _, ruleReport := range reports[1].Projects[0].Rules {
+		assert.Contains(t, []string{"pass", "skipped"}, ruleReport.Result, ruleReport.ID)
+	}
+}
+
+func TestRunError(t *testing.T) {
+	projectsPath, err := paths.MkTempDir("", "arduino-lint-lint-TestRunError")
+	require.Nil(t, err)
+	defer projectsPath.RemoveAll()
+
+	for _, options := range []Options{
+		{Paths: []string{projectsPath.String()}, Compliance: "foo"},
+		{Paths: []string{projectsPath.String()}, Jobs: -1},
+		{Paths: []string{projectsPath.String()}, Only: []string{"foo"}},
+		{Paths: []string{projectsPath.Join("nonexistent").String()}},
+		{Paths: []string{projectsPath.String()}},
+	} {
+		report, err := Run(context.Background(), options)
+		assert.Error(t, err, options)
+		assert.Nil(t, report, options)
+	}
+
+	libraryPath := projectsPath.Join("Foo")
+	require.Nil(t, libraryPath.Mkdir())
+	require.Nil(t, libraryPath.Join("Foo.h").WriteFile([]byte{}))
+	require.Nil(t, libraryPath.Join("library.properties").WriteFile([]byte("name=Foo\n")))
+	libraryIndexPath := projectsPath.Join("library_index.json")
+	require.Nil(t, libraryIndexPath.WriteFile([]byte("foo")))
+	report, err := Run(context.Background(), Options{Paths: []string{libraryPath.String()}, LibraryIndexPath: libraryIndexPath.String()})
+	assert.ErrorContains(t, err, "Library Manager index", "Library Manager index load failure")
+	assert.Nil(t, report)
+}
@@ -219,11 +219,12 @@ func ruleLevels(ruleConfiguration ruleconfiguration.Type) [][]string {
 				if err := flags.Set("library-manager", libraryManagerFlagValue); err != nil {
 					panic(err)
 				}
-				if err := configuration.Initialize(flags, []string{}); err != nil {
+				toolConfiguration, err := configuration.Initialize(flags, []string{})
+				if err != nil {
 					panic(err)
 				}
-				ruleModes := configuration.RuleModes(ruleConfiguration.ProjectType)
-				levelsData = append(levelsData, []string{complianceMode.String(), libraryManagerFlagValue, ruleLevel(ruleConfiguration, ruleModes)})
+				ruleModes := toolConfiguration.RuleModes(ruleConfiguration.ProjectType)
+				levelsData = append(levelsData, []string{complianceMode.String(), libraryManagerFlagValue, ruleLevel(ruleConfiguration, ruleModes, toolConfiguration)})
 			}
 		}
 	} else {
@@ -234,25 +235,27 @@ func ruleLevels(ruleConfiguration ruleconfiguration.Type) [][]string {
 			if err := flags.Set("compliance", complianceMode.String()); err != nil {
 				panic(err)
 			}
-			if err := configuration.Initialize(flags, []string{}); err != nil {
+			toolConfiguration, err := configuration.Initialize(flags, []string{})
+			if err != nil {
 				panic(err)
 			}
-			ruleModes := configuration.RuleModes(ruleConfiguration.ProjectType)
-			levelsData = append(levelsData, []string{complianceMode.String(), ruleLevel(ruleConfiguration, ruleModes)})
+			ruleModes := toolConfiguration.RuleModes(ruleConfiguration.ProjectType)
+			levelsData = append(levelsData, []string{complianceMode.String(), ruleLevel(ruleConfiguration, ruleModes, toolConfiguration)})
 		}
 	}
 
 	return levelsData
 }
 
-// ruleLevel returns the string representation of the violation level of the given rule in the given mode.
-func ruleLevel(ruleConfiguration ruleconfiguration.Type, ruleModes map[rulemode.Type]bool) string {
-	enabled, err := rule.IsEnabled(ruleConfiguration, ruleModes)
+// ruleLevel returns the string representation of the violation level of the given rule in the given mode, under the given
+// tool configuration.
+func ruleLevel(ruleConfiguration ruleconfiguration.Type, ruleModes map[rulemode.Type]bool, toolConfiguration *configuration.Type) string {
+	enabled, err := rule.IsEnabled(ruleConfiguration, ruleModes, toolConfiguration)
 	if err != nil {
 		panic(err)
 	}
 	if enabled {
-		ruleLevel, err := rulelevel.FailRuleLevel(ruleConfiguration, ruleModes)
+		ruleLevel, err := rulelevel.FailRuleLevel(ruleConfiguration, ruleModes, toolConfiguration)
 		if err != nil {
 			panic(err)
 		}
@@ -49,11 +49,259 @@ def test_compliance(run_command, project_folder, compliance_level):
         assert result.ok == expected_ok
 
 
+def test_baseline(run_command, working_dir):
+    project_path = test_data_path.joinpath("compliance", "Specification")
+    baseline_path = pathlib.Path(working_dir).joinpath("baseline.json")
+    result = run_command(cmd=["--compliance", "strict", "--write-baseline", baseline_path, project_path])
+    assert not result.ok
+    assert len(json.loads(baseline_path.read_text())["findings"]) > 0
+
+    result = run_command(cmd=["--compliance", "strict", "--baseline", baseline_path, project_path])
+    assert result.ok
+
+    result = run_command(cmd=["--baseline", pathlib.Path(working_dir).joinpath("nonexistent.json"), project_path])
+    assert not result.ok
+
+
 def test_compliance_invalid(run_command):
     result = run_command(cmd=["--compliance", "foo", test_data_path.joinpath("ValidSketch")])
     assert not result.ok
 
 
+def test_configuration_file(run_command):
+    project_path = test_data_path.joinpath("configuration-file", "Specification")
+    result = run_command(cmd=["--format", "json", project_path])
+    assert not result.ok
+    rules = json.loads(result.stdout)["projects"][0]["rules"]
+    assert [(rule["ID"], rule["level"]) for rule in rules] == [("SD001", "WARNING"), ("SD002", "ERROR")]
+
+    result = run_command(cmd=["--compliance", "specification", project_path])
+    assert result.ok
+
+
+def test_individual_violations(run_command):
+    project_path = test_data_path.joinpath("individual-violations", "Platform")
+    result = run_command(cmd=["--only", "PF005", "--format", "json", project_path])
+    assert result.ok
+    report = json.loads(result.stdout)
+    rules = report["projects"][0]["rules"]
+    assert [(rule["ID"], rule["locations"][0]["line"]) for rule in rules] == [("PF005", 1), ("PF005", 6)]
+    assert rules[0]["message"].startswith("Missing build.board property for board ID(s) uno\n")
+    assert report["summary"]["warningCount"] == 2
+
+    result = run_command(cmd=["--only", "PF005", "--format", "json", "--report-version", "3", project_path])
+    assert result.ok
+    report = json.loads(result.stdout)
+    rules = report["projects"][0]["rules"]
+    assert [(rule["ID"], [location["line"] for location in rule["locations"]]) for rule in rules] == [("PF005", [1, 6])]
+    assert rules[0]["message"].startswith("Missing build.board property for board ID(s) uno, nano\n")
+    assert report["summary"]["warningCount"] == 1
+
+
+def test_custom_rules(run_command):
+    project_path = test_data_path.joinpath("custom-rules", "Platform")
+    result = run_command(cmd=["--only", "custom", "--format", "json", project_path])
+    assert not result.ok
+    rules = json.loads(result.stdout)["projects"][0]["rules"]
+    assert [(rule["ID"], rule["level"], rule["message"]) for rule in rules] == [
+        ("CR001", "WARNING", "Board names must start with the vendor prefix Example: mega.name"),
+        ("CR002", "ERROR", "Missing maintainer property."),
+    ]
+
+    result = run_command(cmd=["--only", "CR001", project_path])
+    assert result.ok
+
+
+def test_plugin(run_command):
+    project_path = test_data_path.joinpath("plugin", "Foo")
+    plugins_config_path = test_data_path.joinpath("plugin", ".arduino-lint.yml")
+    result = run_command(
+        cmd=["--plugins-config", plugins_config_path, "--only", "plugin", "--format", "json", project_path]
+    )
+    assert not result.ok
+    rules = json.loads(result.stdout)["projects"][0]["rules"]
+    assert [(rule["ID"], rule["level"], rule["subcategory"]) for rule in rules] == [
+        ("HR001", "ERROR", "house-rules"),
+        ("HR002", "WARNING", "house-rules"),
+    ]
+
+    result = run_command(cmd=["--plugins-config", plugins_config_path, "--only", "HR002", project_path])
+    assert result.ok
+
+    # The plugins of the configuration file found in the project path are not run without the --plugins-config flag.
+    result = run_command(cmd=["--only", "HR002", project_path])
+    assert result.exited == 2
+
+
+def test_suppression(run_command):
+    project_path = test_data_path.joinpath("suppression", "Platform")
+    result = run_command(cmd=["--compliance", "strict", "--format", "json", project_path])
+    assert result.ok
+    rules = json.loads(result.stdout)["projects"][0]["rules"]
+    assert [(rule["ID"], rule["result"]) for rule in rules] == [("PF044", "suppressed")]
+
+
+def test_fix(run_command, working_dir):
+    project_path = pathlib.Path(working_dir).joinpath("Fixable")
+    shutil.copytree(src=test_data_path.joinpath("fix", "Fixable"), dst=project_path)
+
+    result = run_command(cmd=["--fix", "--dry-run", project_path])
+    assert not result.ok
+    assert "rename from Fixable.pde" in result.stdout
+    assert "+#include <Arduino.h>" in result.stdout
+    assert project_path.joinpath("Fixable.pde").exists()
+
+    result = run_command(cmd=["--fix", project_path])
+    assert result.ok
+    assert sorted(path.name for path in project_path.iterdir()) == ["Fixable.ino", "src"]
+    assert project_path.joinpath("Fixable.ino").read_text().startswith("#include <Arduino.h>")
+
+    result = run_command(cmd=["--dry-run", project_path])
+    assert not result.ok
+
+
+def test_offline(run_command):
+    project_path = test_data_path.joinpath("library-manager", "Update")
+    result = run_command(cmd=["--offline", "--library-manager", "update", "--verbose", "--format", "json", project_path])
+    assert result.ok
+    rules = {rule["ID"]: rule for rule in json.loads(result.stdout)["projects"][0]["rules"]}
+    assert rules["LP018"]["result"] == "unable to run"
+    assert rules["LP042"]["result"] == "unable to run"
+
+    library_index_path = test_data_path.joinpath("library-index", "library_index.json")
+    result = run_command(
+        cmd=[
+            "--offline",
+            "--library-index",
+            library_index_path,
+            "--library-manager",
+            "update",
+            "--verbose",
+            "--format",
+            "json",
+            project_path,
+        ]
+    )
+    assert result.ok
+    rules = {rule["ID"]: rule for rule in json.loads(result.stdout)["projects"][0]["rules"]}
+    assert rules["LP018"]["result"] == "pass"
+
+    result = run_command(
+        cmd=["--offline", "--library-manager", "update", "--verbose", "--format", "json", project_path],
+        custom_env={"ARDUINO_LINT_LIBRARY_INDEX": str(library_index_path)},
+    )
+    assert result.ok
+    rules = {rule["ID"]: rule for rule in json.loads(result.stdout)["projects"][0]["rules"]}
+    assert rules["LP018"]["result"] == "pass"
+
+    result = run_command(cmd=["--library-index", "nonexistent.json", project_path])
+    assert not result.ok
+
+
+def test_rules(run_command):
+    result = run_command(cmd=["rules", "--format", "json"])
+    assert result.ok
+    rules = json.loads(result.stdout)
+    assert "LP012" in [rule["ID"] for rule in rules]
+
+    result = run_command(cmd=["rules", "--project-type", "sketch", "--format", "json"])
+    assert result.ok
+    assert all(rule["projectType"] == "sketch" for rule in json.loads(result.stdout))
+
+    result = run_command(cmd=["rules", "--mode", "foo"])
+    assert not result.ok
+
+
+def test_explain(run_command):
+    result = run_command(cmd=["explain", "LP012", "--format", "json"])
+    assert result.ok
+    explanation = json.loads(result.stdout)
+    assert explanation["ID"] == "LP012"
+    assert len(explanation["levels"]) == 9
+
+    result = run_command(cmd=["explain", "XX001"])
+    assert not result.ok
+
+
+def test_report_schema(run_command):
+    result = run_command(cmd=["report-schema"])
+    assert result.ok
+    report_schema = json.loads(result.stdout)
+    assert report_schema["properties"]["reportVersion"]["const"] == 4
+
+
+def test_report_version(run_command):
+    project_path = test_data_path.joinpath("InvalidSketch")
+    result = run_command(cmd=["--format", "json", project_path])
+    assert not result.ok
+    assert json.loads(result.stdout)["reportVersion"] == 4
+
+    result = run_command(cmd=["--format", "json", "--report-version", "1", project_path])
+    assert not result.ok
+    report = json.loads(result.stdout)
+    assert "reportVersion" not in report
+    assert all("locations" not in rule for rule in report["projects"][0]["rules"])
+
+    result = run_command(cmd=["--format", "json", "--report-version", "2", project_path])
+    assert not result.ok
+    report = json.loads(result.stdout)
+    assert report["reportVersion"] == 2
+    assert "passCount" not in report["summary"]
+
+    result = run_command(cmd=["--report-version", "foo", project_path])
+    assert not result.ok
+
+
+def test_report_detail(run_command):
+    project_path = test_data_path.joinpath("ValidSketch")
+    result = run_command(cmd=["--format", "json", project_path])
+    assert result.ok
+    report = json.loads(result.stdout)
+    assert report["projects"][0]["rules"] == []
+    assert report["projects"][0]["summary"]["passCount"] > 0
+
+    result = run_command(cmd=["--format", "json", "--report-detail", "full", project_path])
+    assert result.ok
+    report = json.loads(result.stdout)
+    assert len(report["projects"][0]["rules"]) > 0
+    assert all(rule["result"] != "fail" for rule in report["projects"][0]["rules"])
+
+    result = run_command(cmd=["--report-detail", "full", project_path])
+    assert result.ok
+    assert "result: pass" not in result.stdout
+
+    result = run_command(cmd=["--report-detail", "foo", project_path])
+    assert not result.ok
+
+
+def test_timings(run_command):
+    project_path = test_data_path.joinpath("ValidSketch")
+    result = run_command(cmd=[project_path])
+    assert result.ok
+    assert "Slowest rules:" not in result.stdout
+
+    result = run_command(cmd=["--timings", project_path])
+    assert result.ok
+    assert "Slowest rules:" in result.stdout
+
+    result = run_command(cmd=["--format", "json", "--timings", project_path])
+    assert result.ok
+    report = json.loads(result.stdout)
+    assert report["projects"][0]["timings"]["initialization"] >= 0
+    assert len(report["projects"][0]["timings"]["rules"]) > 0
+
+
+def test_jobs(run_command):
+    project_path = test_data_path.joinpath("recursive")
+    sequential_result = run_command(cmd=["--recursive", "true", "--format", "json", project_path])
+    parallel_result = run_command(cmd=["--recursive", "true", "--jobs", "4", "--format", "json", project_path])
+    assert sequential_result.ok == parallel_result.ok
+    assert json.loads(sequential_result.stdout)["projects"] == json.loads(parallel_result.stdout)["projects"]
+
+    result = run_command(cmd=["--jobs", "0", project_path])
+    assert not result.ok
+
+
 def test_format(run_command):
     project_path = test_data_path.joinpath("ValidSketch")
     result = run_command(cmd=["--format", "text", project_path])
@@ -65,6 +313,48 @@ def test_format(run_command):
     assert result.ok
     json.loads(result.stdout)
 
+    result = run_command(cmd=["--format", "sarif", project_path])
+    assert result.ok
+    assert json.loads(result.stdout)["version"] == "2.1.0"
+
+    result = run_command(cmd=["--format", "junit", project_path])
+    assert result.ok
+    assert result.stdout.startswith("<?xml")
+
+    result = run_command(cmd=["--format", "github", project_path])
+    assert result.ok
+    assert result.stdout.startswith("::group::")
+
+    result = run_command(cmd=["--format", "github", test_data_path.joinpath("InvalidSketch")])
+    assert not result.ok
+    assert "::error " in result.stdout
+
+    result = run_command(cmd=["--format", "codeclimate", test_data_path.joinpath("InvalidSketch")])
+    assert not result.ok
+    assert json.loads(result.stdout)[0]["type"] == "issue"
+
+    result = run_command(cmd=["--format", "checkstyle", test_data_path.joinpath("InvalidSketch")])
+    assert not result.ok
+    assert "<checkstyle" in result.stdout
+
+    result = run_command(cmd=["--format", "html", test_data_path.joinpath("InvalidSketch")])
+    assert not result.ok
+    assert result.stdout.startswith("<!DOCTYPE html>")
+
+    result = run_command(cmd=["--format", "jsonl", test_data_path.joinpath("InvalidSketch")])
+    assert not result.ok
+    events = [json.loads(line) for line in result.stdout.splitlines()]
+    assert events[0]["event"] == "projectStart"
+    assert events[1]["event"] == "ruleResult"
+    assert events[-2]["event"] == "projectSummary"
+    assert events[-1]["event"] == "summary"
+    assert not events[-1]["summary"]["pass"]
+
+    result = run_command(cmd=["--format", "markdown", test_data_path.joinpath("InvalidSketch")])
+    assert not result.ok
+    assert result.stdout.startswith("## Arduino Lint results")
+    assert "<details>" in result.stdout
+
     result = run_command(cmd=["--format", "foo", project_path])
     assert not result.ok
 
@@ -81,7 +371,7 @@ def test_help(run_command):
         ("Submit", {"submit": 0, "update": 1, "false": 0}),
         ("Update", {"submit": 1, "update": 0, "false": 0}),
         ("False", {"submit": 1, "update": 1, "false": 0}),
-        ("Invalid", {"submit": 1, "update": 1, "false": 1}),
+        ("Invalid", {"submit": 3, "update": 3, "false": 3}),
     ],
 )
 def test_library_manager(run_command, project_folder, expected_exit_statuses):
@@ -91,6 +381,48 @@ def test_library_manager(run_command, project_folder, expected_exit_statuses):
         assert result.exited == expected_exit_status
 
 
+def test_rule_selection(run_command):
+    project_path = test_data_path.joinpath("InvalidSketch")
+    result = run_command(cmd=["--format", "json", "--verbose", "--only", "SS*", project_path])
+    report = json.loads(result.stdout)
+    assert all(
+        rule["ID"].startswith("SS") or rule["message"] == "skipped by user configuration"
+        for rule in report["projects"][0]["rules"]
+    )
+
+    result = run_command(cmd=["--disable-rules", "structure,code", project_path])
+    assert result.ok
+
+    result = run_command(cmd=["--only", "foo", project_path])
+    assert result.exited == 2
+
+
+def test_fail_on(run_command):
+    project_path = test_data_path.joinpath("verbose", "HasWarnings")
+    result = run_command(cmd=[project_path])
+    assert result.ok
+
+    result = run_command(cmd=["--fail-on", "warning", project_path])
+    assert result.exited == 1
+
+    result = run_command(cmd=["--fail-on", "never", test_data_path.joinpath("InvalidSketch")])
+    assert result.ok
+
+    result = run_command(cmd=["--fail-on", "foo", project_path])
+    assert result.exited == 2
+
+
+def test_exit_status(run_command):
+    result = run_command(cmd=["--foo"])
+    assert result.exited == 2
+
+    result = run_command(cmd=["--compliance", "foo", test_data_path.joinpath("ValidSketch")])
+    assert result.exited == 2
+
+    result = run_command(cmd=[test_data_path.joinpath("verbose")])
+    assert result.exited == 3
+
+
 def test_library_manager_invalid(run_command):
     result = run_command(cmd=["--library-manager", "foo", test_data_path.joinpath("ValidSketch")])
     assert not result.ok
@@ -177,6 +509,25 @@ def test_report_file(run_command, working_dir):
     assert report["summary"]["errorCount"] == 0
 
 
+def test_report_format(run_command, working_dir):
+    project_path = test_data_path.joinpath("InvalidSketch")
+    result = run_command(cmd=["--report-file", "report.html", project_path])
+    assert not result.ok
+    report = pathlib.Path(working_dir, "report.html").read_text()
+    assert report.startswith("<!DOCTYPE html>")
+    assert "SS001" in report
+
+    result = run_command(cmd=["--report-file", "report.html", "--report-format", "json", project_path])
+    assert not result.ok
+    with pathlib.Path(working_dir, "report.html").open() as report_file:
+        report = json.load(report_file)
+    assert not report["summary"]["pass"]
+
+    result = run_command(cmd=["--report-file", "report.txt", "--report-format", "text", project_path])
+    assert not result.ok
+    assert not pathlib.Path(working_dir, "report.txt").exists()
+
+
 def test_verbose(run_command):
     project_path = test_data_path.joinpath("verbose", "HasWarnings")
     result = run_command(cmd=["--format", "text", project_path])
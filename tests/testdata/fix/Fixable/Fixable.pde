@@ -0,0 +1,5 @@
+#include <arduino.h>
+
+void setup() {}
+
+void loop() {}
@@ -0,0 +1,28 @@
+# Example Arduino Lint plugin, which checks the library.properties file against the organization's house rules.
+import json
+import sys
+
+project = json.load(sys.stdin)
+findings = []
+library_properties = project["metadata"].get("library.properties")
+if library_properties is not None:
+    if not library_properties.get("maintainer", "").endswith("<lint@example.com>"):
+        findings.append(
+            {
+                "ID": "HR001",
+                "level": "error",
+                "message": "maintainer field must use the company email address.",
+                "location": {"path": "library.properties"},
+            }
+        )
+    if not library_properties.get("name", "").startswith("Example"):
+        findings.append(
+            {
+                "ID": "HR002",
+                "level": "warning",
+                "message": "name field must start with the vendor prefix Example.",
+                "location": {"path": "library.properties"},
+            }
+        )
+
+json.dump({"findings": findings}, sys.stdout)